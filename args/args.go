@@ -2,9 +2,12 @@ package args
 
 import (
 	"fmt"
+	"math/big"
 	"reflect"
 	"strconv"
 	"strings"
+
+	"go-runtimevalidation/functions"
 )
 
 type ArgType int
@@ -46,68 +49,88 @@ func ParseArgs(text string) (map[string]Arg, error) {
 	for _, part := range parts {
 		part = strings.TrimSpace(part)
 
-		// Case 1: Handle Condition (e.g. $Age>18, $Name=="John")
-		if isCondition(part) {
-			condition, err := parseCondition(part)
-			if err != nil {
-				return nil, err
-			}
+		arg, err := parseRuleArg(part)
+		if err != nil {
+			return nil, err
+		}
+		argsMap[part] = arg
+	}
 
-			// Store the condition argument
-			argsMap[part] = Arg{
-				Type:      ConditionArg,
-				Condition: condition,
-			}
+	return argsMap, nil
+}
 
-			// Case 2: Handle Function Call (e.g. $len($Name))
-		} else if isFunctionCall(part) {
-			funcName, funcArgs, err := parseFunctionCall(part)
-			if err != nil {
-				return nil, err
-			}
+// ParseArgList parses the arguments of a rule like ParseArgs, but keeps them in order and keeps duplicates,
+// for rules whose arguments are positional such as the bounds of `between:5,5` or `lenbetween:runes,3,20`.
+func ParseArgList(text string) ([]Arg, error) {
+	parts := splitAndHandleEscapes(text, ",")
+	argsList := make([]Arg, 0, len(parts))
 
-			// Store the function argument
-			argsMap[part] = Arg{
-				Type: FunctionArg,
-				Function: Function{
-					Name: funcName,
-					Args: funcArgs,
-				},
-			}
+	for _, part := range parts {
+		arg, err := parseRuleArg(strings.TrimSpace(part))
+		if err != nil {
+			return nil, err
+		}
+		argsList = append(argsList, arg)
+	}
 
-			// Case 3: Handle Field Reference (e.g. $Age)
-		} else if isField(part) {
-			// Store the field argument
-			argsMap[part] = Arg{
-				Type:  FieldArg,
-				Field: strings.TrimPrefix(part, "$"),
-			}
+	return argsList, nil
+}
 
-			// Case 4: Handle Escaped Value
-		} else if strings.HasPrefix(part, `\`) {
-			// Remove escape characters and treat as a value
-			value := unescapeText(part)
-			argsMap[part] = Arg{
-				Type:  ValueArg,
-				Value: value,
-			}
+// Helper function to parse an argument of a rule, where conditions take precedence over function calls
+func parseRuleArg(part string) (Arg, error) {
+	// Case 1: Handle Condition (e.g. $Age>18, $Name=="John")
+	if isCondition(part) {
+		condition, err := parseCondition(part)
+		if err != nil {
+			return Arg{}, err
+		}
 
-			// Case 5: Handle Value (e.g. 1, "John")
-		} else {
-			value, err := parseValue(part)
-			if err != nil {
-				return nil, err
-			}
+		return Arg{
+			Type:      ConditionArg,
+			Condition: condition,
+		}, nil
 
-			// Store the value argument
-			argsMap[part] = Arg{
-				Type:  ValueArg,
-				Value: value,
-			}
+		// Case 2: Handle Function Call (e.g. $len($Name))
+	} else if isFunctionCall(part) {
+		funcName, funcArgs, err := parseFunctionCall(part)
+		if err != nil {
+			return Arg{}, err
 		}
+
+		return Arg{
+			Type: FunctionArg,
+			Function: Function{
+				Name: funcName,
+				Args: funcArgs,
+			},
+		}, nil
+
+		// Case 3: Handle Field Reference (e.g. $Age)
+	} else if isField(part) {
+		return Arg{
+			Type:  FieldArg,
+			Field: strings.TrimPrefix(part, "$"),
+		}, nil
+
+		// Case 4: Handle Escaped Value
+	} else if strings.HasPrefix(part, `\`) {
+		// Remove escape characters and treat as a value
+		return Arg{
+			Type:  ValueArg,
+			Value: unescapeText(part),
+		}, nil
 	}
 
-	return argsMap, nil
+	// Case 5: Handle Value (e.g. 1, "John")
+	value, err := parseValue(part)
+	if err != nil {
+		return Arg{}, err
+	}
+
+	return Arg{
+		Type:  ValueArg,
+		Value: value,
+	}, nil
 }
 
 // Helper function to split arguments while handling nested structures like arrays or maps
@@ -404,8 +427,19 @@ func parseValueType(s string) interface{} {
 		return intValue
 	}
 
+	// Keep integers beyond the range of int exactly
+	if bigValue, ok := new(big.Int).SetString(s, 10); ok {
+		return bigValue
+	}
+
 	// Try to parse the value as a float
 	if floatValue, err := strconv.ParseFloat(s, 64); err == nil {
+		// Keep decimals that a float64 cannot hold exactly, such as 99999999999999999999.99, as *big.Rat
+		if exact, err := functions.GetNumber(s); err == nil {
+			if rounded, err := functions.GetNumber(floatValue); err == nil && exact.Cmp(rounded) != 0 {
+				return exact
+			}
+		}
 		return floatValue
 	}
 
@@ -428,7 +462,11 @@ func isFunctionCall(s string) bool {
 }
 
 // Helper function to determine if the input is a condition (contains an operator)
+// A single quoted string is a value, so that operators can appear in it, e.g. ">=1.2.0 <2.0.0" for semverrange
 func isCondition(s string) bool {
+	if isQuoted(s) {
+		return false
+	}
 	operators := []string{"<=", ">=", "==", "!=", "<", ">"}
 	for _, op := range operators {
 		if strings.Contains(s, op) {
@@ -437,3 +475,22 @@ func isCondition(s string) bool {
 	}
 	return false
 }
+
+// Helper function to determine if the input is a single quoted string, with no unescaped quote inside it
+// Quoted operands such as "x" == "x" start and end with a quote as well, but are a condition
+func isQuoted(s string) bool {
+	if len(s) < 2 || !strings.HasPrefix(s, "\"") || !strings.HasSuffix(s, "\"") {
+		return false
+	}
+
+	inner := s[1 : len(s)-1]
+	for i := 0; i < len(inner); i++ {
+		switch inner[i] {
+		case '\\':
+			i++
+		case '"':
+			return false
+		}
+	}
+	return true
+}
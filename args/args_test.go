@@ -1,6 +1,7 @@
 package args
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -19,6 +20,21 @@ func TestParseValue(t *testing.T) {
 		assert.Equal(t, 1.1, value)
 	})
 
+	t.Run("should keep large numbers exact", func(t *testing.T) {
+		value, err := parseValue("18446744073709551615")
+		assert.NoError(t, err)
+		expected, _ := new(big.Int).SetString("18446744073709551615", 10)
+		assert.Equal(t, expected, value)
+
+		value, err = parseValue("99999999999999999999.99")
+		assert.NoError(t, err)
+		assert.Equal(t, "9999999999999999999999/100", value.(*big.Rat).String())
+
+		value, err = parseValue("0.1")
+		assert.NoError(t, err)
+		assert.Equal(t, 0.1, value)
+	})
+
 	t.Run("should return a boolean", func(t *testing.T) {
 		value, err := parseValue("true")
 		assert.NoError(t, err)
@@ -320,6 +336,28 @@ func TestParseArgs(t *testing.T) {
 		assert.Equal(t, "Name", args["$len($Name)"].Function.Args[0].Field)
 	})
 
+	t.Run("should parse quoted operators as a value", func(t *testing.T) {
+		args, err := ParseArgs(`">=1.2.0 <2.0.0"`)
+		assert.NoError(t, err)
+		assert.Len(t, args, 1)
+		assert.Equal(t, ValueArg, args[`">=1.2.0 <2.0.0"`].Type)
+		assert.Equal(t, ">=1.2.0 <2.0.0", args[`">=1.2.0 <2.0.0"`].Value)
+	})
+
+	t.Run("should parse a condition with quoted operands", func(t *testing.T) {
+		args, err := ParseArgs(`"x" == "x"`)
+		assert.NoError(t, err)
+		assert.Len(t, args, 1)
+		assert.Equal(t, ConditionArg, args[`"x" == "x"`].Type)
+		assert.Equal(t, "x", args[`"x" == "x"`].Condition.Lhs.Value)
+		assert.Equal(t, "x", args[`"x" == "x"`].Condition.Rhs.Value)
+		assert.Equal(t, "==", args[`"x" == "x"`].Condition.Operator)
+
+		value, err := args[`"x" == "x"`].Evaluate(nil)
+		assert.NoError(t, err)
+		assert.Equal(t, true, value)
+	})
+
 	t.Run("should parse condition argument", func(t *testing.T) {
 		args, err := ParseArgs("$Age >= 18")
 		assert.NoError(t, err)
@@ -361,3 +399,34 @@ func TestParseArgs(t *testing.T) {
 		assert.Equal(t, "escaped", args["\"escaped\""].Value)
 	})
 }
+
+func TestParseArgList(t *testing.T) {
+	t.Run("should keep arguments in order", func(t *testing.T) {
+		args, err := ParseArgList("$MinLen, $MaxLen, 50")
+		assert.NoError(t, err)
+		assert.Len(t, args, 3)
+		assert.Equal(t, Arg{Type: FieldArg, Field: "MinLen"}, args[0])
+		assert.Equal(t, Arg{Type: FieldArg, Field: "MaxLen"}, args[1])
+		assert.Equal(t, Arg{Type: ValueArg, Value: 50}, args[2])
+	})
+
+	t.Run("should keep duplicate arguments", func(t *testing.T) {
+		args, err := ParseArgList("1,1")
+		assert.NoError(t, err)
+		assert.Len(t, args, 2)
+	})
+
+	t.Run("should parse conditions and function calls", func(t *testing.T) {
+		args, err := ParseArgList("$len($Name)>3, $len($Tags)")
+		assert.NoError(t, err)
+		assert.Len(t, args, 2)
+		assert.Equal(t, ConditionArg, args[0].Type)
+		assert.Equal(t, FunctionArg, args[1].Type)
+		assert.Equal(t, "len", args[1].Function.Name)
+	})
+
+	t.Run("should fail on invalid arguments", func(t *testing.T) {
+		_, err := ParseArgList("1,,2")
+		assert.Error(t, err)
+	})
+}
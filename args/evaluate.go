@@ -2,9 +2,14 @@ package args
 
 import (
 	"fmt"
+	"math/big"
 	"reflect"
+	"strings"
+	"time"
 
 	"go-runtimevalidation/functions"
+	"go-runtimevalidation/iso"
+	"go-runtimevalidation/semver"
 )
 
 // Evaluate function that traverses and evaluates based on the type of Arg
@@ -48,6 +53,15 @@ func EvaluateFunctionCall(function Function, obj any) (any, error) {
 			return nil, err
 		}
 		return functions.GetLen(argValue)
+	case "len_bytes", "len_runes", "len_graphemes", "len_utf16":
+		if len(function.Args) != 1 {
+			return nil, fmt.Errorf("%s expects 1 argument", function.Name)
+		}
+		argValue, err := function.Args[0].Evaluate(obj)
+		if err != nil {
+			return nil, err
+		}
+		return functions.GetLenMode(argValue, functions.LenMode(strings.TrimPrefix(function.Name, "len_")))
 	case "int":
 		if len(function.Args) != 1 {
 			return nil, fmt.Errorf("int expects 1 argument")
@@ -66,6 +80,53 @@ func EvaluateFunctionCall(function Function, obj any) (any, error) {
 			return nil, err
 		}
 		return functions.GetFloat(argValue)
+	case "now":
+		if len(function.Args) > 1 {
+			return nil, fmt.Errorf("now expects at most 1 argument")
+		}
+		now := functions.Now()
+		if len(function.Args) == 1 {
+			// An optional duration shifts the current time, e.g. $now(-24h) or $now(720h)
+			argValue, err := function.Args[0].Evaluate(obj)
+			if err != nil {
+				return nil, err
+			}
+			offset, err := functions.GetDuration(argValue)
+			if err != nil {
+				return nil, err
+			}
+			now = now.Add(offset)
+		}
+		return now, nil
+	case "currencyminor":
+		if len(function.Args) != 1 {
+			return nil, fmt.Errorf("currencyminor expects 1 argument")
+		}
+		argValue, err := function.Args[0].Evaluate(obj)
+		if err != nil {
+			return nil, err
+		}
+		code, err := functions.GetString(argValue)
+		if err != nil {
+			return nil, err
+		}
+		currency, ok := iso.CurrencyByCode(code)
+		if !ok {
+			return nil, fmt.Errorf("unknown currency: %s", code)
+		}
+		if currency.Minor == iso.NoMinorUnit {
+			return nil, fmt.Errorf("currency %s has no minor unit", code)
+		}
+		return currency.Minor, nil
+	case "semver":
+		if len(function.Args) != 1 {
+			return nil, fmt.Errorf("semver expects 1 argument")
+		}
+		argValue, err := function.Args[0].Evaluate(obj)
+		if err != nil {
+			return nil, err
+		}
+		return functions.GetSemVer(argValue)
 	// Add other function calls like "min", "max", etc.
 	default:
 		return nil, fmt.Errorf("unknown function: %s", function.Name)
@@ -73,9 +134,17 @@ func EvaluateFunctionCall(function Function, obj any) (any, error) {
 }
 
 func compare(lhs, rhs interface{}, operator string) (bool, error) {
+	if isBigNumber(lhs) || isBigNumber(rhs) {
+		// Big numbers, such as literals beyond the range of int64 and float64, compare exactly with any number
+		return compareBigNumbers(lhs, rhs, operator)
+	}
 	if reflect.TypeOf(lhs) != reflect.TypeOf(rhs) {
 		return false, fmt.Errorf("type mismatch: lhs is %T, rhs is %T", lhs, rhs)
 	}
+	if version, ok := lhs.(semver.Version); ok {
+		// Versions compare by precedence, which ignores build metadata
+		return compareVersions(version, rhs.(semver.Version), operator)
+	}
 	switch operator {
 	case "==":
 		return reflect.DeepEqual(lhs, rhs), nil
@@ -136,9 +205,67 @@ func compareNumbers(lhs, rhs interface{}, operator string) (bool, error) {
 		case "<=":
 			return lhsFloat <= rhsFloat, nil
 		}
+	case time.Time:
+		rhsTime := rhs.(time.Time)
+		switch operator {
+		case ">":
+			return val.After(rhsTime), nil
+		case "<":
+			return val.Before(rhsTime), nil
+		case ">=":
+			return !val.Before(rhsTime), nil
+		case "<=":
+			return !val.After(rhsTime), nil
+		}
 	default:
 		return false, fmt.Errorf("unsupported type for comparison: %T", val)
 	}
 
 	return false, fmt.Errorf("unknown operator: %s", operator)
 }
+
+// Helper function to compare semantic versions by precedence
+func compareVersions(lhs, rhs semver.Version, operator string) (bool, error) {
+	return compareOrder(lhs.Compare(rhs), operator)
+}
+
+// isBigNumber reports whether the value is a *big.Int, *big.Float or *big.Rat.
+func isBigNumber(value any) bool {
+	switch value.(type) {
+	case *big.Int, *big.Float, *big.Rat:
+		return true
+	}
+	return false
+}
+
+// Helper function to compare numbers exactly when one of them is a big number
+func compareBigNumbers(lhs, rhs any, operator string) (bool, error) {
+	lhsNumber, err := functions.GetNumber(lhs)
+	if err != nil {
+		return false, fmt.Errorf("type mismatch: %w", err)
+	}
+	rhsNumber, err := functions.GetNumber(rhs)
+	if err != nil {
+		return false, fmt.Errorf("type mismatch: %w", err)
+	}
+	return compareOrder(lhsNumber.Cmp(rhsNumber), operator)
+}
+
+// Helper function to apply an operator to the result of a three-way comparison
+func compareOrder(c int, operator string) (bool, error) {
+	switch operator {
+	case "==":
+		return c == 0, nil
+	case "!=":
+		return c != 0, nil
+	case ">":
+		return c > 0, nil
+	case "<":
+		return c < 0, nil
+	case ">=":
+		return c >= 0, nil
+	case "<=":
+		return c <= 0, nil
+	}
+	return false, fmt.Errorf("unknown operator: %s", operator)
+}
@@ -1,7 +1,11 @@
 package args
 
 import (
+	"go-runtimevalidation/functions"
+	"go-runtimevalidation/semver"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -123,6 +127,78 @@ func TestEvaluateFunctionCall(t *testing.T) {
 		assert.Error(t, err)
 		assert.Nil(t, result)
 	})
+
+	t.Run("Evaluate Len Runes Function", func(t *testing.T) {
+		function := Function{
+			Name: "len_runes",
+			Args: []Arg{{Value: "héllo"}},
+		}
+
+		result, err := EvaluateFunctionCall(function, obj)
+		assert.NoError(t, err)
+		assert.Equal(t, 5, result)
+	})
+
+	t.Run("Evaluate Now Function", func(t *testing.T) {
+		now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+		restore := functions.SetClock(func() time.Time { return now })
+		defer restore()
+
+		result, err := EvaluateFunctionCall(Function{Name: "now"}, obj)
+		assert.NoError(t, err)
+		assert.Equal(t, now, result)
+
+		result, err = EvaluateFunctionCall(Function{Name: "now", Args: []Arg{{Value: "-24h"}}}, obj)
+		assert.NoError(t, err)
+		assert.Equal(t, now.Add(-24*time.Hour), result)
+	})
+
+	t.Run("Evaluate Now Function with Invalid Offset", func(t *testing.T) {
+		result, err := EvaluateFunctionCall(Function{Name: "now", Args: []Arg{{Value: "yesterday"}}}, obj)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Evaluate Currency Minor Function", func(t *testing.T) {
+		result, err := EvaluateFunctionCall(Function{Name: "currencyminor", Args: []Arg{{Value: "JPY"}}}, obj)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, result)
+
+		result, err = EvaluateFunctionCall(Function{Name: "currencyminor", Args: []Arg{{Value: "KWD"}}}, obj)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, result)
+	})
+
+	t.Run("Evaluate Currency Minor Function with Unknown Currency", func(t *testing.T) {
+		result, err := EvaluateFunctionCall(Function{Name: "currencyminor", Args: []Arg{{Value: "ABC"}}}, obj)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+
+		result, err = EvaluateFunctionCall(Function{Name: "currencyminor", Args: []Arg{{Value: "XAU"}}}, obj)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Evaluate SemVer Function", func(t *testing.T) {
+		result, err := EvaluateFunctionCall(Function{Name: "semver", Args: []Arg{{Value: "1.2.3-rc.1"}}}, obj)
+		assert.NoError(t, err)
+		assert.Equal(t, semver.MustParse("1.2.3-rc.1"), result)
+
+		result, err = EvaluateFunctionCall(Function{Name: "semver", Args: []Arg{{Value: "1.2"}}}, obj)
+		assert.Error(t, err)
+		assert.Equal(t, semver.Version{}, result)
+	})
+
+	t.Run("Evaluate Len Bytes Function", func(t *testing.T) {
+		function := Function{
+			Name: "len_bytes",
+			Args: []Arg{{Value: "héllo"}},
+		}
+
+		result, err := EvaluateFunctionCall(function, obj)
+		assert.NoError(t, err)
+		assert.Equal(t, 6, result)
+	})
 }
 
 // Test the compare function for various operators
@@ -151,6 +227,64 @@ func TestCompare(t *testing.T) {
 		assert.True(t, result)
 	})
 
+	t.Run("Compare Times", func(t *testing.T) {
+		earlier := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		later := earlier.Add(time.Hour)
+
+		result, err := compare(earlier, later, "<")
+		assert.NoError(t, err)
+		assert.True(t, result)
+
+		result, err = compare(earlier, earlier, ">=")
+		assert.NoError(t, err)
+		assert.True(t, result)
+	})
+
+	t.Run("Compare Big Numbers", func(t *testing.T) {
+		limit, _ := new(big.Int).SetString("18446744073709551615", 10)
+
+		result, err := compare(uint64(18446744073709551615), limit, "==")
+		assert.NoError(t, err)
+		assert.True(t, result)
+
+		result, err = compare(big.NewRat(1, 3), 0.33, ">")
+		assert.NoError(t, err)
+		assert.True(t, result)
+
+		_, err = compare(limit, "many", ">")
+		assert.Error(t, err)
+	})
+
+	t.Run("Compare Versions", func(t *testing.T) {
+		result, err := compare(semver.MustParse("1.10.0"), semver.MustParse("1.9.0"), ">")
+		assert.NoError(t, err)
+		assert.True(t, result)
+
+		result, err = compare(semver.MustParse("1.0.0-rc.1"), semver.MustParse("1.0.0"), "<")
+		assert.NoError(t, err)
+		assert.True(t, result)
+
+		result, err = compare(semver.MustParse("1.0.0+build.1"), semver.MustParse("1.0.0+build.2"), "==")
+		assert.NoError(t, err)
+		assert.True(t, result)
+	})
+
+	t.Run("Compare Versions in a Condition", func(t *testing.T) {
+		type Release struct{ Version, MinVersion string }
+		args, err := ParseArgs("$semver($Version)>=$semver($MinVersion)")
+		assert.NoError(t, err)
+
+		for _, arg := range args {
+			result, err := arg.Evaluate(Release{Version: "1.10.0", MinVersion: "1.9.0"})
+			assert.NoError(t, err)
+			assert.Equal(t, true, result)
+
+			result, err = arg.Evaluate(Release{Version: "1.9.0", MinVersion: "1.10.0"})
+			assert.NoError(t, err)
+			assert.Equal(t, false, result)
+		}
+	})
+
 	t.Run("Compare Invalid Operator", func(t *testing.T) {
 		result, err := compare(5, 5, "invalid")
 		assert.Error(t, err)
@@ -0,0 +1,101 @@
+package args
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Options describes the options of a rule, the arguments written as `name=value`, such as `min=8` in
+// `password:min=8,upper`, or as a bare flag, such as `upper`. Rules read their options with it,
+// so that they all accept the same syntax and report the same errors.
+type Options struct {
+	Rule   string   // The name of the rule, used in errors
+	Values []string // The names of the options given as `name=value`
+	Flags  []string // The names of the options given as a bare `name`
+}
+
+// Option is an option of a rule, as read by Options.Parse.
+type Option struct {
+	Name string
+	// Text is the value as written in the rule, such as "5MiB" or "$MaxUpload", and is empty for flags.
+	Text string
+	// Value is the value as an argument: a Field reference for `$Field`, a function call for `$func(...)`,
+	// or Text as a string value otherwise.
+	Value Arg
+}
+
+// IsOption reports whether the argument is written as an option, either as `name=value` or as the bare name of one of the options.
+// Rules that also take positional arguments, such as `decimals:2` and `decimals:max=2`, use it to tell them apart.
+func (o Options) IsOption(arg Arg) bool {
+	text, ok := arg.Value.(string)
+	if arg.Type != ValueArg || !ok {
+		return false
+	}
+
+	name, _, hasValue := strings.Cut(text, "=")
+	return hasValue || slices.Contains(o.Values, name) || slices.Contains(o.Flags, name)
+}
+
+// Parse reads an argument as an option. The key is the argument as written in the rule, used in errors.
+//
+// Returns an error if:
+// - The argument is not a literal
+// - The option is unknown, or a flag is given a value
+// - An option that takes a value is given none.
+func (o Options) Parse(key string, arg Arg) (Option, error) {
+	text, ok := arg.Value.(string)
+	if arg.Type != ValueArg || !ok {
+		return Option{}, fmt.Errorf("%s: unsupported argument %s", o.Rule, key)
+	}
+
+	name, param, hasValue := strings.Cut(text, "=")
+	switch {
+	case !hasValue && slices.Contains(o.Flags, name):
+		return Option{Name: name}, nil
+	case slices.Contains(o.Values, name):
+		if param == "" {
+			return Option{}, fmt.Errorf("%s: missing value for %s", o.Rule, name)
+		}
+		value, err := parseOptionValue(param)
+		if err != nil {
+			return Option{}, fmt.Errorf("%s: invalid value for %s: %s", o.Rule, name, param)
+		}
+		return Option{Name: name, Text: param, Value: value}, nil
+	}
+
+	return Option{}, fmt.Errorf("%s: unknown option %s", o.Rule, text)
+}
+
+// Evaluate returns the value of the option, resolving Field references and function calls against obj.
+func (o Option) Evaluate(obj any) (any, error) {
+	return o.Value.Evaluate(obj)
+}
+
+// Helper function to parse the value of an option, which is kept as written unless it is a Field reference or a function call
+func parseOptionValue(text string) (Arg, error) {
+	if isFunctionCall(text) {
+		funcName, funcArgs, err := parseFunctionCall(text)
+		if err != nil {
+			return Arg{}, err
+		}
+
+		return Arg{
+			Type: FunctionArg,
+			Function: Function{
+				Name: funcName,
+				Args: funcArgs,
+			},
+		}, nil
+	} else if isField(text) {
+		return Arg{
+			Type:  FieldArg,
+			Field: strings.TrimPrefix(text, "$"),
+		}, nil
+	}
+
+	return Arg{
+		Type:  ValueArg,
+		Value: text,
+	}, nil
+}
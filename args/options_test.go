@@ -0,0 +1,89 @@
+package args
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptions(t *testing.T) {
+	options := Options{Rule: "password", Values: []string{"min", "max"}, Flags: []string{"upper"}}
+
+	t.Run("should parse a value option", func(t *testing.T) {
+		option, err := options.Parse("min=8", Arg{Value: "min=8"})
+		assert.NoError(t, err)
+		assert.Equal(t, Option{Name: "min", Text: "8", Value: Arg{Type: ValueArg, Value: "8"}}, option)
+	})
+
+	t.Run("should parse a flag", func(t *testing.T) {
+		option, err := options.Parse("upper", Arg{Value: "upper"})
+		assert.NoError(t, err)
+		assert.Equal(t, Option{Name: "upper"}, option)
+	})
+
+	t.Run("should parse a field reference", func(t *testing.T) {
+		option, err := options.Parse("max=$Max", Arg{Value: "max=$Max"})
+		assert.NoError(t, err)
+		assert.Equal(t, Arg{Type: FieldArg, Field: "Max"}, option.Value)
+
+		value, err := option.Evaluate(struct{ Max int }{Max: 64})
+		assert.NoError(t, err)
+		assert.Equal(t, 64, value)
+	})
+
+	t.Run("should parse a function call", func(t *testing.T) {
+		option, err := options.Parse("max=$len($Name)", Arg{Value: "max=$len($Name)"})
+		assert.NoError(t, err)
+		assert.Equal(t, "$len($Name)", option.Text)
+
+		value, err := option.Evaluate(struct{ Name string }{Name: "Alice"})
+		assert.NoError(t, err)
+		assert.Equal(t, 5, value)
+	})
+
+	t.Run("should parse options from rule arguments", func(t *testing.T) {
+		arguments, err := ParseArgs("min=8,max=$currencyminor($Currency),upper")
+		assert.NoError(t, err)
+		for key, arg := range arguments {
+			assert.True(t, options.IsOption(arg), key)
+			_, err := options.Parse(key, arg)
+			assert.NoError(t, err, key)
+		}
+	})
+
+	t.Run("should tell options from positional arguments", func(t *testing.T) {
+		assert.True(t, options.IsOption(Arg{Value: "min=8"}))
+		assert.True(t, options.IsOption(Arg{Value: "size=8"}))
+		assert.True(t, options.IsOption(Arg{Value: "max"}))
+		assert.True(t, options.IsOption(Arg{Value: "upper"}))
+		assert.False(t, options.IsOption(Arg{Value: "16:9"}))
+		assert.False(t, options.IsOption(Arg{Value: 2}))
+		assert.False(t, options.IsOption(Arg{Type: FieldArg, Field: "Max"}))
+	})
+
+	t.Run("should reject an unknown option", func(t *testing.T) {
+		_, err := options.Parse("size=8", Arg{Value: "size=8"})
+		assert.EqualError(t, err, "password: unknown option size=8")
+
+		_, err = options.Parse("lower", Arg{Value: "lower"})
+		assert.EqualError(t, err, "password: unknown option lower")
+	})
+
+	t.Run("should reject a flag with a value", func(t *testing.T) {
+		_, err := options.Parse("upper=1", Arg{Value: "upper=1"})
+		assert.EqualError(t, err, "password: unknown option upper=1")
+	})
+
+	t.Run("should reject a bare value option", func(t *testing.T) {
+		_, err := options.Parse("min", Arg{Value: "min"})
+		assert.EqualError(t, err, "password: missing value for min")
+
+		_, err = options.Parse("min=", Arg{Value: "min="})
+		assert.EqualError(t, err, "password: missing value for min")
+	})
+
+	t.Run("should reject a non-literal argument", func(t *testing.T) {
+		_, err := options.Parse("$Min", Arg{Type: FieldArg, Field: "Min"})
+		assert.EqualError(t, err, "password: unsupported argument $Min")
+	})
+}
@@ -0,0 +1,189 @@
+// Package domain parses domain names label by label, following RFC 1123 for ASCII labels and
+// IDNA2008 (as profiled by UTS #46 for lookups) for internationalized ones.
+//
+// Every label is converted to its lower case ASCII form, with internationalized labels in punycode,
+// and checked against the 63-octet label and 253-octet name limits of RFC 1035. Errors name the offending label.
+// Public suffix lookups use the list embedded in golang.org/x/net/publicsuffix.
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/idna"
+	"golang.org/x/net/publicsuffix"
+)
+
+// Maximum lengths in octets of the ASCII form, from RFC 1035 section 2.3.4.
+const (
+	MaxLabelLength = 63
+	MaxNameLength  = 253
+)
+
+// Label is a single label of a domain name.
+type Label struct {
+	ASCII   string // lower case ASCII form, an A-label (xn--...) for internationalized labels
+	Unicode string // lower case Unicode form, equal to ASCII for labels that are not internationalized
+}
+
+// Name is a parsed domain name.
+type Name struct {
+	Labels []Label
+	Rooted bool // whether the name was written with a trailing dot, e.g. "example.com."
+}
+
+// profile converts labels between Unicode and ASCII following IDNA2008 as used for lookups (UTS #46, non-transitional).
+var profile = idna.New(idna.MapForLookup(), idna.BidiRule(), idna.Transitional(false))
+
+// ASCII returns the name in lower case ASCII form, without a trailing dot.
+func (n Name) ASCII() string {
+	return n.join(func(l Label) string { return l.ASCII })
+}
+
+// Unicode returns the name in lower case Unicode form, without a trailing dot.
+func (n Name) Unicode() string {
+	return n.join(func(l Label) string { return l.Unicode })
+}
+
+// TLD returns the last label of the name.
+func (n Name) TLD() Label {
+	return n.Labels[len(n.Labels)-1]
+}
+
+func (n Name) join(form func(Label) string) string {
+	parts := make([]string, len(n.Labels))
+	for i, label := range n.Labels {
+		parts[i] = form(label)
+	}
+	return strings.Join(parts, ".")
+}
+
+// PublicSuffix returns the public suffix of the name in ASCII form, such as "com" or "co.uk",
+// and whether it is an ICANN suffix rather than a privately managed one such as "github.io".
+// Names under a top-level domain that is not on the list have their last label as public suffix.
+func (n Name) PublicSuffix() (string, bool) {
+	return publicsuffix.PublicSuffix(n.ASCII())
+}
+
+// RegistrableDomain returns the registrable domain of the name in ASCII form, that is its public suffix
+// and one more label, e.g. "example.co.uk" for "www.example.co.uk".
+// Returns an error if the name is itself a public suffix.
+func (n Name) RegistrableDomain() (string, error) {
+	registrable, err := publicsuffix.EffectiveTLDPlusOne(n.ASCII())
+	if err != nil {
+		suffix, _ := n.PublicSuffix()
+		if suffix == n.ASCII() {
+			return "", fmt.Errorf("%s is a public suffix", n.Unicode())
+		}
+		return "", err
+	}
+	return registrable, nil
+}
+
+// Parse parses a domain name such as "www.example.com", "bücher.example." or "xn--bcher-kva.example".
+//
+// Example:
+//
+//	name, err := domain.Parse("Bücher.example")
+//	// name.ASCII(): "xn--bcher-kva.example", name.Unicode(): "bücher.example"
+//	_, err = domain.Parse("exa_mple.com")
+//	// err: `label "exa_mple" contains invalid character '_'`
+func Parse(name string) (Name, error) {
+	if !utf8.ValidString(name) {
+		return Name{}, fmt.Errorf("name is not valid UTF-8")
+	}
+
+	trimmed, rooted := strings.CutSuffix(name, ".")
+	if trimmed == "" {
+		return Name{}, fmt.Errorf("name is empty")
+	}
+
+	parts := strings.Split(trimmed, ".")
+	labels := make([]Label, len(parts))
+	length := len(parts) - 1 // dots between labels
+	for i, part := range parts {
+		if part == "" {
+			return Name{}, fmt.Errorf("name has an empty label")
+		}
+		label, err := ParseLabel(part)
+		if err != nil {
+			return Name{}, err
+		}
+		labels[i] = label
+		length += len(label.ASCII)
+	}
+
+	if length > MaxNameLength {
+		return Name{}, fmt.Errorf("name is longer than %d octets", MaxNameLength)
+	}
+
+	return Name{Labels: labels, Rooted: rooted}, nil
+}
+
+// ParseLabel parses a single label such as "example", "bücher" or "xn--bcher-kva".
+// ASCII labels may contain letters, digits and hyphens, but must not start or end with a hyphen.
+// Labels starting with "xn--" must be valid punycode for an internationalized label.
+func ParseLabel(label string) (Label, error) {
+	if label == "" {
+		return Label{}, fmt.Errorf("label is empty")
+	}
+
+	// Report ASCII characters that are never allowed before any IDNA processing, which would only name the code point
+	for _, r := range label {
+		if r < utf8.RuneSelf && !isLDH(r) {
+			return Label{}, fmt.Errorf("label %q contains invalid character %q", label, r)
+		}
+	}
+	if strings.HasPrefix(label, "-") {
+		return Label{}, fmt.Errorf("label %q starts with a hyphen", label)
+	}
+	if strings.HasSuffix(label, "-") {
+		return Label{}, fmt.Errorf("label %q ends with a hyphen", label)
+	}
+
+	ascii := strings.ToLower(label)
+	if !isASCII(label) {
+		var err error
+		if ascii, err = profile.ToASCII(label); err != nil {
+			return Label{}, fmt.Errorf("label %q is not a valid internationalized label: %s", label, strings.TrimPrefix(err.Error(), "idna: "))
+		}
+		// Mapping can turn characters such as the ideographic full stop into ASCII ones that are not allowed in a label
+		if strings.IndexFunc(ascii, func(r rune) bool { return !isLDH(r) }) >= 0 {
+			return Label{}, fmt.Errorf("label %q is not a valid internationalized label", label)
+		}
+	}
+	if len(ascii) > MaxLabelLength {
+		return Label{}, fmt.Errorf("label %q is longer than %d octets", label, MaxLabelLength)
+	}
+
+	if !strings.HasPrefix(ascii, "xn--") {
+		return Label{ASCII: ascii, Unicode: ascii}, nil
+	}
+
+	// An A-label must decode to a non-ASCII U-label that encodes back to the same A-label (RFC 5890 section 2.3.2.1)
+	unicode, err := profile.ToUnicode(ascii)
+	if err != nil || unicode == "" || isASCII(unicode) {
+		return Label{}, fmt.Errorf("label %q is not a valid punycode label", label)
+	}
+	if reencoded, err := profile.ToASCII(unicode); err != nil || reencoded != ascii {
+		return Label{}, fmt.Errorf("label %q is not a valid punycode label", label)
+	}
+
+	return Label{ASCII: ascii, Unicode: unicode}, nil
+}
+
+// isLDH reports whether r is a letter, digit or hyphen.
+func isLDH(r rune) bool {
+	return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-'
+}
+
+// isASCII reports whether s consists of ASCII characters only.
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
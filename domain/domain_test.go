@@ -0,0 +1,139 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("ASCII names", func(t *testing.T) {
+		name, err := Parse("WWW.Example.com")
+		assert.NoError(t, err)
+		assert.Equal(t, "www.example.com", name.ASCII())
+		assert.Equal(t, "www.example.com", name.Unicode())
+		assert.Equal(t, "com", name.TLD().ASCII)
+		assert.False(t, name.Rooted)
+
+		name, err = Parse("a.b-c.123.example.")
+		assert.NoError(t, err)
+		assert.Equal(t, "a.b-c.123.example", name.ASCII())
+		assert.True(t, name.Rooted)
+	})
+
+	t.Run("Internationalized names", func(t *testing.T) {
+		name, err := Parse("Bücher.example")
+		assert.NoError(t, err)
+		assert.Equal(t, "xn--bcher-kva.example", name.ASCII())
+		assert.Equal(t, "bücher.example", name.Unicode())
+
+		name, err = Parse("xn--bcher-kva.example")
+		assert.NoError(t, err)
+		assert.Equal(t, "bücher.example", name.Unicode())
+
+		name, err = Parse("例え.テスト")
+		assert.NoError(t, err)
+		assert.Equal(t, "xn--r8jz45g.xn--zckzah", name.ASCII())
+	})
+
+	t.Run("Invalid names", func(t *testing.T) {
+		for input, reason := range map[string]string{
+			"":                 "name is empty",
+			".":                "name is empty",
+			"example..com":     "name has an empty label",
+			".example.com":     "name has an empty label",
+			"exa_mple.com":     `label "exa_mple" contains invalid character '_'`,
+			"ex@mple.com":      `label "ex@mple" contains invalid character '@'`,
+			"example domain":   `label "example domain" contains invalid character ' '`,
+			"-example.com":     `label "-example" starts with a hyphen`,
+			"example-.com":     `label "example-" ends with a hyphen`,
+			"example.com-":     `label "com-" ends with a hyphen`,
+			"xn--.example":     `label "xn--" ends with a hyphen`,
+			"xn--ab-c.example": `label "xn--ab-c" is not a valid punycode label`,
+			"xn--abc.example":  `label "xn--abc" is not a valid punycode label`,
+			"a。b.example":      `label "a。b" is not a valid internationalized label`,
+			"اa.example":       `label "اa" is not a valid internationalized label: invalid label "اa"`,
+			"\xff.example":     "name is not valid UTF-8",
+		} {
+			_, err := Parse(input)
+			if assert.Error(t, err, input) {
+				assert.Equal(t, reason, err.Error(), input)
+			}
+		}
+	})
+
+	t.Run("Length limits", func(t *testing.T) {
+		label := strings.Repeat("a", 63)
+		_, err := Parse(label + ".com")
+		assert.NoError(t, err)
+
+		_, err = Parse(label + "a.com")
+		assert.EqualError(t, err, `label "`+label+`a" is longer than 63 octets`)
+
+		// The limit applies to the punycode form, 60 characters here
+		_, err = Parse(strings.Repeat("bücher", 10) + ".com")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "is longer than 63 octets")
+
+		name := strings.Join([]string{label, label, label, strings.Repeat("a", 61)}, ".")
+		_, err = Parse(name)
+		assert.NoError(t, err)
+		_, err = Parse(name + ".")
+		assert.NoError(t, err, "the trailing dot does not count")
+		_, err = Parse(name + "a")
+		assert.EqualError(t, err, "name is longer than 253 octets")
+	})
+}
+
+func TestParseLabel(t *testing.T) {
+	label, err := ParseLabel("Bücher")
+	assert.NoError(t, err)
+	assert.Equal(t, Label{ASCII: "xn--bcher-kva", Unicode: "bücher"}, label)
+
+	_, err = ParseLabel("a.b")
+	assert.EqualError(t, err, `label "a.b" contains invalid character '.'`)
+
+	_, err = ParseLabel("")
+	assert.EqualError(t, err, "label is empty")
+}
+
+func TestRegistrableDomain(t *testing.T) {
+	for input, expected := range map[string]string{
+		"www.example.com":     "example.com",
+		"example.com":         "example.com",
+		"a.b.example.co.uk":   "example.co.uk",
+		"shop.bücher.example": "xn--bcher-kva.example",
+		"project.github.io":   "project.github.io",
+		"Example.COM.":        "example.com",
+	} {
+		name, err := Parse(input)
+		assert.NoError(t, err, input)
+		registrable, err := name.RegistrableDomain()
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, registrable, input)
+	}
+
+	for input, reason := range map[string]string{
+		"co.uk":     "co.uk is a public suffix",
+		"github.io": "github.io is a public suffix",
+		"com":       "com is a public suffix",
+	} {
+		name, err := Parse(input)
+		assert.NoError(t, err, input)
+		_, err = name.RegistrableDomain()
+		assert.EqualError(t, err, reason, input)
+	}
+}
+
+func TestPublicSuffix(t *testing.T) {
+	name, _ := Parse("www.example.co.uk")
+	suffix, icann := name.PublicSuffix()
+	assert.Equal(t, "co.uk", suffix)
+	assert.True(t, icann)
+
+	name, _ = Parse("project.github.io")
+	suffix, icann = name.PublicSuffix()
+	assert.Equal(t, "github.io", suffix)
+	assert.False(t, icann)
+}
@@ -0,0 +1,287 @@
+// Package email parses email addresses following RFC 5322 and its internationalized form from RFC 6531.
+//
+// Parse accepts a bare address (addr-spec) such as `jane.doe@example.com`; ParseWithName also accepts
+// an address with a display name such as `"Jane Doe" <jane.doe@example.com>`. Both report why an address
+// is invalid, and convert internationalized domains to and from their ASCII (punycode) form using IDNA2008.
+// Comments and folding white space, which are obsolete in addresses, are not supported.
+package email
+
+import (
+	"fmt"
+	"mime"
+	"net/netip"
+	"strings"
+	"unicode/utf8"
+
+	"go-runtimevalidation/domain"
+)
+
+// Maximum lengths in octets, from RFC 5321 section 4.5.3.1.
+const (
+	maxLocalLength   = 64
+	maxAddressLength = 254
+)
+
+// Address is a parsed email address.
+type Address struct {
+	Name          string // display name, decoded; empty if the address has none
+	Local         string // local part as written, including quotes if it is quoted
+	Domain        string // domain as written, including brackets if it is a domain literal
+	ASCIIDomain   string // domain in lower case ASCII form, with internationalized labels in punycode
+	UnicodeDomain string // domain in lower case Unicode form
+}
+
+// String returns the address without its display name, e.g. `jane.doe@example.com`.
+func (a *Address) String() string {
+	return a.Local + "@" + a.Domain
+}
+
+// IsDomainLiteral reports whether the domain is an IP address literal such as `[192.0.2.1]`.
+func (a *Address) IsDomainLiteral() bool {
+	return strings.HasPrefix(a.Domain, "[")
+}
+
+// Parse parses a bare email address such as `jane.doe@example.com` or `"jane doe"@bücher.example`.
+//
+// Example:
+//
+//	addr, err := email.Parse("Jane.Doe@Bücher.example")
+//	// addr.Local: "Jane.Doe", addr.ASCIIDomain: "xn--bcher-kva.example"
+//	_, err = email.Parse("jane@example..com")
+//	// err: "domain has an empty label"
+func Parse(address string) (*Address, error) {
+	if !utf8.ValidString(address) {
+		return nil, fmt.Errorf("address is not valid UTF-8")
+	}
+	if address == "" {
+		return nil, fmt.Errorf("address is empty")
+	}
+
+	local, rest, err := splitLocal(address)
+	if err != nil {
+		return nil, err
+	}
+
+	domain, ok := strings.CutPrefix(rest, "@")
+	if !ok {
+		return nil, fmt.Errorf("missing @")
+	}
+	ascii, unicode, err := parseDomain(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	if length := len(local) + 1 + len(ascii); length > maxAddressLength {
+		return nil, fmt.Errorf("address is longer than %d octets", maxAddressLength)
+	}
+
+	return &Address{Local: local, Domain: domain, ASCIIDomain: ascii, UnicodeDomain: unicode}, nil
+}
+
+// ParseWithName parses an address that may have a display name, such as `Jane Doe <jane@example.com>`,
+// `"Doe, Jane" <jane@example.com>`, `<jane@example.com>` or a bare `jane@example.com`.
+// Display names encoded as RFC 2047 encoded words, e.g. `=?UTF-8?Q?J=C3=B6rg?=`, are decoded.
+func ParseWithName(address string) (*Address, error) {
+	trimmed := strings.TrimSpace(address)
+	if !strings.HasSuffix(trimmed, ">") {
+		return Parse(trimmed)
+	}
+
+	open := angleBracket(trimmed)
+	if open < 0 {
+		return nil, fmt.Errorf("missing < before the address")
+	}
+
+	name, err := parseDisplayName(strings.TrimSpace(trimmed[:open]))
+	if err != nil {
+		return nil, err
+	}
+
+	addr, err := Parse(trimmed[open+1 : len(trimmed)-1])
+	if err != nil {
+		return nil, err
+	}
+	addr.Name = name
+	return addr, nil
+}
+
+// splitLocal returns the local part at the start of the address and the remainder, which starts with @ if the local part is valid.
+func splitLocal(address string) (string, string, error) {
+	if strings.HasPrefix(address, `"`) {
+		end, err := quotedStringEnd(address)
+		if err != nil {
+			return "", "", fmt.Errorf("local part %w", err)
+		}
+		if end > maxLocalLength {
+			return "", "", fmt.Errorf("local part is longer than %d octets", maxLocalLength)
+		}
+		return address[:end], address[end:], nil
+	}
+
+	local, _, found := strings.Cut(address, "@")
+	if !found {
+		return "", "", fmt.Errorf("missing @")
+	}
+	if local == "" {
+		return "", "", fmt.Errorf("local part is empty")
+	}
+	if len(local) > maxLocalLength {
+		return "", "", fmt.Errorf("local part is longer than %d octets", maxLocalLength)
+	}
+	if strings.HasPrefix(local, ".") || strings.HasSuffix(local, ".") {
+		return "", "", fmt.Errorf("local part starts or ends with a dot")
+	}
+	if strings.Contains(local, "..") {
+		return "", "", fmt.Errorf("local part has consecutive dots")
+	}
+	for _, r := range local {
+		if r != '.' && !isAtext(r) {
+			return "", "", fmt.Errorf("invalid character %q in local part", r)
+		}
+	}
+
+	return local, address[len(local):], nil
+}
+
+// quotedStringEnd returns the index just after the closing quote of the quoted string at the start of s.
+func quotedStringEnd(s string) (int, error) {
+	escaped := false
+	for i, r := range s {
+		switch {
+		case i == 0:
+			// opening quote
+		case escaped:
+			if r < ' ' && r != '\t' || r == 0x7f {
+				return 0, fmt.Errorf("has an invalid escaped character %q", r)
+			}
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '"':
+			return i + 1, nil
+		case r < ' ' && r != '\t' || r == 0x7f:
+			return 0, fmt.Errorf("has an invalid character %q in quotes", r)
+		}
+	}
+	return 0, fmt.Errorf("has an unterminated quoted string")
+}
+
+// isAtext reports whether r may appear in an unquoted local part or display name word (RFC 5322 atext, extended by RFC 6531).
+func isAtext(r rune) bool {
+	switch {
+	case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		return true
+	case strings.ContainsRune("!#$%&'*+-/=?^_`{|}~", r):
+		return true
+	default:
+		return r >= 0x80 && r != utf8.RuneError
+	}
+}
+
+// parseDomain validates a domain name or domain literal and returns its ASCII and Unicode forms.
+func parseDomain(value string) (string, string, error) {
+	if value == "" {
+		return "", "", fmt.Errorf("domain is empty")
+	}
+
+	if strings.HasPrefix(value, "[") {
+		if err := parseDomainLiteral(value); err != nil {
+			return "", "", err
+		}
+		return value, value, nil
+	}
+
+	name, err := domain.Parse(value)
+	if err != nil {
+		return "", "", fmt.Errorf("domain %w", err)
+	}
+	if name.Rooted {
+		return "", "", fmt.Errorf("domain name ends with a dot")
+	}
+	if len(name.Labels) < 2 {
+		return "", "", fmt.Errorf("domain %q has no top-level domain", value)
+	}
+	if tld := name.TLD().ASCII; strings.Trim(tld, "0123456789") == "" {
+		return "", "", fmt.Errorf("top-level domain %q is numeric", tld)
+	}
+
+	return name.ASCII(), name.Unicode(), nil
+}
+
+// parseDomainLiteral validates an address literal such as `[192.0.2.1]` or `[IPv6:2001:db8::1]`.
+func parseDomainLiteral(domain string) error {
+	literal, ok := strings.CutSuffix(strings.TrimPrefix(domain, "["), "]")
+	if !ok {
+		return fmt.Errorf("domain literal %q is missing ]", domain)
+	}
+
+	if v6, ok := strings.CutPrefix(literal, "IPv6:"); ok {
+		if ip, err := netip.ParseAddr(v6); err == nil && ip.Is6() && !ip.Is4In6() && ip.Zone() == "" {
+			return nil
+		}
+		return fmt.Errorf("domain literal %q is not a valid IPv6 address", domain)
+	}
+
+	if ip, err := netip.ParseAddr(literal); err == nil && ip.Is4() {
+		return nil
+	}
+	return fmt.Errorf("domain literal %q is not a valid IPv4 address", domain)
+}
+
+// angleBracket returns the index of the < that starts the address in a name-addr, ignoring any in a quoted display name.
+func angleBracket(s string) int {
+	quoted, escaped := false, false
+	for i, r := range s {
+		switch {
+		case escaped:
+			escaped = false
+		case quoted && r == '\\':
+			escaped = true
+		case r == '"':
+			quoted = !quoted
+		case !quoted && r == '<':
+			return i
+		}
+	}
+	return -1
+}
+
+// parseDisplayName decodes a display name, which is a quoted string or a sequence of words.
+func parseDisplayName(name string) (string, error) {
+	if name == "" {
+		return "", nil
+	}
+
+	if strings.HasPrefix(name, `"`) {
+		end, err := quotedStringEnd(name)
+		if err != nil {
+			return "", fmt.Errorf("display name %w", err)
+		}
+		if end != len(name) {
+			return "", fmt.Errorf("unexpected text after the quoted display name")
+		}
+		var unquoted strings.Builder
+		escaped := false
+		for _, r := range name[1 : end-1] {
+			if !escaped && r == '\\' {
+				escaped = true
+				continue
+			}
+			escaped = false
+			unquoted.WriteRune(r)
+		}
+		return unquoted.String(), nil
+	}
+
+	for _, r := range name {
+		if !isAtext(r) && r != ' ' && r != '\t' && r != '.' {
+			return "", fmt.Errorf("invalid character %q in display name, quote the name to use it", r)
+		}
+	}
+
+	decoded, err := new(mime.WordDecoder).DecodeHeader(strings.Join(strings.Fields(name), " "))
+	if err != nil {
+		return "", fmt.Errorf("invalid encoded word in display name: %w", err)
+	}
+	return decoded, nil
+}
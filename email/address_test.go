@@ -0,0 +1,149 @@
+package email
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("Valid addresses", func(t *testing.T) {
+		for _, address := range []string{
+			"test@example.com",
+			"user+label@domain.co.uk",
+			"first.last@sub.domain.com",
+			"12345@example.io",
+			"!#$%&'*+-/=?^_`{|}~@example.com",
+			`"john doe"@example.com`,
+			`"quote\"inside"@example.com`,
+			"jane@[192.0.2.1]",
+			"jane@[IPv6:2001:db8::1]",
+		} {
+			_, err := Parse(address)
+			assert.NoError(t, err, address)
+		}
+	})
+
+	t.Run("Parts", func(t *testing.T) {
+		addr, err := Parse("Jane.Doe@Example.COM")
+		assert.NoError(t, err)
+		assert.Equal(t, "Jane.Doe", addr.Local)
+		assert.Equal(t, "Example.COM", addr.Domain)
+		assert.Equal(t, "example.com", addr.ASCIIDomain)
+		assert.Equal(t, "Jane.Doe@Example.COM", addr.String())
+		assert.False(t, addr.IsDomainLiteral())
+	})
+
+	t.Run("Internationalized addresses", func(t *testing.T) {
+		addr, err := Parse("jörg@bücher.example")
+		assert.NoError(t, err)
+		assert.Equal(t, "jörg", addr.Local)
+		assert.Equal(t, "xn--bcher-kva.example", addr.ASCIIDomain)
+		assert.Equal(t, "bücher.example", addr.UnicodeDomain)
+
+		addr, err = Parse("info@xn--bcher-kva.example")
+		assert.NoError(t, err)
+		assert.Equal(t, "bücher.example", addr.UnicodeDomain)
+
+		addr, err = Parse("用户@例え.テスト")
+		assert.NoError(t, err)
+		assert.Equal(t, "xn--r8jz45g.xn--zckzah", addr.ASCIIDomain)
+	})
+
+	t.Run("Invalid addresses", func(t *testing.T) {
+		for address, reason := range map[string]string{
+			"":                      "address is empty",
+			"invalid-email":         "missing @",
+			"@missinglocal.com":     "local part is empty",
+			".jane@example.com":     "local part starts or ends with a dot",
+			"jane..doe@example.com": "local part has consecutive dots",
+			"jane doe@example.com":  `invalid character ' ' in local part`,
+			`"jane@example.com`:     "local part has an unterminated quoted string",
+			`"jane"doe@example.com`: "missing @",
+			"jane@":                 "domain is empty",
+			"jane@localhost":        `domain "localhost" has no top-level domain`,
+			"missingdomain@.com":    "domain name has an empty label",
+			"user@domain..com":      "domain name has an empty label",
+			"user@domain.com.":      "domain name ends with a dot",
+			"user@domain@extra.com": `domain label "domain@extra" contains invalid character '@'`,
+			"user@exa_mple.com":     `domain label "exa_mple" contains invalid character '_'`,
+			"user@-example.com":     `domain label "-example" starts with a hyphen`,
+			"user@xn--.example":     `domain label "xn--" ends with a hyphen`,
+			"user@xn--ab-c.example": `domain label "xn--ab-c" is not a valid punycode label`,
+			"user@xn--abc.example":  `domain label "xn--abc" is not a valid punycode label`,
+			"user@example.123":      `top-level domain "123" is numeric`,
+			"user@[192.0.2.300]":    `domain literal "[192.0.2.300]" is not a valid IPv4 address`,
+			"user@[IPv6:192.0.2.1]": `domain literal "[IPv6:192.0.2.1]" is not a valid IPv6 address`,
+			"user@[192.0.2.1":       `domain literal "[192.0.2.1" is missing ]`,
+			"user@" + strings.Repeat("a", 64) + ".com": `domain label "` + strings.Repeat("a", 64) + `" is longer than 63 octets`,
+		} {
+			_, err := Parse(address)
+			if assert.Error(t, err, address) {
+				assert.Equal(t, reason, err.Error(), address)
+			}
+		}
+	})
+
+	t.Run("Length limits", func(t *testing.T) {
+		_, err := Parse(strings.Repeat("a", 64) + "@example.com")
+		assert.NoError(t, err)
+
+		_, err = Parse(strings.Repeat("a", 65) + "@example.com")
+		assert.EqualError(t, err, "local part is longer than 64 octets")
+
+		label := strings.Repeat("a", 63)
+		domain := strings.Join([]string{label, label, label, strings.Repeat("a", 61)}, ".")
+		_, err = Parse("j@" + domain + "x")
+		assert.EqualError(t, err, "domain name is longer than 253 octets")
+
+		_, err = Parse(strings.Repeat("a", 10) + "@" + domain)
+		assert.EqualError(t, err, "address is longer than 254 octets")
+	})
+}
+
+func TestParseWithName(t *testing.T) {
+	t.Run("Display names", func(t *testing.T) {
+		for address, name := range map[string]string{
+			`"Jane" <j@x.io>`:                "Jane",
+			"Jane Doe <j@x.io>":              "Jane Doe",
+			`"Doe, Jane" <j@x.io>`:           "Doe, Jane",
+			`"Jane \"JD\" Doe" <j@x.io>`:     `Jane "JD" Doe`,
+			"<j@x.io>":                       "",
+			"  Jörg   Müller  <j@x.io> ":     "Jörg Müller",
+			"=?UTF-8?Q?J=C3=B6rg?= <j@x.io>": "Jörg",
+			"j@x.io":                         "",
+			`"Angle <bracket>" <j@x.io>`:     "Angle <bracket>",
+		} {
+			addr, err := ParseWithName(address)
+			if assert.NoError(t, err, address) {
+				assert.Equal(t, name, addr.Name, address)
+				assert.Equal(t, "j@x.io", addr.String(), address)
+			}
+		}
+	})
+
+	t.Run("Invalid display names", func(t *testing.T) {
+		for address, reason := range map[string]string{
+			"Doe, Jane <j@x.io>":  `invalid character ',' in display name, quote the name to use it`,
+			`"Jane <j@x.io>`:      "missing < before the address",
+			`"Jane" Doe <j@x.io>`: "unexpected text after the quoted display name",
+			"Jane <j@@x.io>":      `domain label "@x" contains invalid character '@'`,
+			"Jane <jane>":         "missing @",
+		} {
+			_, err := ParseWithName(address)
+			if assert.Error(t, err, address) {
+				assert.Equal(t, reason, err.Error(), address)
+			}
+		}
+	})
+}
+
+func TestIsDisposable(t *testing.T) {
+	assert.True(t, IsDisposable("mailinator.com"))
+	assert.True(t, IsDisposable("Mailinator.COM"))
+	assert.True(t, IsDisposable("eu.mailinator.com"))
+	assert.False(t, IsDisposable("example.com"))
+	assert.False(t, IsDisposable("notmailinator.com"))
+	assert.False(t, IsDisposable("com"))
+}
@@ -0,0 +1,47 @@
+package email
+
+import (
+	_ "embed"
+	"strings"
+	"sync"
+)
+
+//go:embed data/disposable-domains.txt
+var disposableList string
+
+var (
+	disposableOnce    sync.Once
+	disposableDomains map[string]bool
+)
+
+// IsDisposable reports whether the domain, in ASCII form, belongs to a disposable email provider
+// on the embedded deny list. Subdomains of listed domains are disposable as well.
+//
+// Example:
+//
+//	email.IsDisposable("mailinator.com")      // true
+//	email.IsDisposable("eu.mailinator.com")   // true
+//	email.IsDisposable("example.com")         // false
+func IsDisposable(domain string) bool {
+	disposableOnce.Do(func() {
+		disposableDomains = map[string]bool{}
+		for _, line := range strings.Split(disposableList, "\n") {
+			if line != "" && !strings.HasPrefix(line, "#") {
+				disposableDomains[line] = true
+			}
+		}
+	})
+
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+	for domain != "" {
+		if disposableDomains[domain] {
+			return true
+		}
+		_, parent, found := strings.Cut(domain, ".")
+		if !found {
+			break
+		}
+		domain = parent
+	}
+	return false
+}
@@ -0,0 +1,44 @@
+package functions
+
+import (
+	"sync"
+	"time"
+)
+
+var (
+	clockMu sync.RWMutex
+	clock   = time.Now
+)
+
+// Now returns the current time according to the validation clock.
+// Every rule that compares against the current time, as well as the `$now()` function, uses it
+// instead of calling time.Now directly, so that tests can be made deterministic with SetClock.
+func Now() time.Time {
+	clockMu.RLock()
+	defer clockMu.RUnlock()
+	return clock()
+}
+
+// SetClock replaces the validation clock and returns a function that restores the previous one.
+// Passing nil restores time.Now.
+//
+// Example:
+//
+//	restore := SetClock(func() time.Time { return time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC) })
+//	defer restore()
+func SetClock(now func() time.Time) func() {
+	if now == nil {
+		now = time.Now
+	}
+
+	clockMu.Lock()
+	previous := clock
+	clock = now
+	clockMu.Unlock()
+
+	return func() {
+		clockMu.Lock()
+		clock = previous
+		clockMu.Unlock()
+	}
+}
@@ -1,12 +1,43 @@
 package functions
 
 import (
+	"errors"
 	"fmt"
+	"math"
+	"math/big"
 	"reflect"
 	"strconv"
 	"time"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"go-runtimevalidation/semver"
+
+	"github.com/rivo/uniseg"
 )
 
+// LenMode selects how the length of a string is measured.
+// Collections (slices, arrays and maps) are always measured by their number of elements.
+type LenMode string
+
+const (
+	LenBytes     LenMode = "bytes"     // number of bytes in the UTF-8 encoding, same as len(s)
+	LenRunes     LenMode = "runes"     // number of Unicode code points
+	LenGraphemes LenMode = "graphemes" // number of user-perceived characters (extended grapheme clusters)
+	LenUTF16     LenMode = "utf16"     // number of UTF-16 code units, matches JavaScript's String.length and browser maxlength
+)
+
+// ParseLenMode converts a mode name such as "runes" into a LenMode.
+// Returns false if the name is not a known length mode.
+func ParseLenMode(name string) (LenMode, bool) {
+	switch mode := LenMode(name); mode {
+	case LenBytes, LenRunes, LenGraphemes, LenUTF16:
+		return mode, true
+	default:
+		return "", false
+	}
+}
+
 // GetLen evaluates the length of a value and returns it as an integer.
 // It supports types that have a defined length, such as strings, slices, arrays, and maps.
 //
@@ -35,6 +66,54 @@ func GetLen(value any) (int, error) {
 	}
 }
 
+// GetLenMode evaluates the length of a value using the given length mode.
+// Strings are measured according to the mode, so "héllo" has 6 bytes but 5 runes,
+// and "👍🏽" has 8 bytes, 2 runes, 4 UTF-16 code units and 1 grapheme cluster.
+// Slices, arrays and maps are measured by their number of elements regardless of the mode.
+//
+// This function backs the `$len_bytes`, `$len_runes`, `$len_graphemes` and `$len_utf16`
+// functions as well as the mode argument of rules such as `length:runes,5`.
+//
+// Parameters:
+//   - value: The input value for which the length is to be evaluated. It can be of type string, slice, array, or map.
+//   - mode: The length mode used to measure strings.
+//
+// Returns:
+//   - An integer representing the length of the input value.
+//   - An error if the type of the value does not support a length property, or if the mode is unknown.
+//
+// Example:
+//
+//	GetLenMode("héllo", LenBytes)  // Returns: 6, nil
+//	GetLenMode("héllo", LenRunes)  // Returns: 5, nil
+//	GetLenMode([]int{1, 2, 3}, LenRunes)  // Returns: 3, nil
+func GetLenMode(value any, mode LenMode) (int, error) {
+	if _, ok := ParseLenMode(string(mode)); !ok {
+		return 0, fmt.Errorf("unknown length mode: %s", mode)
+	}
+
+	val := reflect.ValueOf(value)
+	if val.Kind() != reflect.String {
+		return GetLen(value)
+	}
+
+	str := val.String()
+	switch mode {
+	case LenRunes:
+		return utf8.RuneCountInString(str), nil
+	case LenGraphemes:
+		return uniseg.GraphemeClusterCount(str), nil
+	case LenUTF16:
+		count := 0
+		for _, r := range str {
+			count += utf16.RuneLen(r)
+		}
+		return count, nil
+	default:
+		return len(str), nil
+	}
+}
+
 // GetIntAny converts an input of various types into an int64 value.
 // It supports conversion from multiple data types such as int, uint, and string,
 // making it flexible for use in validation rules like `min:$int($DateField)`.
@@ -50,9 +129,10 @@ func GetLen(value any) (int, error) {
 //
 // Supported types:
 //   - int, int8, int16, int32, int64
-//   - uint, uint8, uint16, uint32, uint64
+//   - uint, uint8, uint16, uint32, uint64 (an error is returned for values above math.MaxInt64)
+//   - *big.Int (an error is returned for values outside the range of int64)
 //   - time.Duration (parsed as int64 based on the duration)
-//   - string (parsed using getInt function)
+//   - string (parsed using getInt function, an error is returned for integers outside the range of int64)
 //
 // Example:
 //
@@ -65,7 +145,15 @@ func GetInt(input any) (int64, error) {
 	case int, int32, int8, int16, int64:
 		return value.Int(), nil
 	case uint, uint8, uint16, uint32, uint64:
+		if value.Uint() > math.MaxInt64 {
+			return 0, fmt.Errorf("%d of type %T overflows int64", v, v)
+		}
 		return int64(value.Uint()), nil
+	case *big.Int:
+		if v == nil || !v.IsInt64() {
+			return 0, fmt.Errorf("%v of type %T overflows int64", v, v)
+		}
+		return v.Int64(), nil
 	case time.Duration:
 		// Return the duration in nanoseconds
 		return int64(value.Int()), nil
@@ -74,9 +162,13 @@ func GetInt(input any) (int64, error) {
 		return v.Unix(), nil
 	case string:
 		// Try to parse the string as an int64 first
-		if i, err := strconv.ParseInt(value.String(), 0, 64); err == nil {
+		i, err := strconv.ParseInt(value.String(), 0, 64)
+		if err == nil {
 			return i, nil
 		}
+		if errors.Is(err, strconv.ErrRange) {
+			return 0, fmt.Errorf("%q overflows int64", v)
+		}
 		// Try to parse the string as a time
 		if t, err := time.Parse(time.RFC3339, value.String()); err == nil {
 			return t.Unix(), nil
@@ -102,7 +194,8 @@ func GetInt(input any) (int64, error) {
 //   - int, int8, int16, int32, int64
 //   - uint, uint8, uint16, uint32, uint64
 //   - float32, float64
-//   - string (parsed using strconv.ParseFloat)
+//   - *big.Int, *big.Float, *big.Rat (rounded to the nearest float64, an error is returned when it overflows)
+//   - string (parsed using strconv.ParseFloat, an error is returned when it overflows)
 //
 // Example:
 //
@@ -120,11 +213,25 @@ func GetFloat(input any) (float64, error) {
 		return float64(value.Float()), nil
 	case float64:
 		return value.Float(), nil
+	case *big.Int, *big.Float, *big.Rat:
+		number, err := GetNumber(v)
+		if err != nil {
+			return 0, err
+		}
+		f, _ := number.Float64()
+		if math.IsInf(f, 0) {
+			return 0, fmt.Errorf("%s overflows float64", FormatNumber(number))
+		}
+		return f, nil
 	case string:
 		// Try to parse the string as a float64
-		if f, err := strconv.ParseFloat(value.String(), 64); err == nil {
+		f, err := strconv.ParseFloat(value.String(), 64)
+		if err == nil {
 			return f, nil
 		}
+		if errors.Is(err, strconv.ErrRange) && math.IsInf(f, 0) {
+			return 0, fmt.Errorf("%q overflows float64", v)
+		}
 		return 0, fmt.Errorf("failed to parse %q of type %T as float64", value, v)
 	default:
 		return 0, fmt.Errorf("failed to parse %q of type %T as float64", value, v)
@@ -173,3 +280,177 @@ func GetString(input any) (string, error) {
 		return "", fmt.Errorf("failed to parse %q of type %T as string", value, v)
 	}
 }
+
+// GetBytes returns the raw content of a document held in a string or byte slice.
+// Named byte slice types, such as json.RawMessage, are accepted as well.
+//
+// Example:
+//
+//	GetBytes(`{"a":1}`)  // Returns: []byte(`{"a":1}`), nil
+//	GetBytes(42)  // Returns: nil, error
+func GetBytes(input any) ([]byte, error) {
+	value := reflect.ValueOf(input)
+	switch {
+	case value.Kind() == reflect.String:
+		return []byte(value.String()), nil
+	case value.Kind() == reflect.Slice && value.Type().Elem().Kind() == reflect.Uint8:
+		return value.Bytes(), nil
+	default:
+		return nil, fmt.Errorf("failed to parse %v of type %T as bytes", input, input)
+	}
+}
+
+// timeLayouts lists the layouts GetTime tries, in order, when parsing a string.
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	time.DateTime,
+	time.DateOnly,
+}
+
+// GetTime converts an input of various types into a time.Time value.
+// It is used by the temporal rules such as `before:$Deadline` or `after:2024-01-01`.
+//
+// Parameters:
+//   - input: The value to be converted. It can be of type time.Time, *time.Time, int or string.
+//
+// Returns:
+//   - The converted time.Time value.
+//   - An error if the input type is unsupported or the conversion fails.
+//
+// Supported types:
+//   - time.Time and *time.Time
+//   - int, int8, int16, int32, int64 (interpreted as Unix seconds, the inverse of GetInt)
+//   - string (parsed as RFC 3339, "2006-01-02 15:04:05" or "2006-01-02", in UTC unless an offset is given)
+//
+// Example:
+//
+//	GetTime("2024-01-02T15:04:05Z")  // Returns: 2024-01-02 15:04:05 +0000 UTC, nil
+//	GetTime("2024-01-02")  // Returns: 2024-01-02 00:00:00 +0000 UTC, nil
+//	GetTime("tomorrow")  // Returns: time.Time{}, error
+func GetTime(input any) (time.Time, error) {
+	switch v := input.(type) {
+	case time.Time:
+		return v, nil
+	case *time.Time:
+		if v == nil {
+			return time.Time{}, fmt.Errorf("failed to parse nil of type %T as time", v)
+		}
+		return *v, nil
+	case int, int8, int16, int32, int64:
+		return time.Unix(reflect.ValueOf(input).Int(), 0).UTC(), nil
+	case string:
+		for _, layout := range timeLayouts {
+			if t, err := time.Parse(layout, v); err == nil {
+				return t, nil
+			}
+		}
+		return time.Time{}, fmt.Errorf("failed to parse %q of type %T as time", v, v)
+	default:
+		return time.Time{}, fmt.Errorf("failed to parse %v of type %T as time", v, v)
+	}
+}
+
+// GetDuration converts an input of various types into a time.Duration value.
+// It is used by the duration rules such as `maxduration:90m`.
+//
+// Parameters:
+//   - input: The value to be converted. It can be of type time.Duration, int or string.
+//
+// Returns:
+//   - The converted time.Duration value.
+//   - An error if the input type is unsupported or the conversion fails.
+//
+// Supported types:
+//   - time.Duration
+//   - int, int8, int16, int32, int64 (interpreted as nanoseconds, the inverse of GetInt)
+//   - string (parsed using time.ParseDuration, e.g. "90m" or "1h30m")
+//
+// Example:
+//
+//	GetDuration("90m")  // Returns: 1h30m0s, nil
+//	GetDuration(time.Second)  // Returns: 1s, nil
+//	GetDuration("soon")  // Returns: 0, error
+func GetDuration(input any) (time.Duration, error) {
+	switch v := input.(type) {
+	case time.Duration:
+		return v, nil
+	case int, int8, int16, int32, int64:
+		return time.Duration(reflect.ValueOf(input).Int()), nil
+	case string:
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return 0, fmt.Errorf("failed to parse %q of type %T as duration", v, v)
+		}
+		return d, nil
+	default:
+		return 0, fmt.Errorf("failed to parse %v of type %T as duration", v, v)
+	}
+}
+
+// GetDecimals returns the number of decimal places of a numeric input, ignoring trailing zeros.
+// It is used by the `decimals` rule, e.g. to check that an amount has no more decimals than its currency allows.
+//
+// Parameters:
+//   - input: The value to be inspected. It can be of any type supported by GetNumber, such as int, float, *big.Rat or a decimal string.
+//
+// Returns:
+//   - The number of significant digits after the decimal point.
+//   - An error if the input type is unsupported, the string is not a number, the value is not finite
+//     or has no finite decimal representation, such as big.NewRat(1, 3).
+//
+// Floats are inspected in their shortest decimal representation, so 0.1 has 1 decimal place
+// even though it cannot be represented exactly. Strings keep their exact digits, so "0.10000000000000001" has 17.
+//
+// Example:
+//
+//	GetDecimals(12.5)  // Returns: 1, nil
+//	GetDecimals("12.50")  // Returns: 1, nil
+//	GetDecimals(12)  // Returns: 0, nil
+func GetDecimals(input any) (int, error) {
+	number, err := GetNumber(input)
+	if err != nil {
+		return 0, err
+	}
+
+	places, ok := decimalPlaces(number)
+	if !ok {
+		return 0, fmt.Errorf("%s has no finite decimal representation", FormatNumber(number))
+	}
+	return places, nil
+}
+
+// GetSemVer converts an input into a semantic version.
+// It is used by the version rules such as `semvergt:$MinVersion` and by the `$semver()` function.
+//
+// Parameters:
+//   - input: The value to be converted. It can be of type semver.Version, *semver.Version or string.
+//
+// Returns:
+//   - The converted semver.Version value.
+//   - An error if the input type is unsupported or the string is not a valid version.
+//
+// Example:
+//
+//	GetSemVer("1.2.3-rc.1")  // Returns: 1.2.3-rc.1, nil
+//	GetSemVer("1.2")  // Returns: semver.Version{}, error
+func GetSemVer(input any) (semver.Version, error) {
+	switch v := input.(type) {
+	case semver.Version:
+		return v, nil
+	case *semver.Version:
+		if v == nil {
+			return semver.Version{}, fmt.Errorf("failed to parse nil %T as semver", v)
+		}
+		return *v, nil
+	}
+
+	value := reflect.ValueOf(input)
+	if value.Kind() != reflect.String {
+		return semver.Version{}, fmt.Errorf("failed to parse %v of type %T as semver", input, input)
+	}
+	version, err := semver.Parse(value.String())
+	if err != nil {
+		return semver.Version{}, fmt.Errorf("failed to parse %q as semver: %w", value.String(), err)
+	}
+	return version, nil
+}
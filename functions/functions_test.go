@@ -1,9 +1,13 @@
 package functions
 
 import (
+	"math"
+	"math/big"
 	"testing"
 	"time"
 
+	"go-runtimevalidation/semver"
+
 	"github.com/stretchr/testify/assert"
 )
 
@@ -51,6 +55,56 @@ func TestGetLen(t *testing.T) {
 	})
 }
 
+func TestGetLenMode(t *testing.T) {
+	t.Run("BytesMultiByte", func(t *testing.T) {
+		length, err := GetLenMode("héllo", LenBytes)
+		assert.NoError(t, err)
+		assert.Equal(t, 6, length)
+	})
+
+	t.Run("RunesMultiByte", func(t *testing.T) {
+		length, err := GetLenMode("héllo", LenRunes)
+		assert.NoError(t, err)
+		assert.Equal(t, 5, length)
+	})
+
+	t.Run("GraphemesCombiningMark", func(t *testing.T) {
+		length, err := GetLenMode("he\u0301llo", LenGraphemes) // e + combining acute accent
+		assert.NoError(t, err)
+		assert.Equal(t, 5, length)
+	})
+
+	t.Run("EmojiWithSkinTone", func(t *testing.T) {
+		emoji := "👍🏽"
+		bytes, _ := GetLenMode(emoji, LenBytes)
+		runes, _ := GetLenMode(emoji, LenRunes)
+		utf16, _ := GetLenMode(emoji, LenUTF16)
+		graphemes, _ := GetLenMode(emoji, LenGraphemes)
+		assert.Equal(t, 8, bytes)
+		assert.Equal(t, 2, runes)
+		assert.Equal(t, 4, utf16)
+		assert.Equal(t, 1, graphemes)
+	})
+
+	t.Run("SliceIgnoresMode", func(t *testing.T) {
+		length, err := GetLenMode([]string{"é", "👍"}, LenGraphemes)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, length)
+	})
+
+	t.Run("UnknownMode", func(t *testing.T) {
+		length, err := GetLenMode("hello", LenMode("words"))
+		assert.Error(t, err)
+		assert.Equal(t, 0, length)
+	})
+
+	t.Run("UnsupportedType", func(t *testing.T) {
+		length, err := GetLenMode(123, LenRunes)
+		assert.Error(t, err)
+		assert.Equal(t, 0, length)
+	})
+}
+
 func TestGetIntAny(t *testing.T) {
 	t.Run("Int", func(t *testing.T) {
 		value, err := GetInt(42)
@@ -105,4 +159,350 @@ func TestGetIntAny(t *testing.T) {
 		assert.Error(t, err)
 		assert.Equal(t, int64(0), value)
 	})
+
+	t.Run("BigInt", func(t *testing.T) {
+		value, err := GetInt(big.NewInt(-42))
+		assert.NoError(t, err)
+		assert.Equal(t, int64(-42), value)
+	})
+
+	t.Run("Overflow", func(t *testing.T) {
+		_, err := GetInt(uint64(math.MaxUint64))
+		assert.EqualError(t, err, "18446744073709551615 of type uint64 overflows int64")
+
+		_, err = GetInt(new(big.Int).Lsh(big.NewInt(1), 63))
+		assert.EqualError(t, err, "9223372036854775808 of type *big.Int overflows int64")
+
+		_, err = GetInt("9223372036854775808")
+		assert.EqualError(t, err, `"9223372036854775808" overflows int64`)
+	})
+}
+
+func TestGetFloat(t *testing.T) {
+	t.Run("BigNumbers", func(t *testing.T) {
+		value, err := GetFloat(big.NewRat(1, 4))
+		assert.NoError(t, err)
+		assert.Equal(t, 0.25, value)
+
+		value, err = GetFloat(big.NewFloat(1.5))
+		assert.NoError(t, err)
+		assert.Equal(t, 1.5, value)
+	})
+
+	t.Run("Overflow", func(t *testing.T) {
+		_, err := GetFloat("1e400")
+		assert.EqualError(t, err, `"1e400" overflows float64`)
+
+		_, err = GetFloat(new(big.Int).Lsh(big.NewInt(1), 1024))
+		assert.ErrorContains(t, err, "overflows float64")
+	})
+}
+
+func TestGetNumber(t *testing.T) {
+	t.Run("Integers", func(t *testing.T) {
+		value, err := GetNumber(uint64(math.MaxUint64))
+		assert.NoError(t, err)
+		assert.Equal(t, "18446744073709551615", value.RatString())
+
+		value, err = GetNumber(3 * time.Second)
+		assert.NoError(t, err)
+		assert.Equal(t, "3000000000", value.RatString())
+	})
+
+	t.Run("Floats are exact in their shortest representation", func(t *testing.T) {
+		value, err := GetNumber(0.1)
+		assert.NoError(t, err)
+		assert.Equal(t, "1/10", value.RatString())
+
+		value, err = GetNumber(float32(0.1))
+		assert.NoError(t, err)
+		assert.Equal(t, "1/10", value.RatString())
+
+		_, err = GetNumber(math.Inf(-1))
+		assert.EqualError(t, err, "-Inf is not a finite number")
+	})
+
+	t.Run("Big numbers", func(t *testing.T) {
+		i, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+		value, err := GetNumber(i)
+		assert.NoError(t, err)
+		assert.Equal(t, "123456789012345678901234567890", value.RatString())
+
+		value, err = GetNumber(big.NewRat(1, 3))
+		assert.NoError(t, err)
+		assert.Equal(t, "1/3", value.RatString())
+
+		f, _ := new(big.Float).SetPrec(200).SetString("1000000000000000000000.01")
+		value, err = GetNumber(f)
+		assert.NoError(t, err)
+		assert.Equal(t, "1000000000000000000000.01", FormatNumber(value))
+
+		_, err = GetNumber((*big.Int)(nil))
+		assert.EqualError(t, err, "failed to parse nil *big.Int as a number")
+	})
+
+	t.Run("Decimal strings", func(t *testing.T) {
+		for text, expected := range map[string]string{
+			"-12.50":                     "-25/2",
+			"+1.5e-3":                    "3/2000",
+			".5":                         "1/2",
+			"5.":                         "5",
+			"99999999999999999999.99":    "9999999999999999999999/100",
+			"2024-10-05T15:04:05Z":       "1728140645",
+			"1E3":                        "1000",
+			"0.000000000000000000000001": "1/1000000000000000000000000",
+		} {
+			value, err := GetNumber(text)
+			if assert.NoError(t, err, text) {
+				assert.Equal(t, expected, value.RatString(), text)
+			}
+		}
+	})
+
+	t.Run("Integer strings with base prefixes", func(t *testing.T) {
+		for text, expected := range map[string]string{
+			"0x10":                        "16",
+			"-0X1f":                       "-31",
+			"0b101":                       "5",
+			"0o17":                        "15",
+			"017":                         "15",
+			"1_000":                       "1000",
+			"0x1000000000000000000000000": "79228162514264337593543950336",
+		} {
+			value, err := GetNumber(text)
+			if assert.NoError(t, err, text) {
+				assert.Equal(t, expected, value.RatString(), text)
+			}
+		}
+	})
+
+	t.Run("Invalid strings", func(t *testing.T) {
+		for _, text := range []string{"", "12,50", "1/3", "0x1.8", "1_000.5", "--1", "1e", "NaN", "Inf", "1.2.3", "."} {
+			_, err := GetNumber(text)
+			assert.Error(t, err, text)
+		}
+
+		_, err := GetNumber("1e1000000000")
+		assert.EqualError(t, err, `failed to parse "1e1000000000" of type string as a number`)
+	})
+
+	t.Run("Unsupported type", func(t *testing.T) {
+		_, err := GetNumber(true)
+		assert.EqualError(t, err, "failed to parse true of type bool as a number")
+	})
+}
+
+func TestFormatNumber(t *testing.T) {
+	assert.Equal(t, "12.5", FormatNumber(big.NewRat(25, 2)))
+	assert.Equal(t, "-0.001", FormatNumber(big.NewRat(-1, 1000)))
+	assert.Equal(t, "42", FormatNumber(big.NewRat(42, 1)))
+	assert.Equal(t, "1/3", FormatNumber(big.NewRat(1, 3)))
+
+	// Long decimals are written in exponent form, keeping every significant digit
+	for text, expected := range map[string]string{
+		"1e400":                     "1e+400",
+		"-1.25e400":                 "-1.25e+400",
+		"1e-400":                    "1e-400",
+		"-3.5e-50":                  "-3.5e-50",
+		"1e40":                      "1e+40",
+		"1e39":                      "1000000000000000000000000000000000000000",
+		"1000000000000000000000.01": "1000000000000000000000.01",
+		"123456789012345678901234567890123456789012345": "123456789012345678901234567890123456789012345",
+	} {
+		number, err := GetNumber(text)
+		if assert.NoError(t, err, text) {
+			assert.Equal(t, expected, FormatNumber(number), text)
+		}
+	}
+}
+
+func TestGetPrecision(t *testing.T) {
+	for input, expected := range map[any]int{
+		"123.450": 5,
+		0.05:      2,
+		100:       3,
+		0:         0,
+		"-1e20":   21,
+		"1.5e-3":  4,
+	} {
+		value, err := GetPrecision(input)
+		if assert.NoError(t, err, input) {
+			assert.Equal(t, expected, value, input)
+		}
+	}
+
+	_, err := GetPrecision(big.NewRat(2, 3))
+	assert.EqualError(t, err, "2/3 has no finite decimal representation")
+}
+
+func TestGetTime(t *testing.T) {
+	t.Run("Time", func(t *testing.T) {
+		now := time.Now()
+		value, err := GetTime(now)
+		assert.NoError(t, err)
+		assert.Equal(t, now, value)
+	})
+
+	t.Run("RFC3339String", func(t *testing.T) {
+		value, err := GetTime("2024-01-02T15:04:05+02:00")
+		assert.NoError(t, err)
+		assert.True(t, value.Equal(time.Date(2024, 1, 2, 13, 4, 5, 0, time.UTC)))
+	})
+
+	t.Run("DateOnlyString", func(t *testing.T) {
+		value, err := GetTime("2024-01-02")
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), value)
+	})
+
+	t.Run("UnixSeconds", func(t *testing.T) {
+		value, err := GetTime(int64(1704067200))
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), value)
+	})
+
+	t.Run("InvalidString", func(t *testing.T) {
+		_, err := GetTime("tomorrow")
+		assert.Error(t, err)
+	})
+
+	t.Run("UnsupportedType", func(t *testing.T) {
+		_, err := GetTime(1.5)
+		assert.Error(t, err)
+	})
+}
+
+func TestGetDuration(t *testing.T) {
+	t.Run("Duration", func(t *testing.T) {
+		value, err := GetDuration(time.Minute)
+		assert.NoError(t, err)
+		assert.Equal(t, time.Minute, value)
+	})
+
+	t.Run("String", func(t *testing.T) {
+		value, err := GetDuration("90m")
+		assert.NoError(t, err)
+		assert.Equal(t, 90*time.Minute, value)
+	})
+
+	t.Run("Nanoseconds", func(t *testing.T) {
+		value, err := GetDuration(1000)
+		assert.NoError(t, err)
+		assert.Equal(t, time.Microsecond, value)
+	})
+
+	t.Run("InvalidString", func(t *testing.T) {
+		_, err := GetDuration("soon")
+		assert.Error(t, err)
+	})
+}
+
+func TestSetClock(t *testing.T) {
+	fixed := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	restore := SetClock(func() time.Time { return fixed })
+	assert.Equal(t, fixed, Now())
+
+	restore()
+	assert.NotEqual(t, fixed, Now())
+}
+
+func TestGetDecimals(t *testing.T) {
+	t.Run("Integer", func(t *testing.T) {
+		value, err := GetDecimals(12)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, value)
+	})
+
+	t.Run("Float", func(t *testing.T) {
+		value, err := GetDecimals(12.345)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, value)
+
+		value, err = GetDecimals(float32(0.1))
+		assert.NoError(t, err)
+		assert.Equal(t, 1, value)
+	})
+
+	t.Run("String", func(t *testing.T) {
+		value, err := GetDecimals("12.50")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, value)
+
+		value, err = GetDecimals("1.5e-3")
+		assert.NoError(t, err)
+		assert.Equal(t, 4, value)
+
+		value, err = GetDecimals("100")
+		assert.NoError(t, err)
+		assert.Equal(t, 0, value)
+	})
+
+	t.Run("NotFinite", func(t *testing.T) {
+		_, err := GetDecimals(math.Inf(1))
+		assert.Error(t, err)
+
+		_, err = GetDecimals("NaN")
+		assert.Error(t, err)
+	})
+
+	t.Run("InvalidString", func(t *testing.T) {
+		_, err := GetDecimals("12,50")
+		assert.Error(t, err)
+	})
+
+	t.Run("BigNumbers", func(t *testing.T) {
+		value, err := GetDecimals("1000000000000000000000.0001")
+		assert.NoError(t, err)
+		assert.Equal(t, 4, value)
+
+		value, err = GetDecimals(big.NewRat(1, 8))
+		assert.NoError(t, err)
+		assert.Equal(t, 3, value)
+
+		_, err = GetDecimals(big.NewRat(1, 3))
+		assert.EqualError(t, err, "1/3 has no finite decimal representation")
+	})
+}
+
+func TestGetBytes(t *testing.T) {
+	t.Run("String", func(t *testing.T) {
+		value, err := GetBytes(`{"a":1}`)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte(`{"a":1}`), value)
+	})
+
+	t.Run("NamedByteSlice", func(t *testing.T) {
+		type raw []byte
+		value, err := GetBytes(raw("[1]"))
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("[1]"), value)
+	})
+
+	t.Run("UnsupportedType", func(t *testing.T) {
+		_, err := GetBytes(42)
+		assert.Error(t, err)
+	})
+}
+
+func TestGetSemVer(t *testing.T) {
+	t.Run("String", func(t *testing.T) {
+		value, err := GetSemVer("1.2.3-rc.1")
+		assert.NoError(t, err)
+		assert.Equal(t, semver.MustParse("1.2.3-rc.1"), value)
+	})
+
+	t.Run("Version", func(t *testing.T) {
+		version := semver.MustParse("2.0.0")
+		value, err := GetSemVer(&version)
+		assert.NoError(t, err)
+		assert.Equal(t, version, value)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := GetSemVer("1.2")
+		assert.EqualError(t, err, `failed to parse "1.2" as semver: version "1.2" is not of the form MAJOR.MINOR.PATCH`)
+
+		_, err = GetSemVer(1.2)
+		assert.EqualError(t, err, "failed to parse 1.2 of type float64 as semver")
+	})
 }
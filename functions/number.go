@@ -0,0 +1,242 @@
+package functions
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxExponent bounds the exponent of decimal strings such as "1e400", so that parsing them cannot allocate huge numbers.
+const maxExponent = 10000
+
+// GetNumber converts an input of various types into an exact rational number.
+// It backs the numeric rules such as `min`, `max`, `between`, `multipleof`, `decimals` and `precision`,
+// so that values beyond the range of int64 and float64, such as monetary amounts, are compared exactly.
+//
+// Parameters:
+//   - input: The value to be converted.
+//
+// Returns:
+//   - The converted number, which the caller may modify.
+//   - An error if the input type is unsupported, the string is not a decimal number or the value is not finite.
+//
+// Supported types:
+//   - int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64 and named types based on them, such as time.Duration
+//   - float32, float64 (taken in their shortest decimal representation, so 0.1 is exactly 1/10)
+//   - *big.Int, *big.Float (taken in its shortest decimal representation) and *big.Rat
+//   - string (an integer in Go syntax such as "0x10" or "1_000", as GetInt, or a decimal number such as "-12.50" or "1.5e-3")
+//   - time.Time (Unix seconds, as GetInt)
+//
+// Example:
+//
+//	GetNumber(uint64(math.MaxUint64))  // Returns: 18446744073709551615, nil
+//	GetNumber("0.1")  // Returns: 1/10, nil
+//	GetNumber(math.NaN())  // Returns: nil, error
+func GetNumber(input any) (*big.Rat, error) {
+	switch v := input.(type) {
+	case *big.Int:
+		if v == nil {
+			return nil, fmt.Errorf("failed to parse nil *big.Int as a number")
+		}
+		return new(big.Rat).SetInt(v), nil
+	case *big.Rat:
+		if v == nil {
+			return nil, fmt.Errorf("failed to parse nil *big.Rat as a number")
+		}
+		return new(big.Rat).Set(v), nil
+	case *big.Float:
+		if v == nil {
+			return nil, fmt.Errorf("failed to parse nil *big.Float as a number")
+		}
+		if v.IsInf() {
+			return nil, fmt.Errorf("%s is not a finite number", v.String())
+		}
+		return parseDecimal(v.Text('g', -1))
+	case time.Time:
+		return new(big.Rat).SetInt64(v.Unix()), nil
+	}
+
+	value := reflect.ValueOf(input)
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return new(big.Rat).SetInt64(value.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return new(big.Rat).SetInt(new(big.Int).SetUint64(value.Uint())), nil
+	case reflect.Float32, reflect.Float64:
+		f := value.Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, fmt.Errorf("%v is not a finite number", f)
+		}
+		bits := 64
+		if value.Kind() == reflect.Float32 {
+			bits = 32
+		}
+		return parseDecimal(strconv.FormatFloat(f, 'g', -1, bits))
+	case reflect.String:
+		// Integers keep the base prefixes accepted by GetInt, such as "0x10"
+		if i, ok := new(big.Int).SetString(value.String(), 0); ok {
+			return new(big.Rat).SetInt(i), nil
+		}
+		if number, err := parseDecimal(value.String()); err == nil {
+			return number, nil
+		}
+		// Keep accepting RFC 3339 times, as GetInt does
+		if t, err := time.Parse(time.RFC3339, value.String()); err == nil {
+			return new(big.Rat).SetInt64(t.Unix()), nil
+		}
+		return nil, fmt.Errorf("failed to parse %q of type %T as a number", value.String(), input)
+	default:
+		return nil, fmt.Errorf("failed to parse %v of type %T as a number", input, input)
+	}
+}
+
+// parseDecimal parses a decimal number with an optional sign, fraction and exponent, such as "-12.50" or "1.5e-3".
+func parseDecimal(text string) (*big.Rat, error) {
+	mantissa, exponent, hasExponent := strings.Cut(strings.ToLower(text), "e")
+	digits := strings.TrimLeft(mantissa, "+-")
+	if len(mantissa)-len(digits) > 1 {
+		return nil, fmt.Errorf("invalid decimal number %q", text)
+	}
+
+	whole, fraction, _ := strings.Cut(digits, ".")
+	if whole+fraction == "" || strings.Trim(whole+fraction, "0123456789") != "" {
+		return nil, fmt.Errorf("invalid decimal number %q", text)
+	}
+
+	if hasExponent {
+		exp, err := strconv.Atoi(exponent)
+		if err != nil {
+			return nil, fmt.Errorf("invalid decimal number %q", text)
+		}
+		if exp > maxExponent || exp < -maxExponent {
+			return nil, fmt.Errorf("exponent of %q is out of range", text)
+		}
+	}
+
+	number, ok := new(big.Rat).SetString(text)
+	if !ok {
+		return nil, fmt.Errorf("invalid decimal number %q", text)
+	}
+	return number, nil
+}
+
+// maxPlainLength is the length beyond which FormatNumber writes a number in exponent form, when that is shorter.
+const maxPlainLength = 40
+
+// FormatNumber formats a number as a decimal when it has a finite decimal representation, such as 12.5,
+// and as a fraction such as 1/3 otherwise. It is used in the error messages of the numeric rules.
+// Decimals longer than 40 characters are written exactly in exponent form when that is shorter, so that
+// 1e400 is "1e+400" rather than 401 digits.
+//
+// Example:
+//
+//	FormatNumber(big.NewRat(25, 2))  // Returns: "12.5"
+//	FormatNumber(big.NewRat(1, 3))  // Returns: "1/3"
+func FormatNumber(number *big.Rat) string {
+	var plain string
+	if number.IsInt() {
+		plain = number.Num().String()
+	} else {
+		places, ok := decimalPlaces(number)
+		if !ok {
+			return number.RatString()
+		}
+		plain = number.FloatString(places)
+	}
+
+	if len(plain) > maxPlainLength {
+		if exponent := exponentForm(plain); len(exponent) < len(plain) {
+			return exponent
+		}
+	}
+	return plain
+}
+
+// exponentForm rewrites a plain decimal such as "-1200" or "0.0015" in exponent form, "-1.2e+3" or "1.5e-3", keeping every significant digit.
+func exponentForm(plain string) string {
+	sign, digits := "", plain
+	if strings.HasPrefix(digits, "-") {
+		sign, digits = "-", digits[1:]
+	}
+
+	integer, fraction, _ := strings.Cut(digits, ".")
+	exponent := len(integer) - 1
+	digits = integer + fraction
+
+	// Leading zeros, as in 0.0015, move the exponent down
+	trimmed := strings.TrimLeft(digits, "0")
+	if trimmed == "" {
+		return plain
+	}
+	exponent -= len(digits) - len(trimmed)
+	digits = strings.TrimRight(trimmed, "0")
+
+	mantissa := digits[:1]
+	if len(digits) > 1 {
+		mantissa += "." + digits[1:]
+	}
+	if exponent < 0 {
+		return fmt.Sprintf("%s%se-%d", sign, mantissa, -exponent)
+	}
+	return fmt.Sprintf("%s%se+%d", sign, mantissa, exponent)
+}
+
+// decimalPlaces returns the number of decimal places of a number without trailing zeros.
+// It returns false when the number has no finite decimal representation, such as 1/3.
+func decimalPlaces(number *big.Rat) (int, bool) {
+	// A reduced fraction has a finite decimal representation if its denominator is 2^a * 5^b, it then has max(a, b) decimal places
+	denominator := new(big.Int).Set(number.Denom())
+	twos, fives := 0, 0
+	two, five, remainder := big.NewInt(2), big.NewInt(5), new(big.Int)
+	for {
+		quotient, r := new(big.Int).QuoRem(denominator, two, remainder)
+		if r.Sign() != 0 {
+			break
+		}
+		denominator, twos = quotient, twos+1
+	}
+	for {
+		quotient, r := new(big.Int).QuoRem(denominator, five, remainder)
+		if r.Sign() != 0 {
+			break
+		}
+		denominator, fives = quotient, fives+1
+	}
+
+	if denominator.Cmp(big.NewInt(1)) != 0 {
+		return 0, false
+	}
+	return max(twos, fives), true
+}
+
+// GetPrecision returns the number of significant decimal digits of a number: the digits of its integer part
+// without leading zeros, plus its decimal places without trailing zeros. It is used by the `precision` rule.
+// The input is converted with GetNumber.
+//
+// Example:
+//
+//	GetPrecision("123.450")  // Returns: 5, nil
+//	GetPrecision(0.05)  // Returns: 2, nil
+//	GetPrecision(100)  // Returns: 3, nil
+func GetPrecision(input any) (int, error) {
+	number, err := GetNumber(input)
+	if err != nil {
+		return 0, err
+	}
+
+	places, ok := decimalPlaces(number)
+	if !ok {
+		return 0, fmt.Errorf("%s has no finite decimal representation", FormatNumber(number))
+	}
+
+	whole := new(big.Int).Quo(number.Num(), number.Denom())
+	digits := 0
+	if whole.Sign() != 0 {
+		digits = len(whole.Abs(whole).String())
+	}
+	return digits + places, nil
+}
@@ -0,0 +1,106 @@
+// Package geo provides the geographic primitives behind the coordinate rules: points, great-circle distances,
+// bounding boxes and GeoJSON polygons as defined by RFC 7946. Coordinates are WGS 84 degrees.
+package geo
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// EarthRadiusKm is the mean radius of the Earth in kilometres, as used by the haversine formula.
+const EarthRadiusKm = 6371.0088
+
+// Point is a geographic position in degrees.
+type Point struct {
+	Lat float64
+	Lng float64
+}
+
+// NewPoint returns the point at the given latitude and longitude, which must be finite and within
+// [-90, 90] and [-180, 180] degrees respectively.
+func NewPoint(lat, lng float64) (Point, error) {
+	if math.IsNaN(lat) || lat < -90 || lat > 90 {
+		return Point{}, fmt.Errorf("latitude %v is out of range [-90, 90]", lat)
+	}
+	if math.IsNaN(lng) || lng < -180 || lng > 180 {
+		return Point{}, fmt.Errorf("longitude %v is out of range [-180, 180]", lng)
+	}
+	return Point{Lat: lat, Lng: lng}, nil
+}
+
+// ParsePoint parses a point written as "lat,lng", such as "52.5200,13.4050" or "-33.87, 151.21".
+func ParsePoint(text string) (Point, error) {
+	latText, lngText, found := strings.Cut(text, ",")
+	if !found {
+		return Point{}, fmt.Errorf("expected coordinates such as \"52.52,13.405\", got %q", text)
+	}
+
+	lat, err := strconv.ParseFloat(strings.TrimSpace(latText), 64)
+	if err != nil {
+		return Point{}, fmt.Errorf("invalid latitude %q", strings.TrimSpace(latText))
+	}
+	lng, err := strconv.ParseFloat(strings.TrimSpace(lngText), 64)
+	if err != nil {
+		return Point{}, fmt.Errorf("invalid longitude %q", strings.TrimSpace(lngText))
+	}
+
+	return NewPoint(lat, lng)
+}
+
+// String returns the point as "lat,lng".
+func (p Point) String() string {
+	return strconv.FormatFloat(p.Lat, 'f', -1, 64) + "," + strconv.FormatFloat(p.Lng, 'f', -1, 64)
+}
+
+// Distance returns the great-circle distance between two points in kilometres, computed with the haversine formula
+// on a sphere of radius EarthRadiusKm. It differs from the distance on the WGS 84 ellipsoid by at most about 0.5%.
+func Distance(a, b Point) float64 {
+	lat1, lat2 := radians(a.Lat), radians(b.Lat)
+	dLat, dLng := lat2-lat1, radians(b.Lng-a.Lng)
+
+	h := math.Pow(math.Sin(dLat/2), 2) + math.Cos(lat1)*math.Cos(lat2)*math.Pow(math.Sin(dLng/2), 2)
+	return 2 * EarthRadiusKm * math.Asin(math.Sqrt(min(h, 1)))
+}
+
+// radians converts degrees to radians.
+func radians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
+
+// BBox is a bounding box delimited by its south-west and north-east corners.
+// A box whose West is greater than its East crosses the antimeridian, such as the box from 170 to -170 degrees of longitude.
+type BBox struct {
+	West, South, East, North float64
+}
+
+// NewBBox returns the bounding box with the given edges, in the order of a GeoJSON bbox.
+func NewBBox(west, south, east, north float64) (BBox, error) {
+	if _, err := NewPoint(south, west); err != nil {
+		return BBox{}, err
+	}
+	if _, err := NewPoint(north, east); err != nil {
+		return BBox{}, err
+	}
+	if south > north {
+		return BBox{}, fmt.Errorf("south %v is greater than north %v", south, north)
+	}
+	return BBox{West: west, South: south, East: east, North: north}, nil
+}
+
+// Contains reports whether the point is inside the box or on its edges.
+func (b BBox) Contains(p Point) bool {
+	if p.Lat < b.South || p.Lat > b.North {
+		return false
+	}
+	if b.West <= b.East {
+		return p.Lng >= b.West && p.Lng <= b.East
+	}
+	return p.Lng >= b.West || p.Lng <= b.East
+}
+
+// String returns the box as its south-west and north-east corners, such as "47.27,5.87 to 55.06,15.04".
+func (b BBox) String() string {
+	return Point{Lat: b.South, Lng: b.West}.String() + " to " + Point{Lat: b.North, Lng: b.East}.String()
+}
@@ -0,0 +1,82 @@
+package geo
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePoint(t *testing.T) {
+	t.Run("Valid points", func(t *testing.T) {
+		p, err := ParsePoint("52.5200,13.4050")
+		assert.NoError(t, err)
+		assert.Equal(t, Point{Lat: 52.52, Lng: 13.405}, p)
+
+		p, err = ParsePoint(" -33.87 , 151.21 ")
+		assert.NoError(t, err)
+		assert.Equal(t, Point{Lat: -33.87, Lng: 151.21}, p)
+
+		p, err = ParsePoint("90,-180")
+		assert.NoError(t, err)
+		assert.Equal(t, "90,-180", p.String())
+	})
+
+	t.Run("Invalid points", func(t *testing.T) {
+		_, err := ParsePoint("52.52")
+		assert.EqualError(t, err, `expected coordinates such as "52.52,13.405", got "52.52"`)
+
+		_, err = ParsePoint("north,13.4")
+		assert.EqualError(t, err, `invalid latitude "north"`)
+
+		_, err = ParsePoint("91,0")
+		assert.EqualError(t, err, "latitude 91 is out of range [-90, 90]")
+
+		_, err = ParsePoint("0,180.5")
+		assert.EqualError(t, err, "longitude 180.5 is out of range [-180, 180]")
+
+		_, err = ParsePoint("NaN,0")
+		assert.Error(t, err)
+	})
+}
+
+func TestDistance(t *testing.T) {
+	berlin := Point{Lat: 52.52, Lng: 13.405}
+	paris := Point{Lat: 48.8566, Lng: 2.3522}
+	sydney := Point{Lat: -33.8688, Lng: 151.2093}
+
+	assert.InDelta(t, 877.5, Distance(berlin, paris), 0.5)
+	assert.InDelta(t, Distance(berlin, paris), Distance(paris, berlin), 1e-9)
+	assert.InDelta(t, 16095, Distance(berlin, sydney), 5)
+	assert.Equal(t, 0.0, Distance(berlin, berlin))
+
+	// Antipodal points are half the circumference apart
+	assert.InDelta(t, math.Pi*EarthRadiusKm, Distance(Point{Lat: 0, Lng: 0}, Point{Lat: 0, Lng: 180}), 1e-6)
+}
+
+func TestBBox(t *testing.T) {
+	t.Run("Contains", func(t *testing.T) {
+		germany, err := NewBBox(5.87, 47.27, 15.04, 55.06)
+		assert.NoError(t, err)
+		assert.True(t, germany.Contains(Point{Lat: 52.52, Lng: 13.405}))
+		assert.True(t, germany.Contains(Point{Lat: 47.27, Lng: 5.87}))
+		assert.False(t, germany.Contains(Point{Lat: 48.8566, Lng: 2.3522}))
+		assert.Equal(t, "47.27,5.87 to 55.06,15.04", germany.String())
+	})
+
+	t.Run("Antimeridian", func(t *testing.T) {
+		fiji, err := NewBBox(177, -21, -178, -12)
+		assert.NoError(t, err)
+		assert.True(t, fiji.Contains(Point{Lat: -18, Lng: 179}))
+		assert.True(t, fiji.Contains(Point{Lat: -18, Lng: -179}))
+		assert.False(t, fiji.Contains(Point{Lat: -18, Lng: 0}))
+	})
+
+	t.Run("Invalid boxes", func(t *testing.T) {
+		_, err := NewBBox(0, 10, 1, 5)
+		assert.EqualError(t, err, "south 10 is greater than north 5")
+
+		_, err = NewBBox(0, -95, 1, 5)
+		assert.Error(t, err)
+	})
+}
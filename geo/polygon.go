@@ -0,0 +1,173 @@
+package geo
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Polygon is an area delimited by an exterior ring and optional interior rings, the holes.
+// Each ring is closed, its first and last points are equal.
+//
+// As in GeoJSON, edges are straight lines in longitude and latitude rather than great-circle arcs,
+// which makes no practical difference for areas such as delivery zones.
+type Polygon struct {
+	Rings [][]Point
+}
+
+// Area is a GeoJSON geometry made of one or more polygons.
+type Area []Polygon
+
+// geoJSON holds the members of a GeoJSON object that an Area is parsed from.
+type geoJSON struct {
+	Type        string          `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates"`
+	Geometry    *geoJSON        `json:"geometry"`
+	Features    []geoJSON       `json:"features"`
+}
+
+// ParseArea parses a GeoJSON Polygon or MultiPolygon, or a Feature or FeatureCollection of them.
+// Positions are [longitude, latitude] with an optional altitude, which is ignored.
+//
+// Example:
+//
+//	ParseArea([]byte(`{"type":"Polygon","coordinates":[[[13.3,52.4],[13.5,52.4],[13.5,52.6],[13.3,52.6],[13.3,52.4]]]}`))
+func ParseArea(data []byte) (Area, error) {
+	var object geoJSON
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, fmt.Errorf("invalid GeoJSON: %w", err)
+	}
+	return object.area()
+}
+
+// area returns the polygons of a GeoJSON object.
+func (g geoJSON) area() (Area, error) {
+	switch g.Type {
+	case "Polygon":
+		var rings [][][]float64
+		if err := json.Unmarshal(g.Coordinates, &rings); err != nil {
+			return nil, fmt.Errorf("invalid Polygon coordinates: %w", err)
+		}
+		polygon, err := newPolygon(rings)
+		if err != nil {
+			return nil, err
+		}
+		return Area{polygon}, nil
+	case "MultiPolygon":
+		var polygons [][][][]float64
+		if err := json.Unmarshal(g.Coordinates, &polygons); err != nil {
+			return nil, fmt.Errorf("invalid MultiPolygon coordinates: %w", err)
+		}
+		if len(polygons) == 0 {
+			return nil, fmt.Errorf("MultiPolygon has no polygons")
+		}
+		area := make(Area, 0, len(polygons))
+		for _, rings := range polygons {
+			polygon, err := newPolygon(rings)
+			if err != nil {
+				return nil, err
+			}
+			area = append(area, polygon)
+		}
+		return area, nil
+	case "Feature":
+		if g.Geometry == nil {
+			return nil, fmt.Errorf("Feature has no geometry")
+		}
+		return g.Geometry.area()
+	case "FeatureCollection":
+		var area Area
+		for _, feature := range g.Features {
+			polygons, err := feature.area()
+			if err != nil {
+				return nil, err
+			}
+			area = append(area, polygons...)
+		}
+		if len(area) == 0 {
+			return nil, fmt.Errorf("FeatureCollection has no polygons")
+		}
+		return area, nil
+	default:
+		return nil, fmt.Errorf("unsupported GeoJSON type %q, expected Polygon, MultiPolygon, Feature or FeatureCollection", g.Type)
+	}
+}
+
+// newPolygon converts GeoJSON rings of [longitude, latitude] positions into a Polygon.
+func newPolygon(rings [][][]float64) (Polygon, error) {
+	if len(rings) == 0 {
+		return Polygon{}, fmt.Errorf("polygon has no rings")
+	}
+
+	polygon := Polygon{Rings: make([][]Point, 0, len(rings))}
+	for _, ring := range rings {
+		if len(ring) < 4 {
+			return Polygon{}, fmt.Errorf("polygon ring must have at least 4 positions, got %d", len(ring))
+		}
+
+		points := make([]Point, 0, len(ring))
+		for _, position := range ring {
+			if len(position) < 2 {
+				return Polygon{}, fmt.Errorf("position must have a longitude and a latitude, got %v", position)
+			}
+			point, err := NewPoint(position[1], position[0])
+			if err != nil {
+				return Polygon{}, err
+			}
+			points = append(points, point)
+		}
+
+		if points[0] != points[len(points)-1] {
+			return Polygon{}, fmt.Errorf("polygon ring is not closed, it starts at %s and ends at %s", points[0], points[len(points)-1])
+		}
+		polygon.Rings = append(polygon.Rings, points)
+	}
+
+	return polygon, nil
+}
+
+// Contains reports whether the point is inside the polygon or on its boundary, and not inside one of its holes.
+func (p Polygon) Contains(point Point) bool {
+	for i, ring := range p.Rings {
+		inside, onEdge := ringContains(ring, point)
+		if onEdge {
+			return true
+		}
+		// The point must be inside the exterior ring and outside every hole
+		if inside != (i == 0) {
+			return false
+		}
+	}
+	return true
+}
+
+// Contains reports whether the point is inside one of the polygons of the area.
+func (a Area) Contains(point Point) bool {
+	for _, polygon := range a {
+		if polygon.Contains(point) {
+			return true
+		}
+	}
+	return false
+}
+
+// ringContains reports whether the point is inside a closed ring, using the even-odd rule,
+// and whether it lies on one of its edges.
+func ringContains(ring []Point, point Point) (inside bool, onEdge bool) {
+	x, y := point.Lng, point.Lat
+	for i := 1; i < len(ring); i++ {
+		x1, y1 := ring[i-1].Lng, ring[i-1].Lat
+		x2, y2 := ring[i].Lng, ring[i].Lat
+
+		// Cross product of the edge and the vector to the point, zero when they are collinear
+		cross := (x2-x1)*(y-y1) - (y2-y1)*(x-x1)
+		if cross == 0 && x >= min(x1, x2) && x <= max(x1, x2) && y >= min(y1, y2) && y <= max(y1, y2) {
+			return false, true
+		}
+
+		// Count the edges crossed by a ray going east from the point
+		if (y1 > y) != (y2 > y) && x < x1+(y-y1)*(x2-x1)/(y2-y1) {
+			inside = !inside
+		}
+	}
+	return inside, false
+}
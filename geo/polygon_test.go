@@ -0,0 +1,99 @@
+package geo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// square is a 10x10 degree square with a 2x2 degree hole in its centre.
+const square = `{"type":"Polygon","coordinates":[
+	[[0,0],[10,0],[10,10],[0,10],[0,0]],
+	[[4,4],[6,4],[6,6],[4,6],[4,4]]
+]}`
+
+func TestParseArea(t *testing.T) {
+	t.Run("Polygon", func(t *testing.T) {
+		area, err := ParseArea([]byte(square))
+		assert.NoError(t, err)
+		assert.Len(t, area, 1)
+		assert.Len(t, area[0].Rings, 2)
+		assert.Equal(t, Point{Lat: 0, Lng: 10}, area[0].Rings[0][1])
+	})
+
+	t.Run("MultiPolygon, Feature and FeatureCollection", func(t *testing.T) {
+		area, err := ParseArea([]byte(`{"type":"MultiPolygon","coordinates":[
+			[[[0,0],[1,0],[1,1],[0,0]]],
+			[[[5,5],[6,5],[6,6],[5,5]]]
+		]}`))
+		assert.NoError(t, err)
+		assert.Len(t, area, 2)
+
+		area, err = ParseArea([]byte(`{"type":"Feature","properties":{"name":"zone"},"geometry":` + square + `}`))
+		assert.NoError(t, err)
+		assert.Len(t, area, 1)
+
+		area, err = ParseArea([]byte(`{"type":"FeatureCollection","features":[{"type":"Feature","geometry":` + square + `},{"type":"Feature","geometry":` + square + `}]}`))
+		assert.NoError(t, err)
+		assert.Len(t, area, 2)
+	})
+
+	t.Run("Positions with altitude", func(t *testing.T) {
+		_, err := ParseArea([]byte(`{"type":"Polygon","coordinates":[[[0,0,100],[1,0,100],[1,1,100],[0,0,100]]]}`))
+		assert.NoError(t, err)
+	})
+
+	t.Run("Invalid GeoJSON", func(t *testing.T) {
+		_, err := ParseArea([]byte(`{"type":"Point","coordinates":[0,0]}`))
+		assert.EqualError(t, err, `unsupported GeoJSON type "Point", expected Polygon, MultiPolygon, Feature or FeatureCollection`)
+
+		_, err = ParseArea([]byte(`{"type":"Polygon","coordinates":[[[0,0],[1,0],[0,0]]]}`))
+		assert.EqualError(t, err, "polygon ring must have at least 4 positions, got 3")
+
+		_, err = ParseArea([]byte(`{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,1]]]}`))
+		assert.EqualError(t, err, "polygon ring is not closed, it starts at 0,0 and ends at 1,0")
+
+		_, err = ParseArea([]byte(`{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,100],[0,0]]]}`))
+		assert.EqualError(t, err, "latitude 100 is out of range [-90, 90]")
+
+		_, err = ParseArea([]byte(`{"type":"Polygon","coordinates":[]}`))
+		assert.EqualError(t, err, "polygon has no rings")
+
+		_, err = ParseArea([]byte(`{"type":"Feature"}`))
+		assert.EqualError(t, err, "Feature has no geometry")
+
+		_, err = ParseArea([]byte(`not json`))
+		assert.Error(t, err)
+	})
+}
+
+func TestAreaContains(t *testing.T) {
+	area, err := ParseArea([]byte(square))
+	assert.NoError(t, err)
+
+	t.Run("Inside", func(t *testing.T) {
+		assert.True(t, area.Contains(Point{Lat: 1, Lng: 1}))
+		assert.True(t, area.Contains(Point{Lat: 9.5, Lng: 5}))
+	})
+
+	t.Run("On the boundary", func(t *testing.T) {
+		assert.True(t, area.Contains(Point{Lat: 0, Lng: 5}))
+		assert.True(t, area.Contains(Point{Lat: 10, Lng: 10}))
+		assert.True(t, area.Contains(Point{Lat: 4, Lng: 5}))
+	})
+
+	t.Run("Outside or in the hole", func(t *testing.T) {
+		assert.False(t, area.Contains(Point{Lat: 5, Lng: 5}))
+		assert.False(t, area.Contains(Point{Lat: -1, Lng: 5}))
+		assert.False(t, area.Contains(Point{Lat: 5, Lng: 10.5}))
+	})
+
+	t.Run("Concave polygon", func(t *testing.T) {
+		// A U shape open to the north
+		u, err := ParseArea([]byte(`{"type":"Polygon","coordinates":[[[0,0],[3,0],[3,3],[2,3],[2,1],[1,1],[1,3],[0,3],[0,0]]]}`))
+		assert.NoError(t, err)
+		assert.True(t, u.Contains(Point{Lat: 2, Lng: 0.5}))
+		assert.True(t, u.Contains(Point{Lat: 2, Lng: 2.5}))
+		assert.False(t, u.Contains(Point{Lat: 2, Lng: 1.5}))
+	})
+}
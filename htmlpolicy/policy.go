@@ -0,0 +1,192 @@
+// Package htmlpolicy checks and sanitizes HTML against allowlist policies.
+//
+// A Policy lists the elements and attributes that may appear in a document and the URL schemes
+// that may be used in link and source attributes. Everything else is disallowed: Check reports it and
+// Sanitize removes it. Event handler attributes (onclick, onload, ...) are never allowed, whatever the policy says.
+//
+// Two policies are registered by default:
+//   - strict: no markup at all, only text
+//   - ugc: formatting, lists, tables, links and images suitable for user generated content
+//
+// More can be added with Register and referenced from rule text as `htmlpolicy:name`.
+package htmlpolicy
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// Policy is an allowlist of HTML elements, attributes and URL schemes.
+// A policy must not be modified after it has been registered.
+type Policy struct {
+	elements   map[string]map[string]bool // allowed elements and their allowed attributes
+	global     map[string]bool            // attributes allowed on every allowed element
+	urlSchemes map[string]bool            // schemes allowed in URL attributes, relative URLs are always allowed
+}
+
+// urlAttributes are the attributes whose values are URLs and are checked against the allowed schemes.
+var urlAttributes = map[string]bool{
+	"action":     true,
+	"background": true,
+	"cite":       true,
+	"formaction": true,
+	"href":       true,
+	"longdesc":   true,
+	"poster":     true,
+	"src":        true,
+	"srcset":     true,
+	"usemap":     true,
+	"xlink:href": true,
+}
+
+// dropContent are the elements whose content is removed along with them when they are not allowed.
+// The content of other disallowed elements is kept.
+var dropContent = map[string]bool{
+	"embed":    true,
+	"iframe":   true,
+	"math":     true,
+	"noembed":  true,
+	"noframes": true,
+	"noscript": true,
+	"object":   true,
+	"script":   true,
+	"select":   true,
+	"style":    true,
+	"svg":      true,
+	"template": true,
+	"textarea": true,
+	"title":    true,
+	"xmp":      true,
+}
+
+// NewPolicy returns an empty policy, which allows text only.
+func NewPolicy() *Policy {
+	return &Policy{
+		elements:   map[string]map[string]bool{},
+		global:     map[string]bool{},
+		urlSchemes: map[string]bool{},
+	}
+}
+
+// AllowElements allows the given elements, without attributes other than the global ones.
+func (p *Policy) AllowElements(names ...string) *Policy {
+	for _, name := range names {
+		name = strings.ToLower(name)
+		if p.elements[name] == nil {
+			p.elements[name] = map[string]bool{}
+		}
+	}
+	return p
+}
+
+// AllowAttributes allows attributes on an element, which is allowed as well.
+// Use "*" as the element to allow the attributes on every allowed element.
+func (p *Policy) AllowAttributes(element string, attributes ...string) *Policy {
+	allowed := p.global
+	if element != "*" {
+		p.AllowElements(element)
+		allowed = p.elements[strings.ToLower(element)]
+	}
+	for _, attribute := range attributes {
+		allowed[strings.ToLower(attribute)] = true
+	}
+	return p
+}
+
+// AllowURLSchemes allows absolute URLs with the given schemes, e.g. "https" or "mailto", in URL attributes.
+func (p *Policy) AllowURLSchemes(schemes ...string) *Policy {
+	for _, scheme := range schemes {
+		p.urlSchemes[strings.ToLower(scheme)] = true
+	}
+	return p
+}
+
+// allowsAttribute reports whether an attribute may appear on an allowed element.
+func (p *Policy) allowsAttribute(element, attribute string) bool {
+	if strings.HasPrefix(attribute, "on") {
+		return false
+	}
+	return p.global[attribute] || p.elements[element][attribute]
+}
+
+// allowsURL reports whether a URL attribute value is a valid URL that is relative or uses an allowed scheme.
+func (p *Policy) allowsURL(value string) bool {
+	u, err := parseURL(value)
+	if err != nil {
+		return false
+	}
+	return u.Scheme == "" || p.urlSchemes[u.Scheme]
+}
+
+// parseURL parses a URL attribute value as a browser reads it, see stripURLSpace. The scheme is lower case.
+func parseURL(value string) (*url.URL, error) {
+	return url.Parse(stripURLSpace(value))
+}
+
+// stripURLSpace removes whitespace and control characters, which browsers ignore anywhere in a URL,
+// e.g. "java\tscript:alert(1)" is a javascript: URL.
+func stripURLSpace(value string) string {
+	return strings.Map(func(r rune) rune {
+		if r <= ' ' || r == 0x7f {
+			return -1
+		}
+		return r
+	}, value)
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]*Policy{
+		"strict": NewPolicy(),
+		"ugc":    ugcPolicy(),
+	}
+)
+
+// ugcPolicy allows common formatting, lists, tables, links and images with http, https and mailto URLs.
+func ugcPolicy() *Policy {
+	return NewPolicy().
+		AllowElements("p", "br", "hr", "b", "strong", "i", "em", "u", "s", "strike", "del", "ins", "sub", "sup", "small", "mark",
+			"code", "pre", "kbd", "samp", "var", "span", "div", "ul", "li", "dl", "dt", "dd",
+			"h1", "h2", "h3", "h4", "h5", "h6", "table", "thead", "tbody", "tfoot", "tr", "caption", "figure", "figcaption", "cite").
+		AllowAttributes("*", "title", "lang", "dir").
+		AllowAttributes("a", "href", "rel", "target").
+		AllowAttributes("img", "src", "alt", "width", "height").
+		AllowAttributes("blockquote", "cite").
+		AllowAttributes("q", "cite").
+		AllowAttributes("abbr", "title").
+		AllowAttributes("ol", "start", "reversed").
+		AllowAttributes("th", "colspan", "rowspan", "scope").
+		AllowAttributes("td", "colspan", "rowspan").
+		AllowURLSchemes("http", "https", "mailto")
+}
+
+// Register adds a named policy so that it can be referenced from rule text as `htmlpolicy:name`.
+// Registering a name twice replaces the previous policy, including the built-in ones.
+//
+// Example:
+//
+//	err := htmlpolicy.Register("comment", htmlpolicy.NewPolicy().AllowElements("b", "i").AllowAttributes("a", "href").AllowURLSchemes("https"))
+func Register(name string, policy *Policy) error {
+	if name == "" {
+		return fmt.Errorf("html policy name cannot be empty")
+	}
+	if policy == nil {
+		return fmt.Errorf("html policy %s cannot be nil", name)
+	}
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry[name] = policy
+	return nil
+}
+
+// Lookup returns the policy registered under name.
+// Returns false if no policy has been registered with that name.
+func Lookup(name string) (*Policy, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	policy, ok := registry[name]
+	return policy, ok
+}
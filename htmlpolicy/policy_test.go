@@ -0,0 +1,134 @@
+package htmlpolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheck(t *testing.T) {
+	ugc, ok := Lookup("ugc")
+	assert.True(t, ok)
+
+	t.Run("Allowed markup", func(t *testing.T) {
+		violations, err := ugc.Check(`<p title="x">Hello <b>world</b>, see <a href="https://example.com" rel="nofollow">this</a> and <img src="/a.png" alt="a"></p>`)
+		assert.NoError(t, err)
+		assert.Empty(t, violations)
+
+		violations, err = ugc.Check(`<a href="mailto:jane@example.com">mail</a> <a href="#top">top</a> <a href="../up">up</a>`)
+		assert.NoError(t, err)
+		assert.Empty(t, violations)
+	})
+
+	t.Run("Disallowed elements", func(t *testing.T) {
+		violations, err := ugc.Check(`<p>Hi</p><script>alert(1)</script><iframe src="https://evil.example"></iframe><script>x()</script>`)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"element <script> is not allowed", "element <iframe> is not allowed"}, violations)
+	})
+
+	t.Run("Disallowed attributes", func(t *testing.T) {
+		violations, err := ugc.Check(`<p style="color:red" onclick="x()">Hi</p><a href="/" ONMOUSEOVER="x()">link</a>`)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{
+			"attribute style on <p> is not allowed",
+			"event handler onclick on <p> is not allowed",
+			"event handler onmouseover on <a> is not allowed",
+		}, violations)
+	})
+
+	t.Run("Disallowed URLs", func(t *testing.T) {
+		violations, err := ugc.Check(`<a href="javascript:alert(1)">a</a><a href=" JaVa&#x09;Script:alert(1)">b</a><img src="data:image/png;base64,AAAA">`)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{
+			"javascript: URL in href on <a> is not allowed",
+			"data: URL in src on <img> is not allowed",
+		}, violations)
+	})
+
+	t.Run("Invalid URLs", func(t *testing.T) {
+		violations, err := ugc.Check(`<a href="https://example.com/100%">a</a><img src="http://x:y"><a href=":x">b</a>`)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{
+			"invalid URL in href on <a> is not allowed",
+			"invalid URL in src on <img> is not allowed",
+		}, violations)
+	})
+
+	t.Run("Comments and doctype", func(t *testing.T) {
+		violations, err := ugc.Check(`<!-- hidden --><p>Hi</p>`)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"comments are not allowed"}, violations)
+	})
+
+	t.Run("Strict policy", func(t *testing.T) {
+		strict, ok := Lookup("strict")
+		assert.True(t, ok)
+
+		violations, err := strict.Check("Just text &amp; entities")
+		assert.NoError(t, err)
+		assert.Empty(t, violations)
+
+		violations, err = strict.Check("<b>bold</b>")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"element <b> is not allowed"}, violations)
+	})
+
+	t.Run("Event handlers cannot be allowed", func(t *testing.T) {
+		policy := NewPolicy().AllowAttributes("button", "onclick", "type")
+		violations, err := policy.Check(`<button type="button" onclick="x()">Go</button>`)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"event handler onclick on <button> is not allowed"}, violations)
+	})
+}
+
+func TestSanitize(t *testing.T) {
+	t.Run("Removes disallowed markup", func(t *testing.T) {
+		clean, err := Sanitize("ugc", `<p onclick="x()">Hi <script>alert(1)</script><blink>there</blink></p>`)
+		assert.NoError(t, err)
+		assert.Equal(t, "<p>Hi there</p>", clean)
+	})
+
+	t.Run("Removes disallowed URLs", func(t *testing.T) {
+		clean, err := Sanitize("ugc", `<a href="javascript:alert(1)" title="t">click</a> <a href="https://example.com">ok</a>`)
+		assert.NoError(t, err)
+		assert.Equal(t, `<a title="t">click</a> <a href="https://example.com">ok</a>`, clean)
+	})
+
+	t.Run("Drops content of dangerous elements", func(t *testing.T) {
+		clean, err := Sanitize("ugc", `<style>body{display:none}</style><iframe>fallback</iframe><div><!-- c -->kept</div>`)
+		assert.NoError(t, err)
+		assert.Equal(t, "<div>kept</div>", clean)
+	})
+
+	t.Run("Escapes text", func(t *testing.T) {
+		clean, err := Sanitize("strict", `<b>5 &lt; 6</b> & <i>"quoted"</i>`)
+		assert.NoError(t, err)
+		assert.Equal(t, "5 &lt; 6 &amp; &#34;quoted&#34;", clean)
+	})
+
+	t.Run("Conforming input is unchanged", func(t *testing.T) {
+		input := `<ul><li><a href="https://example.com" rel="nofollow">link</a></li></ul>`
+		clean, err := Sanitize("ugc", input)
+		assert.NoError(t, err)
+		assert.Equal(t, input, clean)
+	})
+
+	t.Run("Unknown policy", func(t *testing.T) {
+		_, err := Sanitize("nope", "x")
+		assert.Error(t, err)
+		assert.Equal(t, "unknown html policy: nope", err.Error())
+	})
+}
+
+func TestRegister(t *testing.T) {
+	assert.NoError(t, Register("comment", NewPolicy().AllowElements("b", "i").AllowAttributes("a", "href").AllowURLSchemes("https")))
+
+	policy, ok := Lookup("comment")
+	assert.True(t, ok)
+	violations, err := policy.Check(`<b>bold</b> <a href="http://example.com">insecure</a>`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"http: URL in href on <a> is not allowed"}, violations)
+
+	assert.Error(t, Register("", NewPolicy()))
+	assert.Error(t, Register("nil", nil))
+}
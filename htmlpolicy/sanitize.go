@@ -0,0 +1,161 @@
+package htmlpolicy
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Check parses the input as an HTML fragment and returns a description of everything the policy does not allow,
+// in document order and without duplicates. An empty result means the input conforms to the policy.
+//
+// Example:
+//
+//	policy, _ := htmlpolicy.Lookup("ugc")
+//	violations, _ := policy.Check(`<a href="javascript:alert(1)" onclick="x()">hi</a>`)
+//	// violations: ["javascript: URL in href on <a> is not allowed", "event handler onclick on <a> is not allowed"]
+func (p *Policy) Check(input string) ([]string, error) {
+	nodes, err := parseFragment(input)
+	if err != nil {
+		return nil, err
+	}
+
+	var violations []string
+	seen := map[string]bool{}
+	report := func(format string, a ...any) {
+		violation := fmt.Sprintf(format, a...)
+		if !seen[violation] {
+			seen[violation] = true
+			violations = append(violations, violation)
+		}
+	}
+
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		switch n.Type {
+		case html.ElementNode:
+			if _, ok := p.elements[n.Data]; !ok {
+				report("element <%s> is not allowed", n.Data)
+			} else {
+				for _, attr := range n.Attr {
+					name := attributeName(attr)
+					switch {
+					case strings.HasPrefix(name, "on"):
+						report("event handler %s on <%s> is not allowed", name, n.Data)
+					case !p.allowsAttribute(n.Data, name):
+						report("attribute %s on <%s> is not allowed", name, n.Data)
+					case urlAttributes[name] && !p.allowsURL(attr.Val):
+						if u, err := parseURL(attr.Val); err != nil {
+							report("invalid URL in %s on <%s> is not allowed", name, n.Data)
+						} else {
+							report("%s: URL in %s on <%s> is not allowed", u.Scheme, name, n.Data)
+						}
+					}
+				}
+			}
+		case html.CommentNode:
+			report("comments are not allowed")
+		case html.DoctypeNode:
+			report("doctype is not allowed")
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	for _, n := range nodes {
+		walk(n)
+	}
+
+	return violations, nil
+}
+
+// Sanitize parses the input as an HTML fragment and returns it with everything the policy does not allow removed.
+// Disallowed elements are unwrapped, keeping their content, except for elements such as <script>, <style> and <iframe>,
+// whose content is removed as well. Disallowed attributes, comments and doctypes are removed.
+// The result is re-serialized, so it may differ from the input in quoting and escaping even when nothing was removed.
+//
+// Example:
+//
+//	policy, _ := htmlpolicy.Lookup("ugc")
+//	clean, _ := policy.Sanitize(`<p onclick="x()">Hi <script>alert(1)</script><blink>there</blink></p>`)
+//	// clean: "<p>Hi there</p>"
+func (p *Policy) Sanitize(input string) (string, error) {
+	nodes, err := parseFragment(input)
+	if err != nil {
+		return "", err
+	}
+
+	var out strings.Builder
+	for _, n := range nodes {
+		for _, clean := range p.sanitizeNode(n) {
+			if err := html.Render(&out, clean); err != nil {
+				return "", err
+			}
+		}
+	}
+	return out.String(), nil
+}
+
+// Sanitize cleans the input with the policy registered under name.
+func Sanitize(name string, input string) (string, error) {
+	policy, ok := Lookup(name)
+	if !ok {
+		return "", fmt.Errorf("unknown html policy: %s", name)
+	}
+	return policy.Sanitize(input)
+}
+
+// sanitizeNode returns the nodes that replace n in the sanitized document.
+func (p *Policy) sanitizeNode(n *html.Node) []*html.Node {
+	switch n.Type {
+	case html.TextNode:
+		return []*html.Node{{Type: html.TextNode, Data: n.Data}}
+	case html.ElementNode:
+		// handled below
+	default:
+		return nil
+	}
+
+	var children []*html.Node
+	if _, ok := p.elements[n.Data]; ok || !dropContent[n.Data] {
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			children = append(children, p.sanitizeNode(c)...)
+		}
+	}
+
+	if _, ok := p.elements[n.Data]; !ok {
+		return children
+	}
+
+	clean := &html.Node{Type: html.ElementNode, DataAtom: n.DataAtom, Data: n.Data, Namespace: n.Namespace}
+	for _, attr := range n.Attr {
+		name := attributeName(attr)
+		if !p.allowsAttribute(n.Data, name) || urlAttributes[name] && !p.allowsURL(attr.Val) {
+			continue
+		}
+		clean.Attr = append(clean.Attr, attr)
+	}
+	for _, c := range children {
+		clean.AppendChild(c)
+	}
+	return []*html.Node{clean}
+}
+
+// parseFragment parses the input as the content of a <body> element.
+func parseFragment(input string) ([]*html.Node, error) {
+	nodes, err := html.ParseFragment(strings.NewReader(input), &html.Node{Type: html.ElementNode, DataAtom: atom.Body, Data: "body"})
+	if err != nil {
+		return nil, fmt.Errorf("invalid html: %w", err)
+	}
+	return nodes, nil
+}
+
+// attributeName returns the lower case name of an attribute, including its namespace prefix, e.g. "xlink:href".
+func attributeName(attr html.Attribute) string {
+	if attr.Namespace != "" {
+		return strings.ToLower(attr.Namespace + ":" + attr.Key)
+	}
+	return strings.ToLower(attr.Key)
+}
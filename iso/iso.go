@@ -0,0 +1,207 @@
+// Package iso provides lookups into embedded ISO reference tables: ISO 3166-1 countries,
+// ISO 3166-2 subdivisions, ISO 4217 currencies, ISO 639 languages, postal code formats and phone number metadata.
+//
+// The tables are CSV files in the data directory, embedded into the binary and parsed once on first use.
+// Country and subdivision data follows the Debian iso-codes project; currency data follows ISO 4217 List One.
+package iso
+
+import (
+	"embed"
+	"encoding/csv"
+	"fmt"
+	"regexp"
+	"strconv"
+	"sync"
+)
+
+//go:embed data/*.csv
+var data embed.FS
+
+// NoMinorUnit is the minor unit of currencies for which ISO 4217 defines none, such as gold (XAU).
+const NoMinorUnit = -1
+
+// Country is an ISO 3166-1 country.
+type Country struct {
+	Alpha2  string
+	Alpha3  string
+	Numeric string
+	Name    string
+}
+
+// Currency is an ISO 4217 currency. Minor is the number of decimal places of the minor unit,
+// or NoMinorUnit if the currency has none.
+type Currency struct {
+	Code    string
+	Numeric string
+	Minor   int
+	Name    string
+}
+
+// Language is an ISO 639 language with its ISO 639-1 code and ISO 639-2 terminology and bibliographic codes.
+type Language struct {
+	Alpha2  string
+	Alpha3T string
+	Alpha3B string
+	Name    string
+}
+
+// Subdivision is an ISO 3166-2 subdivision. Parent is the code of the enclosing subdivision, if any.
+type Subdivision struct {
+	Code   string
+	Type   string
+	Parent string
+	Name   string
+}
+
+// Country returns the code of the country the subdivision belongs to.
+func (s Subdivision) Country() string {
+	return s.Code[:2]
+}
+
+type tables struct {
+	countriesByAlpha2  map[string]Country
+	countriesByAlpha3  map[string]Country
+	countriesByNumeric map[string]Country
+	currencies         map[string]Currency
+	languages          map[string]Language
+	subdivisions       map[string]Subdivision
+	postcodes          map[string]postcodeFormat
+	phones             map[string]phoneFormat
+	phonesByCode       map[string][]phoneFormat
+}
+
+var (
+	loadOnce sync.Once
+	loaded   *tables
+)
+
+// load parses the embedded tables once. The tables are part of the source tree,
+// so a malformed table is a programming error and panics.
+func load() *tables {
+	loadOnce.Do(func() {
+		t := &tables{
+			countriesByAlpha2:  map[string]Country{},
+			countriesByAlpha3:  map[string]Country{},
+			countriesByNumeric: map[string]Country{},
+			currencies:         map[string]Currency{},
+			languages:          map[string]Language{},
+			subdivisions:       map[string]Subdivision{},
+			postcodes:          map[string]postcodeFormat{},
+			phones:             map[string]phoneFormat{},
+			phonesByCode:       map[string][]phoneFormat{},
+		}
+
+		for _, r := range readTable("countries.csv", 4) {
+			c := Country{Alpha2: r[0], Alpha3: r[1], Numeric: r[2], Name: r[3]}
+			t.countriesByAlpha2[c.Alpha2] = c
+			t.countriesByAlpha3[c.Alpha3] = c
+			t.countriesByNumeric[c.Numeric] = c
+		}
+
+		for _, r := range readTable("currencies.csv", 4) {
+			minor := NoMinorUnit
+			if r[2] != "" {
+				m, err := strconv.Atoi(r[2])
+				if err != nil {
+					panic(fmt.Sprintf("iso: invalid minor unit for %s: %v", r[0], err))
+				}
+				minor = m
+			}
+			t.currencies[r[0]] = Currency{Code: r[0], Numeric: r[1], Minor: minor, Name: r[3]}
+		}
+
+		for _, r := range readTable("languages.csv", 4) {
+			l := Language{Alpha2: r[0], Alpha3T: r[1], Alpha3B: r[2], Name: r[3]}
+			t.languages[l.Alpha2] = l
+			t.languages[l.Alpha3T] = l
+			t.languages[l.Alpha3B] = l
+		}
+
+		for _, r := range readTable("subdivisions.csv", 4) {
+			t.subdivisions[r[0]] = Subdivision{Code: r[0], Type: r[1], Parent: r[2], Name: r[3]}
+		}
+
+		for _, r := range readTable("postcodes.csv", 3) {
+			t.postcodes[r[0]] = postcodeFormat{pattern: regexp.MustCompile(r[1]), format: r[2]}
+		}
+
+		for _, r := range readTable("phones.csv", 7) {
+			f := phoneFormat{
+				region:   r[0],
+				code:     r[1],
+				trunk:    r[2],
+				intl:     r[3],
+				national: anchoredRegexp(r[4]),
+				mobile:   anchoredRegexp(r[5]),
+				fixed:    anchoredRegexp(r[6]),
+			}
+			t.phones[f.region] = f
+			t.phonesByCode[f.code] = append(t.phonesByCode[f.code], f)
+		}
+
+		loaded = t
+	})
+	return loaded
+}
+
+// anchoredRegexp compiles a pattern that must match a whole string. An empty pattern yields nil.
+func anchoredRegexp(pattern string) *regexp.Regexp {
+	if pattern == "" {
+		return nil
+	}
+	return regexp.MustCompile("^(?:" + pattern + ")$")
+}
+
+// readTable reads an embedded CSV table, skipping its header row.
+func readTable(name string, fields int) [][]string {
+	f, err := data.Open("data/" + name)
+	if err != nil {
+		panic(fmt.Sprintf("iso: %v", err))
+	}
+	defer f.Close()
+
+	reader := csv.NewReader(f)
+	reader.FieldsPerRecord = fields
+	records, err := reader.ReadAll()
+	if err != nil {
+		panic(fmt.Sprintf("iso: invalid table %s: %v", name, err))
+	}
+	return records[1:]
+}
+
+// CountryByAlpha2 returns the country with the given ISO 3166-1 alpha-2 code, e.g. "DE".
+func CountryByAlpha2(code string) (Country, bool) {
+	c, ok := load().countriesByAlpha2[code]
+	return c, ok
+}
+
+// CountryByAlpha3 returns the country with the given ISO 3166-1 alpha-3 code, e.g. "DEU".
+func CountryByAlpha3(code string) (Country, bool) {
+	c, ok := load().countriesByAlpha3[code]
+	return c, ok
+}
+
+// CountryByNumeric returns the country with the given three digit ISO 3166-1 numeric code, e.g. "276".
+func CountryByNumeric(code string) (Country, bool) {
+	c, ok := load().countriesByNumeric[code]
+	return c, ok
+}
+
+// CurrencyByCode returns the currency with the given ISO 4217 alphabetic code, e.g. "EUR".
+func CurrencyByCode(code string) (Currency, bool) {
+	c, ok := load().currencies[code]
+	return c, ok
+}
+
+// LanguageByCode returns the language with the given ISO 639-1 code (e.g. "de"),
+// or ISO 639-2 terminology (e.g. "deu") or bibliographic (e.g. "ger") code.
+func LanguageByCode(code string) (Language, bool) {
+	l, ok := load().languages[code]
+	return l, ok
+}
+
+// SubdivisionByCode returns the subdivision with the given ISO 3166-2 code, e.g. "DE-BY".
+func SubdivisionByCode(code string) (Subdivision, bool) {
+	s, ok := load().subdivisions[code]
+	return s, ok
+}
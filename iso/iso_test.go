@@ -0,0 +1,93 @@
+package iso
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountries(t *testing.T) {
+	t.Run("Lookup by each code", func(t *testing.T) {
+		c, ok := CountryByAlpha2("DE")
+		assert.True(t, ok)
+		assert.Equal(t, Country{Alpha2: "DE", Alpha3: "DEU", Numeric: "276", Name: "Germany"}, c)
+
+		c, ok = CountryByAlpha3("FRA")
+		assert.True(t, ok)
+		assert.Equal(t, "FR", c.Alpha2)
+
+		c, ok = CountryByNumeric("004")
+		assert.True(t, ok)
+		assert.Equal(t, "AF", c.Alpha2)
+	})
+
+	t.Run("Unknown codes", func(t *testing.T) {
+		_, ok := CountryByAlpha2("XX")
+		assert.False(t, ok)
+		_, ok = CountryByAlpha2("de")
+		assert.False(t, ok)
+		_, ok = CountryByNumeric("4")
+		assert.False(t, ok)
+	})
+
+	t.Run("Table size", func(t *testing.T) {
+		assert.Len(t, load().countriesByAlpha2, 249)
+		assert.Len(t, load().countriesByAlpha3, 249)
+		assert.Len(t, load().countriesByNumeric, 249)
+	})
+}
+
+func TestCurrencies(t *testing.T) {
+	t.Run("Minor units", func(t *testing.T) {
+		for code, minor := range map[string]int{"EUR": 2, "JPY": 0, "KWD": 3, "CLF": 4, "XAU": NoMinorUnit} {
+			c, ok := CurrencyByCode(code)
+			assert.True(t, ok, code)
+			assert.Equal(t, minor, c.Minor, code)
+		}
+	})
+
+	t.Run("Unknown code", func(t *testing.T) {
+		_, ok := CurrencyByCode("ABC")
+		assert.False(t, ok)
+	})
+
+	t.Run("Numeric codes are unique", func(t *testing.T) {
+		seen := map[string]string{}
+		for code, c := range load().currencies {
+			assert.Len(t, c.Numeric, 3, code)
+			assert.NotContains(t, seen, c.Numeric, code)
+			seen[c.Numeric] = code
+		}
+	})
+}
+
+func TestLanguages(t *testing.T) {
+	for _, code := range []string{"de", "deu", "ger"} {
+		l, ok := LanguageByCode(code)
+		assert.True(t, ok, code)
+		assert.Equal(t, "German", l.Name)
+	}
+
+	_, ok := LanguageByCode("iw")
+	assert.False(t, ok, "withdrawn codes are not accepted")
+	_, ok = LanguageByCode("DE")
+	assert.False(t, ok)
+}
+
+func TestSubdivisions(t *testing.T) {
+	s, ok := SubdivisionByCode("FR-75")
+	assert.True(t, ok)
+	assert.Equal(t, "FR", s.Country())
+	assert.Equal(t, "FR-IDF", s.Parent)
+
+	_, ok = SubdivisionByCode(s.Parent)
+	assert.True(t, ok)
+
+	_, ok = SubdivisionByCode("DE-XX")
+	assert.False(t, ok)
+
+	for code, s := range load().subdivisions {
+		_, ok := CountryByAlpha2(s.Country())
+		assert.True(t, ok, code)
+	}
+}
@@ -0,0 +1,142 @@
+package iso
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// PhoneType classifies a phone number by the kind of line it reaches.
+type PhoneType string
+
+const (
+	// PhoneMobile is a number in a mobile range.
+	PhoneMobile PhoneType = "mobile"
+	// PhoneFixedLine is a number in a geographic fixed-line range.
+	PhoneFixedLine PhoneType = "fixed"
+	// PhoneFixedLineOrMobile is a number in a region where mobile and fixed-line numbers share ranges, such as the US.
+	PhoneFixedLineOrMobile PhoneType = "fixedormobile"
+	// PhoneUnknown is a valid number that is neither in a known mobile nor fixed-line range, e.g. a service number.
+	PhoneUnknown PhoneType = "unknown"
+)
+
+// phoneFormat is the numbering plan of one region: its country calling code, national trunk prefix,
+// international call prefix, and patterns for the national significant number (NSN) and its mobile and fixed-line ranges.
+type phoneFormat struct {
+	region   string
+	code     string
+	trunk    string
+	intl     string
+	national *regexp.Regexp
+	mobile   *regexp.Regexp
+	fixed    *regexp.Regexp
+}
+
+// PhoneNumber is a parsed phone number.
+type PhoneNumber struct {
+	// Region is the ISO 3166-1 alpha-2 code of the region the number belongs to.
+	Region string
+	// CallingCode is the country calling code, without the leading "+".
+	CallingCode string
+	// National is the national significant number, without trunk prefix.
+	National string
+	// Type is the kind of line the number reaches.
+	Type PhoneType
+}
+
+// E164 returns the number in E.164 form, e.g. "+4930123456".
+func (p PhoneNumber) E164() string {
+	return "+" + p.CallingCode + p.National
+}
+
+// phoneSeparators are the characters allowed between digits when phone numbers are written for humans.
+var phoneSeparators = strings.NewReplacer(" ", "", "-", "", ".", "", "(", "", ")", "", "/", "")
+
+// ParsePhone parses a phone number written in international form ("+49 30 123456", "0049 30 123456")
+// or, given the ISO 3166-1 alpha-2 code of a default region, in national form ("030 123456").
+// Spaces, hyphens, dots, slashes and parentheses between digits are ignored.
+//
+// The country calling code must be known, and the national significant number must match the numbering plan
+// of the region. Where several regions share a calling code (e.g. +1 for US and CA, +7 for RU and KZ),
+// the default region is tried first.
+//
+// The metadata is a simplified numbering plan for common regions; it checks lengths and leading digits,
+// not whether a number is assigned.
+func ParsePhone(number string, defaultRegion string) (PhoneNumber, error) {
+	defaultRegion = strings.ToUpper(defaultRegion)
+	var region *phoneFormat
+	if defaultRegion != "" {
+		f, ok := load().phones[defaultRegion]
+		if !ok {
+			return PhoneNumber{}, fmt.Errorf("no phone number metadata for region %s", defaultRegion)
+		}
+		region = &f
+	}
+
+	digits := phoneSeparators.Replace(strings.TrimSpace(number))
+	international := strings.HasPrefix(digits, "+")
+	digits = strings.TrimPrefix(digits, "+")
+	if digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return PhoneNumber{}, fmt.Errorf("%s is not a phone number", number)
+	}
+
+	if !international && region != nil && region.intl != "" && strings.HasPrefix(digits, region.intl) {
+		// Dialled with the international call prefix of the default region, e.g. 0049 or 011 49
+		digits = strings.TrimPrefix(digits, region.intl)
+		international = true
+	}
+
+	if !international {
+		if region == nil {
+			return PhoneNumber{}, fmt.Errorf("%s is not in international format and no default region is given", number)
+		}
+		if p, ok := matchPhone(*region, digits); ok {
+			return p, nil
+		}
+		return PhoneNumber{}, fmt.Errorf("%s is not a valid phone number for %s", number, region.region)
+	}
+
+	// Calling codes are prefix-free, so the first known prefix is the calling code
+	for n := 1; n <= 3 && n < len(digits); n++ {
+		formats, ok := load().phonesByCode[digits[:n]]
+		if !ok {
+			continue
+		}
+
+		if region != nil && region.code == digits[:n] {
+			if p, ok := matchPhone(*region, digits[n:]); ok {
+				return p, nil
+			}
+		}
+		for _, f := range formats {
+			if p, ok := matchPhone(f, digits[n:]); ok {
+				return p, nil
+			}
+		}
+		return PhoneNumber{}, fmt.Errorf("%s is not a valid phone number for calling code +%s", number, digits[:n])
+	}
+
+	return PhoneNumber{}, fmt.Errorf("%s has an unknown country calling code", number)
+}
+
+// matchPhone matches a national number against a region's numbering plan, with or without its trunk prefix.
+// The trunk prefix is also accepted after the calling code, as in "+44 (0)20 7946 0958".
+func matchPhone(f phoneFormat, digits string) (PhoneNumber, bool) {
+	national := digits
+	if f.trunk != "" && strings.HasPrefix(digits, f.trunk) && f.national.MatchString(digits[len(f.trunk):]) {
+		national = digits[len(f.trunk):]
+	} else if !f.national.MatchString(digits) {
+		return PhoneNumber{}, false
+	}
+
+	p := PhoneNumber{Region: f.region, CallingCode: f.code, National: national, Type: PhoneUnknown}
+	switch {
+	case f.mobile == nil && f.fixed == nil:
+		p.Type = PhoneFixedLineOrMobile
+	case f.mobile != nil && f.mobile.MatchString(national):
+		p.Type = PhoneMobile
+	case f.fixed != nil && f.fixed.MatchString(national):
+		p.Type = PhoneFixedLine
+	}
+	return p, true
+}
@@ -0,0 +1,113 @@
+package iso
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePhone(t *testing.T) {
+	t.Run("International formats", func(t *testing.T) {
+		cases := []struct{ input, e164, region string }{
+			{"+49 30 123456", "+4930123456", "DE"},
+			{"+44 20 7946 0958", "+442079460958", "GB"},
+			{"+44 (0)20 7946 0958", "+442079460958", "GB"},
+			{"+1 (212) 555-1234", "+12125551234", "US"},
+			{"+33 6 12 34 56 78", "+33612345678", "FR"},
+			{"+39 06 1234 5678", "+390612345678", "IT"},
+			{"+7 495 123-45-67", "+74951234567", "RU"},
+			{"+7 701 123 4567", "+77011234567", "KZ"},
+		}
+		for _, c := range cases {
+			p, err := ParsePhone(c.input, "")
+			assert.NoError(t, err, c.input)
+			assert.Equal(t, c.e164, p.E164(), c.input)
+			assert.Equal(t, c.region, p.Region, c.input)
+		}
+	})
+
+	t.Run("National formats", func(t *testing.T) {
+		p, err := ParsePhone("030 123456", "DE")
+		assert.NoError(t, err)
+		assert.Equal(t, "+4930123456", p.E164())
+
+		p, err = ParsePhone("(212) 555-1234", "us")
+		assert.NoError(t, err)
+		assert.Equal(t, "+12125551234", p.E164())
+
+		p, err = ParsePhone("06 1234 5678", "IT")
+		assert.NoError(t, err)
+		assert.Equal(t, "+390612345678", p.E164(), "Italian numbers keep their leading zero")
+	})
+
+	t.Run("International call prefix of the default region", func(t *testing.T) {
+		p, err := ParsePhone("0049 30 123456", "FR")
+		assert.NoError(t, err)
+		assert.Equal(t, "DE", p.Region)
+
+		p, err = ParsePhone("011 44 20 7946 0958", "US")
+		assert.NoError(t, err)
+		assert.Equal(t, "GB", p.Region)
+	})
+
+	t.Run("Shared calling code prefers the default region", func(t *testing.T) {
+		p, err := ParsePhone("+1 613 555 0123", "CA")
+		assert.NoError(t, err)
+		assert.Equal(t, "CA", p.Region)
+
+		p, err = ParsePhone("+1 613 555 0123", "")
+		assert.NoError(t, err)
+		assert.Equal(t, "CA", p.Region, "Canadian area code")
+
+		p, err = ParsePhone("+1 212 555 1234", "CA")
+		assert.NoError(t, err)
+		assert.Equal(t, "US", p.Region, "not a Canadian area code")
+	})
+
+	t.Run("Classification", func(t *testing.T) {
+		p, _ := ParsePhone("+49 151 23456789", "")
+		assert.Equal(t, PhoneMobile, p.Type)
+
+		p, _ = ParsePhone("+49 30 123456", "")
+		assert.Equal(t, PhoneFixedLine, p.Type)
+
+		p, _ = ParsePhone("+1 212 555 1234", "")
+		assert.Equal(t, PhoneFixedLineOrMobile, p.Type)
+
+		p, _ = ParsePhone("+44 800 123 4567", "")
+		assert.Equal(t, PhoneUnknown, p.Type)
+	})
+
+	t.Run("Invalid numbers", func(t *testing.T) {
+		_, err := ParsePhone("030 123456", "")
+		assert.Error(t, err)
+		assert.Equal(t, "030 123456 is not in international format and no default region is given", err.Error())
+
+		_, err = ParsePhone("+49 30", "")
+		assert.Error(t, err)
+		assert.Equal(t, "+49 30 is not a valid phone number for calling code +49", err.Error())
+
+		_, err = ParsePhone("+999 123456", "")
+		assert.Error(t, err)
+		assert.Equal(t, "+999 123456 has an unknown country calling code", err.Error())
+
+		_, err = ParsePhone("123 abc", "DE")
+		assert.Error(t, err)
+		assert.Equal(t, "123 abc is not a phone number", err.Error())
+
+		_, err = ParsePhone("12", "DE")
+		assert.Error(t, err)
+		assert.Equal(t, "12 is not a valid phone number for DE", err.Error())
+
+		_, err = ParsePhone("+4930123456", "XX")
+		assert.Error(t, err)
+		assert.Equal(t, "no phone number metadata for region XX", err.Error())
+	})
+
+	t.Run("Metadata is keyed by known countries", func(t *testing.T) {
+		for region := range load().phones {
+			_, ok := CountryByAlpha2(region)
+			assert.True(t, ok, region)
+		}
+	})
+}
@@ -0,0 +1,52 @@
+package iso
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// postcodeFormat is the postal code format of one country. The pattern matches the compact form of a
+// postal code (upper case, without spaces and hyphens) and its groups are expanded into format to
+// produce the canonical form, e.g. "SW1A1AA" becomes "SW1A 1AA" for GB.
+type postcodeFormat struct {
+	pattern *regexp.Regexp
+	format  string
+}
+
+// ErrUnsupportedCountry is returned by CanonicalPostcode for countries without a known postal code format,
+// such as HK and AE, which do not use postal codes.
+var ErrUnsupportedCountry = errors.New("unsupported country")
+
+// HasPostcodes reports whether a postal code format is known for the given ISO 3166-1 alpha-2 country code.
+func HasPostcodes(country string) bool {
+	_, ok := load().postcodes[strings.ToUpper(country)]
+	return ok
+}
+
+// CanonicalPostcode validates a postal code against the format of the given ISO 3166-1 alpha-2 country code
+// and returns it in canonical form. Case, spaces and hyphens in the input are not significant,
+// so "sw1a1aa" and "SW1A-1AA" both return "SW1A 1AA" for GB, and "12345 6789" returns "12345-6789" for US.
+//
+// Returns an error if the country code is unknown, the postal code does not match the format, or
+// ErrUnsupportedCountry if the country is known but no format is, see HasPostcodes.
+func CanonicalPostcode(country string, code string) (string, error) {
+	format, ok := load().postcodes[strings.ToUpper(country)]
+	if !ok {
+		if _, known := CountryByAlpha2(strings.ToUpper(country)); !known {
+			return "", fmt.Errorf("unknown country %s", country)
+		}
+		return "", fmt.Errorf("%w %s: no postal code format is known", ErrUnsupportedCountry, strings.ToUpper(country))
+	}
+
+	compact := strings.ToUpper(strings.NewReplacer(" ", "", "-", "").Replace(code))
+	match := format.pattern.FindStringSubmatchIndex(compact)
+	if match == nil {
+		return "", fmt.Errorf("%s is not a valid postal code for %s", code, strings.ToUpper(country))
+	}
+
+	// Optional trailing groups, such as the ZIP+4 extension, leave a dangling separator
+	canonical := format.pattern.ExpandString(nil, format.format, compact, match)
+	return strings.TrimRight(string(canonical), " -"), nil
+}
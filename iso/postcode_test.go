@@ -0,0 +1,73 @@
+package iso
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanonicalPostcode(t *testing.T) {
+	t.Run("Canonical forms", func(t *testing.T) {
+		cases := []struct{ country, input, canonical string }{
+			{"GB", "sw1a1aa", "SW1A 1AA"},
+			{"GB", "SW1A 1AA", "SW1A 1AA"},
+			{"GB", "M1 1AE", "M1 1AE"},
+			{"CA", "k1a 0b1", "K1A 0B1"},
+			{"NL", "1234ab", "1234 AB"},
+			{"US", "12345", "12345"},
+			{"US", "12345 6789", "12345-6789"},
+			{"PL", "00950", "00-950"},
+			{"JP", "100-0001", "100-0001"},
+			{"LV", "1050", "LV-1050"},
+			{"DE", "10115", "10115"},
+			{"de", "10115", "10115"},
+		}
+		for _, c := range cases {
+			canonical, err := CanonicalPostcode(c.country, c.input)
+			assert.NoError(t, err, c.input)
+			assert.Equal(t, c.canonical, canonical, c.input)
+		}
+	})
+
+	t.Run("Invalid postal codes", func(t *testing.T) {
+		for country, input := range map[string]string{"DE": "1011", "NL": "1234 SA", "CA": "D1A 0B1", "US": "1234"} {
+			_, err := CanonicalPostcode(country, input)
+			assert.Error(t, err, input)
+		}
+
+		_, err := CanonicalPostcode("DE", "1011")
+		assert.Equal(t, "1011 is not a valid postal code for DE", err.Error())
+	})
+
+	t.Run("Unsupported country", func(t *testing.T) {
+		_, err := CanonicalPostcode("hk", "999077")
+		assert.ErrorIs(t, err, ErrUnsupportedCountry)
+		assert.Equal(t, "unsupported country HK: no postal code format is known", err.Error())
+		assert.False(t, HasPostcodes("HK"))
+		assert.True(t, HasPostcodes("DE"))
+	})
+
+	t.Run("Every country without a format is unsupported", func(t *testing.T) {
+		for _, country := range load().countriesByAlpha2 {
+			if HasPostcodes(country.Alpha2) {
+				continue
+			}
+			_, err := CanonicalPostcode(country.Alpha2, "12345")
+			assert.True(t, errors.Is(err, ErrUnsupportedCountry), country.Alpha2)
+		}
+	})
+
+	t.Run("Unknown country", func(t *testing.T) {
+		_, err := CanonicalPostcode("XX", "12345")
+		assert.False(t, errors.Is(err, ErrUnsupportedCountry))
+		assert.Equal(t, "unknown country XX", err.Error())
+	})
+
+	t.Run("Formats are keyed by known countries", func(t *testing.T) {
+		for country := range load().postcodes {
+			_, ok := CountryByAlpha2(country)
+			assert.True(t, ok, country)
+		}
+	})
+}
@@ -0,0 +1,109 @@
+package regex
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]func() *regexp.Regexp{
+		"alpha":        AlphaRegex,
+		"alphanum":     AlphaNumericRegex,
+		"alphaunicode": AlphaUnicodeRegex,
+		"num":          NumericRegex,
+		"unum":         NumericUnsignedRegex,
+		"hex":          HexadecimalRegex,
+		"hexcolor":     HexColorRegex,
+		"e164":         E164Regex,
+		"uuid":         UUIDRFC4122Regex,
+		"ulid":         ULIDRegex,
+		"semver":       SemverRegex,
+		"bic":          BicRegex,
+		"cve":          CveRegex,
+	}
+)
+
+// Register adds a named pattern to the registry so that it can be referenced from rule text
+// as `regex:@name` or `notregex:@name`. The pattern is validated immediately and compiled once
+// on first use. Registering a name twice replaces the previous pattern.
+//
+// Example:
+//
+//	err := regex.Register("slug", `^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+func Register(name string, pattern string) error {
+	if name == "" {
+		return fmt.Errorf("regex name cannot be empty")
+	}
+	if _, err := regexp.Compile(pattern); err != nil {
+		return fmt.Errorf("invalid regex %s for %s: %w", pattern, name, err)
+	}
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry[name] = CompileOnce(pattern)
+	return nil
+}
+
+// Lookup returns the compiled pattern registered under name.
+// Returns false if no pattern has been registered with that name.
+func Lookup(name string) (*regexp.Regexp, bool) {
+	registryMu.RLock()
+	compiled, ok := registry[name]
+	registryMu.RUnlock()
+	if !ok {
+		return nil, false
+	}
+	return compiled(), true
+}
+
+// IsLiteral reports whether text uses the regex literal syntax `/pattern/flags`.
+func IsLiteral(text string) bool {
+	return len(text) >= 2 && text[0] == '/' && strings.LastIndex(text, "/") > 0
+}
+
+// Literal compiles a regex literal of the form `/pattern/flags`, e.g. `/^[a-z]+$/i`.
+// The pattern between the first and last slash is used as-is, so slashes inside it need no escaping.
+//
+// Supported flags:
+//   - i: case-insensitive matching
+//   - m: multiline mode, ^ and $ match at line boundaries
+//   - s: dotall mode, . matches \n
+//   - U: ungreedy, swaps the meaning of x* and x*?
+//
+// Returns an error if the literal is malformed, a flag is unknown or repeated, or the pattern does not compile.
+func Literal(text string) (*regexp.Regexp, error) {
+	if !IsLiteral(text) {
+		return nil, fmt.Errorf("invalid regex literal: %s", text)
+	}
+
+	end := strings.LastIndex(text, "/")
+	pattern := text[1:end]
+	flags := text[end+1:]
+
+	seen := make(map[rune]bool, len(flags))
+	for _, flag := range flags {
+		switch flag {
+		case 'i', 'm', 's', 'U':
+			if seen[flag] {
+				return nil, fmt.Errorf("duplicate regex flag %c in %s", flag, text)
+			}
+			seen[flag] = true
+		default:
+			return nil, fmt.Errorf("unknown regex flag %c in %s", flag, text)
+		}
+	}
+
+	if len(flags) > 0 {
+		pattern = "(?" + flags + ")" + pattern
+	}
+
+	exp, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid regex %s: %w", text, err)
+	}
+
+	return exp, nil
+}
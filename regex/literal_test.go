@@ -0,0 +1,89 @@
+package regex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLiteral(t *testing.T) {
+	t.Run("Pattern without flags", func(t *testing.T) {
+		exp, err := Literal(`/^[a-z]+$/`)
+		assert.NoError(t, err)
+		assert.True(t, exp.MatchString("abc"))
+		assert.False(t, exp.MatchString("ABC"))
+	})
+
+	t.Run("Case-insensitive flag", func(t *testing.T) {
+		exp, err := Literal(`/^[a-z]+$/i`)
+		assert.NoError(t, err)
+		assert.True(t, exp.MatchString("ABC"))
+	})
+
+	t.Run("Multiline and dotall flags", func(t *testing.T) {
+		exp, err := Literal(`/^b$/m`)
+		assert.NoError(t, err)
+		assert.True(t, exp.MatchString("a\nb\nc"))
+
+		exp, err = Literal(`/a.c/s`)
+		assert.NoError(t, err)
+		assert.True(t, exp.MatchString("a\nc"))
+	})
+
+	t.Run("Slashes inside the pattern", func(t *testing.T) {
+		exp, err := Literal(`/^https?://[a-z.]+/$/`)
+		assert.NoError(t, err)
+		assert.True(t, exp.MatchString("https://example.com/"))
+	})
+
+	t.Run("Unknown flag", func(t *testing.T) {
+		_, err := Literal(`/abc/x`)
+		assert.EqualError(t, err, "unknown regex flag x in /abc/x")
+	})
+
+	t.Run("Duplicate flag", func(t *testing.T) {
+		_, err := Literal(`/abc/ii`)
+		assert.EqualError(t, err, "duplicate regex flag i in /abc/ii")
+	})
+
+	t.Run("Invalid pattern", func(t *testing.T) {
+		_, err := Literal(`/(/`)
+		assert.Error(t, err)
+	})
+
+	t.Run("Not a literal", func(t *testing.T) {
+		_, err := Literal(`abc`)
+		assert.EqualError(t, err, "invalid regex literal: abc")
+	})
+}
+
+func TestRegistry(t *testing.T) {
+	t.Run("Built-in pattern", func(t *testing.T) {
+		exp, ok := Lookup("alpha")
+		assert.True(t, ok)
+		assert.True(t, exp.MatchString("abc"))
+	})
+
+	t.Run("Register and lookup", func(t *testing.T) {
+		err := Register("slug", `^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+		assert.NoError(t, err)
+
+		exp, ok := Lookup("slug")
+		assert.True(t, ok)
+		assert.True(t, exp.MatchString("hello-world"))
+		assert.False(t, exp.MatchString("Hello World"))
+	})
+
+	t.Run("Register invalid pattern", func(t *testing.T) {
+		err := Register("broken", `(`)
+		assert.Error(t, err)
+
+		_, ok := Lookup("broken")
+		assert.False(t, ok)
+	})
+
+	t.Run("Unknown name", func(t *testing.T) {
+		_, ok := Lookup("missing")
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,41 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/args"
+	"time"
+)
+
+// After validates that the input is a point in time strictly after the argument.
+// It is expected to be used in validation rules such as `after:2024-01-01`, `after:$StartDate` or `after:$now(-720h)`.
+//
+// Parameters:
+// - input: The value being validated. It can be a time.Time, *time.Time, Unix seconds, or a date/time string.
+// - obj: The object containing additional data (can be used for Field references within the args).
+// - args: A map containing exactly one argument, the time to compare against.
+//
+// Returns nil if the input is valid, or an error if:
+// - The number of arguments is not equal to 1
+// - The input or the argument cannot be converted to a time
+// - The input is not after the argument.
+//
+// Example:
+//
+//	input := "2024-06-01"
+//	obj := nil
+//	args := map[string]Arg{
+//	    "2024-01-01": {Value: "2024-01-01"},
+//	}
+//	err := After(input, obj, args)  // err will be nil
+func After(input any, obj any, arguments map[string]args.Arg) error {
+	lhs, rhs, err := timeArgs("after", input, obj, arguments)
+	if err != nil {
+		return err
+	}
+
+	if !lhs.After(rhs) {
+		return fmt.Errorf("after validation failed: %s is not after %s", lhs.Format(time.RFC3339), rhs.Format(time.RFC3339))
+	}
+
+	return nil
+}
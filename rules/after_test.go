@@ -0,0 +1,45 @@
+package rules
+
+import (
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/functions"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAfter(t *testing.T) {
+	t.Run("Date after literal", func(t *testing.T) {
+		err := After("2024-06-01", nil, map[string]args.Arg{
+			"2024-01-01": {Value: "2024-01-01"},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Date before literal", func(t *testing.T) {
+		err := After("2023-06-01", nil, map[string]args.Arg{
+			"2024-01-01": {Value: "2024-01-01"},
+		})
+		assert.EqualError(t, err, "after validation failed: 2023-06-01T00:00:00Z is not after 2024-01-01T00:00:00Z")
+	})
+
+	t.Run("Not older than 30 days", func(t *testing.T) {
+		restore := functions.SetClock(func() time.Time { return time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC) })
+		defer restore()
+
+		lastMonth := map[string]args.Arg{
+			"$now(-720h)": {Type: args.FunctionArg, Function: args.Function{
+				Name: "now",
+				Args: []args.Arg{{Value: "-720h"}},
+			}},
+		}
+		assert.NoError(t, After("2024-05-15", nil, lastMonth))
+		assert.Error(t, After("2024-04-15", nil, lastMonth))
+	})
+
+	t.Run("Wrong number of arguments", func(t *testing.T) {
+		err := After("2024-06-01", nil, map[string]args.Arg{})
+		assert.EqualError(t, err, "after expects exactly 1 argument, got 0")
+	})
+}
@@ -0,0 +1,164 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/functions"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// aspectRatioOptions are the options of the aspectratio rule.
+var aspectRatioOptions = args.Options{Rule: "aspectratio", Values: []string{"tolerance"}}
+
+// AspectRatio validates the aspect ratio, width to height, of a PNG, JPEG or GIF image, read from its header.
+// It is expected to be used in validation rules such as `aspectratio:16:9`, `aspectratio:1:1,4:3`,
+// `aspectratio:1.78` or `aspectratio:16/9,tolerance=0.01`.
+//
+// Ratios written as `W:H` or `W/H` must match exactly, so that a 1920x1080 image has the ratio 16:9 but a 1366x768 image does not.
+// Decimal ratios match the image ratio rounded to the same number of decimals. The `tolerance=D` option allows the image ratio
+// to differ from the given ratios by at most D instead.
+//
+// Parameters:
+//   - input (any): The image being validated, a byte slice, a string, an io.Reader or a *multipart.FileHeader.
+//     Readers that implement io.Seeker are rewound after reading, other readers are consumed.
+//   - obj (any): The object containing additional data (can be used for Field references within the args).
+//   - arguments: The allowed ratios, as values or Field references, and the optional `tolerance=D`. At least one ratio is required.
+//
+// Returns nil if the input is valid, or an error if:
+//   - No ratio is given or a ratio is invalid
+//   - The input cannot be read or is not a PNG, JPEG or GIF image
+//   - The aspect ratio of the image matches none of the ratios.
+//
+// Example:
+//
+//	input := pngBytes  // a 1024x768 PNG image
+//	obj := nil
+//	args := map[string]Arg{
+//	    "16:9": {Value: "16:9"},
+//	}
+//	err := AspectRatio(input, obj, args)  // err will be: "aspectratio validation failed: aspect ratio 4:3 is not one of 16:9"
+func AspectRatio(input any, obj any, arguments map[string]args.Arg) error {
+	var ratios []aspectRatio
+	tolerance := 0.0
+	for key, arg := range arguments {
+		if aspectRatioOptions.IsOption(arg) {
+			option, err := aspectRatioOptions.Parse(key, arg)
+			if err != nil {
+				return err
+			}
+			t, err := strconv.ParseFloat(option.Text, 64)
+			if err != nil || t < 0 {
+				return fmt.Errorf("aspectratio: invalid value for tolerance: %s", option.Text)
+			}
+			tolerance = t
+			continue
+		}
+
+		eval, err := arg.Evaluate(obj)
+		if err != nil {
+			return err
+		}
+
+		text, ok := eval.(string)
+		if !ok && arg.Type == args.ValueArg {
+			// Decimal ratios are parsed as numbers, so use the argument as written to keep its decimals
+			text = key
+		} else if !ok {
+			value, err := functions.GetFloat(eval)
+			if err != nil {
+				return fmt.Errorf("unsupported type for aspectratio argument %s: %T", key, eval)
+			}
+			text = strconv.FormatFloat(value, 'f', -1, 64)
+		}
+
+		ratio, err := parseAspectRatio(text)
+		if err != nil {
+			return fmt.Errorf("invalid aspectratio argument %s: %w", key, err)
+		}
+		ratios = append(ratios, ratio)
+	}
+
+	if len(ratios) == 0 {
+		return fmt.Errorf("aspectratio expects at least one ratio")
+	}
+
+	config, err := decodeImageConfig(input)
+	if err != nil {
+		return err
+	}
+	if config.Width == 0 || config.Height == 0 {
+		return fmt.Errorf("aspectratio validation failed: image is %dx%d pixels", config.Width, config.Height)
+	}
+
+	texts := make([]string, len(ratios))
+	for i, ratio := range ratios {
+		if ratio.matches(int64(config.Width), int64(config.Height), tolerance) {
+			return nil
+		}
+		texts[i] = ratio.text
+	}
+
+	divisor := gcd(int64(config.Width), int64(config.Height))
+	sort.Strings(texts)
+	return fmt.Errorf("aspectratio validation failed: aspect ratio %d:%d is not one of %s",
+		int64(config.Width)/divisor, int64(config.Height)/divisor, strings.Join(texts, ", "))
+}
+
+// aspectRatio is a ratio given as W:H, W/H or a decimal number.
+type aspectRatio struct {
+	text          string
+	width, height int64 // zero for decimal ratios
+	value         float64
+	decimals      int
+}
+
+// parseAspectRatio parses a ratio such as 16:9, 16/9 or 1.78.
+func parseAspectRatio(text string) (aspectRatio, error) {
+	ratio := aspectRatio{text: text}
+
+	if w, h, found := strings.Cut(strings.Replace(text, "/", ":", 1), ":"); found {
+		width, errW := strconv.ParseInt(w, 10, 64)
+		height, errH := strconv.ParseInt(h, 10, 64)
+		if errW != nil || errH != nil || width <= 0 || height <= 0 {
+			return ratio, fmt.Errorf("expected a ratio such as 16:9, got %q", text)
+		}
+		ratio.width, ratio.height = width, height
+		ratio.value = float64(width) / float64(height)
+		return ratio, nil
+	}
+
+	value, err := strconv.ParseFloat(text, 64)
+	if err != nil || value <= 0 || math.IsInf(value, 0) {
+		return ratio, fmt.Errorf("expected a ratio such as 16:9, got %q", text)
+	}
+	ratio.value = value
+	if _, fraction, found := strings.Cut(text, "."); found {
+		ratio.decimals = len(fraction)
+	}
+	return ratio, nil
+}
+
+// matches reports whether an image of the given dimensions has this ratio.
+func (r aspectRatio) matches(width, height int64, tolerance float64) bool {
+	actual := float64(width) / float64(height)
+	switch {
+	case tolerance > 0:
+		return math.Abs(actual-r.value) <= tolerance
+	case r.height > 0:
+		return width*r.height == height*r.width
+	default:
+		scale := math.Pow10(r.decimals)
+		return math.Round(actual*scale) == math.Round(r.value*scale)
+	}
+}
+
+// gcd returns the greatest common divisor of two positive integers.
+func gcd(a, b int64) int64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
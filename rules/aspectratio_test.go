@@ -0,0 +1,56 @@
+package rules
+
+import (
+	"go-runtimevalidation/args"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAspectRatio(t *testing.T) {
+	hd := encodeImage(t, "png", 1920, 1080)
+	laptop := encodeImage(t, "jpeg", 1366, 768)
+
+	t.Run("Exact ratios", func(t *testing.T) {
+		assert.NoError(t, AspectRatio(hd, nil, map[string]args.Arg{"16:9": {Value: "16:9"}}))
+		assert.NoError(t, AspectRatio(hd, nil, map[string]args.Arg{"16/9": {Value: "16/9"}, "4:3": {Value: "4:3"}}))
+
+		err := AspectRatio(laptop, nil, map[string]args.Arg{"16:9": {Value: "16:9"}, "4:3": {Value: "4:3"}})
+		assert.EqualError(t, err, "aspectratio validation failed: aspect ratio 683:384 is not one of 16:9, 4:3")
+	})
+
+	t.Run("Decimal ratios", func(t *testing.T) {
+		assert.NoError(t, AspectRatio(hd, nil, map[string]args.Arg{"1.78": {Value: 1.78}}))
+		assert.NoError(t, AspectRatio(laptop, nil, map[string]args.Arg{"1.78": {Value: 1.78}}))
+		assert.NoError(t, AspectRatio(encodeImage(t, "gif", 64, 64), nil, map[string]args.Arg{"1": {Value: 1.0}}))
+
+		err := AspectRatio(hd, nil, map[string]args.Arg{"1.5": {Value: 1.5}})
+		assert.EqualError(t, err, "aspectratio validation failed: aspect ratio 16:9 is not one of 1.5")
+	})
+
+	t.Run("Tolerance", func(t *testing.T) {
+		arguments := map[string]args.Arg{"16:9": {Value: "16:9"}, "tolerance=0.01": {Value: "tolerance=0.01"}}
+		assert.NoError(t, AspectRatio(laptop, nil, arguments))
+
+		err := AspectRatio(encodeImage(t, "png", 1024, 768), nil, arguments)
+		assert.EqualError(t, err, "aspectratio validation failed: aspect ratio 4:3 is not one of 16:9")
+	})
+
+	t.Run("Ratio from field", func(t *testing.T) {
+		banner := struct{ Ratio string }{Ratio: "3:1"}
+		arguments := map[string]args.Arg{"$Ratio": {Type: args.FieldArg, Field: "Ratio"}}
+		assert.NoError(t, AspectRatio(encodeImage(t, "png", 600, 200), banner, arguments))
+		assert.Error(t, AspectRatio(hd, banner, arguments))
+	})
+
+	t.Run("Invalid arguments", func(t *testing.T) {
+		err := AspectRatio(hd, nil, map[string]args.Arg{"16:0": {Value: "16:0"}})
+		assert.EqualError(t, err, `invalid aspectratio argument 16:0: expected a ratio such as 16:9, got "16:0"`)
+
+		err = AspectRatio(hd, nil, map[string]args.Arg{"tolerance=0.1": {Value: "tolerance=0.1"}})
+		assert.EqualError(t, err, "aspectratio expects at least one ratio")
+
+		err = AspectRatio(hd, nil, map[string]args.Arg{"16:9": {Value: "16:9"}, "tolerance=-1": {Value: "tolerance=-1"}})
+		assert.EqualError(t, err, "aspectratio: invalid value for tolerance: -1")
+	})
+}
@@ -0,0 +1,63 @@
+package rules
+
+import (
+	"encoding/base32"
+	"fmt"
+	"go-runtimevalidation/args"
+)
+
+// Base32Decoded decodes a Base32 encoded string and validates the decoded content.
+// It is expected to be used in validation rules such as `base32decoded:min=10,max=20` or `base32decoded(ascii):hex`,
+// where the rule in parentheses is parsed by the validation package and applied to the decoded content as a string.
+//
+// The standard alphabet with padding is expected unless the `hex` or `raw` flags are given, which select
+// the extended hex alphabet and unpadded encoding respectively.
+//
+// Parameters:
+//   - input (any): The value being validated, a string.
+//   - obj (any): The object containing additional data (can be used for Field references within the args).
+//   - arguments: The optional flags `hex` and `raw`, and the decoded length bounds `min=N` and `max=N`,
+//     given as numbers or Field references.
+//   - validate: The validation to apply to the decoded content, or nil.
+//
+// Returns nil if the input is valid, or an error if:
+//   - The input is not a string or cannot be decoded
+//   - The decoded content is shorter than min or longer than max bytes
+//   - The validation fails for the decoded content.
+//
+// Example:
+//
+//	input := "JBSWY3DPEE======"
+//	obj := nil
+//	args := map[string]Arg{
+//	    "max=4": {Value: "max=4"},
+//	}
+//	err := Base32Decoded(input, obj, args, nil)  // err will be: "base32decoded validation failed: decoded length 6 is more than 4 bytes"
+func Base32Decoded(input any, obj any, arguments map[string]args.Arg, validate func(value any) error) error {
+	policy, err := parseDecodedPolicy("base32decoded", obj, arguments, "hex", "raw")
+	if err != nil {
+		return err
+	}
+
+	// Check if the input is a string
+	value, ok := input.(string)
+	if !ok {
+		return fmt.Errorf("expected a string, got %T", input)
+	}
+
+	// Select the encoding named by the flags, the names match the Base32* rules
+	encoding, name := base32.StdEncoding, "Base32"
+	if policy.flags["hex"] {
+		encoding, name = base32.HexEncoding, "Base32Hex"
+	}
+	if policy.flags["raw"] {
+		encoding = encoding.WithPadding(base32.NoPadding)
+	}
+
+	decoded, err := encoding.DecodeString(value)
+	if err != nil {
+		return fmt.Errorf("invalid %s string: %s", name, value)
+	}
+
+	return policy.check("base32decoded", decoded, validate)
+}
@@ -0,0 +1,42 @@
+package rules
+
+import (
+	"errors"
+	"go-runtimevalidation/args"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBase32Decoded(t *testing.T) {
+	t.Run("Decoded length", func(t *testing.T) {
+		arguments := map[string]args.Arg{"min=6": {Value: "min=6"}, "max=10": {Value: "max=10"}}
+		assert.NoError(t, Base32Decoded("JBSWY3DPEE======", nil, arguments, nil))
+
+		err := Base32Decoded("JBSWY3DPEE======", nil, map[string]args.Arg{"max=4": {Value: "max=4"}}, nil)
+		assert.EqualError(t, err, "base32decoded validation failed: decoded length 6 is more than 4 bytes")
+	})
+
+	t.Run("Encodings", func(t *testing.T) {
+		assert.NoError(t, Base32Decoded("JBSWY3DPEE", nil, map[string]args.Arg{"raw": {Value: "raw"}}, nil))
+		assert.NoError(t, Base32Decoded("91IMOR3F44======", nil, map[string]args.Arg{"hex": {Value: "hex"}}, nil))
+
+		err := Base32Decoded("JBSWY3DPEE", nil, nil, nil)
+		assert.EqualError(t, err, "invalid Base32 string: JBSWY3DPEE")
+
+		err = Base32Decoded("JBSWY3DPEE======", nil, map[string]args.Arg{"hex": {Value: "hex"}}, nil)
+		assert.EqualError(t, err, "invalid Base32Hex string: JBSWY3DPEE======")
+	})
+
+	t.Run("Nested validation", func(t *testing.T) {
+		err := Base32Decoded("JBSWY3DPEE======", nil, nil, func(value any) error {
+			assert.Equal(t, "Hello!", value)
+			return errors.New("invalid ASCII string")
+		})
+		assert.EqualError(t, err, "base32decoded validation failed: invalid ASCII string")
+	})
+
+	t.Run("Invalid input", func(t *testing.T) {
+		assert.EqualError(t, Base32Decoded(42, nil, nil, nil), "expected a string, got int")
+	})
+}
@@ -0,0 +1,142 @@
+package rules
+
+import (
+	"encoding/base64"
+	"fmt"
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/functions"
+	"strings"
+)
+
+// Base64Decoded decodes a Base64 encoded string and validates the decoded content.
+// It is expected to be used in validation rules such as `base64decoded:min=16,max=64`, `base64decoded(json)`
+// or `base64decoded(jsonfield:alg,oneof:HS256,RS256):url,raw,max=$MaxSize`, where the rule in parentheses is
+// parsed by the validation package and applied to the decoded content as a string.
+//
+// The standard alphabet with padding is expected unless the `url` or `raw` flags are given, which select
+// the URL and filename safe alphabet and unpadded encoding respectively.
+//
+// Parameters:
+//   - input (any): The value being validated, a string.
+//   - obj (any): The object containing additional data (can be used for Field references within the args).
+//   - arguments: The optional flags `url` and `raw`, and the decoded length bounds `min=N` and `max=N`,
+//     given as numbers or Field references.
+//   - validate: The validation to apply to the decoded content, or nil.
+//
+// Returns nil if the input is valid, or an error if:
+//   - The input is not a string or cannot be decoded
+//   - The decoded content is shorter than min or longer than max bytes
+//   - The validation fails for the decoded content.
+//
+// Example:
+//
+//	input := "aGVsbG8="
+//	obj := nil
+//	args := map[string]Arg{
+//	    "min=8": {Value: "min=8"},
+//	}
+//	err := Base64Decoded(input, obj, args, nil)  // err will be: "base64decoded validation failed: decoded length 5 is less than 8 bytes"
+func Base64Decoded(input any, obj any, arguments map[string]args.Arg, validate func(value any) error) error {
+	policy, err := parseDecodedPolicy("base64decoded", obj, arguments, "url", "raw")
+	if err != nil {
+		return err
+	}
+
+	// Check if the input is a string
+	value, ok := input.(string)
+	if !ok {
+		return fmt.Errorf("expected a string, got %T", input)
+	}
+
+	// Select the encoding named by the flags, the names match the Base64* rules
+	encoding, name := base64.StdEncoding, "Base64"
+	if policy.flags["url"] {
+		encoding, name = base64.URLEncoding, "Base64Url"
+	}
+	if policy.flags["raw"] {
+		encoding, name = encoding.WithPadding(base64.NoPadding), strings.Replace(name, "Base64", "Base64Raw", 1)
+	}
+
+	decoded, err := encoding.Strict().DecodeString(value)
+	if err != nil {
+		return fmt.Errorf("invalid %s string: %s", name, value)
+	}
+
+	return policy.check("base64decoded", decoded, validate)
+}
+
+// decodedPolicy holds the constraints shared by the base64decoded, base32decoded and datauridecoded rules.
+type decodedPolicy struct {
+	flags     map[string]bool
+	minBytes  int64
+	maxBytes  int64 // -1 when there is no upper bound
+	mimeTypes []string
+}
+
+// parseDecodedPolicy reads the options of a decoded content rule. Flags lists the options without a value the rule accepts,
+// the `mime=type/subtype` option is accepted only when "mime" is among them.
+func parseDecodedPolicy(rule string, obj any, arguments map[string]args.Arg, flags ...string) (decodedPolicy, error) {
+	policy := decodedPolicy{flags: map[string]bool{}, maxBytes: -1}
+
+	options := args.Options{Rule: rule, Values: []string{"min", "max"}}
+	for _, flag := range flags {
+		if flag == "mime" {
+			options.Values = append(options.Values, flag)
+		} else {
+			options.Flags = append(options.Flags, flag)
+		}
+	}
+
+	for key, arg := range arguments {
+		option, err := options.Parse(key, arg)
+		if err != nil {
+			return policy, err
+		}
+
+		switch option.Name {
+		case "mime":
+			mimeType, subtype, found := strings.Cut(strings.ToLower(option.Text), "/")
+			if !found || mimeType == "" || subtype == "" || mimeType == "*" {
+				return policy, fmt.Errorf("%s: invalid value for mime: %s", rule, option.Text)
+			}
+			policy.mimeTypes = append(policy.mimeTypes, mimeType+"/"+subtype)
+		case "min", "max":
+			eval, err := option.Evaluate(obj)
+			if err != nil {
+				return policy, err
+			}
+			size, err := functions.GetInt(eval)
+			if err != nil || size < 0 {
+				return policy, fmt.Errorf("%s: invalid value for %s: %s", rule, option.Name, option.Text)
+			}
+			if option.Name == "min" {
+				policy.minBytes = size
+			} else {
+				policy.maxBytes = size
+			}
+		default:
+			policy.flags[option.Name] = true
+		}
+	}
+
+	return policy, nil
+}
+
+// check validates the length of the decoded content, then applies the nested validation to it.
+func (p decodedPolicy) check(rule string, decoded []byte, validate func(value any) error) error {
+	size := int64(len(decoded))
+	if size < p.minBytes {
+		return fmt.Errorf("%s validation failed: decoded length %d is less than %d bytes", rule, size, p.minBytes)
+	}
+	if p.maxBytes >= 0 && size > p.maxBytes {
+		return fmt.Errorf("%s validation failed: decoded length %d is more than %d bytes", rule, size, p.maxBytes)
+	}
+
+	if validate != nil {
+		if err := validate(string(decoded)); err != nil {
+			return fmt.Errorf("%s validation failed: %w", rule, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package rules
+
+import (
+	"errors"
+	"go-runtimevalidation/args"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBase64Decoded(t *testing.T) {
+	options := func(values ...string) map[string]args.Arg {
+		arguments := map[string]args.Arg{}
+		for _, v := range values {
+			arguments[v] = args.Arg{Value: v}
+		}
+		return arguments
+	}
+
+	t.Run("Decoded length", func(t *testing.T) {
+		assert.NoError(t, Base64Decoded("aGVsbG8=", nil, options("min=5", "max=5"), nil))
+		assert.NoError(t, Base64Decoded("", nil, nil, nil))
+
+		err := Base64Decoded("aGVsbG8=", nil, options("min=8"), nil)
+		assert.EqualError(t, err, "base64decoded validation failed: decoded length 5 is less than 8 bytes")
+
+		err = Base64Decoded("aGVsbG8gd29ybGQ=", nil, options("max=5"), nil)
+		assert.EqualError(t, err, "base64decoded validation failed: decoded length 11 is more than 5 bytes")
+	})
+
+	t.Run("Length from field", func(t *testing.T) {
+		limits := struct{ MaxSize int }{MaxSize: 4}
+		err := Base64Decoded("aGVsbG8=", limits, options("max=$MaxSize"), nil)
+		assert.EqualError(t, err, "base64decoded validation failed: decoded length 5 is more than 4 bytes")
+	})
+
+	t.Run("Encodings", func(t *testing.T) {
+		// 0xfb 0xff encodes to "+/8=" with the standard alphabet and "-_8=" with the URL safe alphabet
+		assert.NoError(t, Base64Decoded("+/8=", nil, nil, nil))
+		assert.NoError(t, Base64Decoded("-_8=", nil, options("url"), nil))
+		assert.NoError(t, Base64Decoded("-_8", nil, options("url", "raw"), nil))
+		assert.NoError(t, Base64Decoded("+/8", nil, options("raw"), nil))
+
+		assert.EqualError(t, Base64Decoded("-_8=", nil, nil, nil), "invalid Base64 string: -_8=")
+		assert.EqualError(t, Base64Decoded("+/8=", nil, options("url"), nil), "invalid Base64Url string: +/8=")
+		assert.EqualError(t, Base64Decoded("-_8=", nil, options("url", "raw"), nil), "invalid Base64RawUrl string: -_8=")
+		assert.EqualError(t, Base64Decoded("+/8=", nil, options("raw"), nil), "invalid Base64Raw string: +/8=")
+	})
+
+	t.Run("Nested validation", func(t *testing.T) {
+		var decoded any
+		validate := func(value any) error {
+			decoded = value
+			return nil
+		}
+		assert.NoError(t, Base64Decoded("eyJhIjoxfQ==", nil, nil, validate))
+		assert.Equal(t, `{"a":1}`, decoded)
+
+		err := Base64Decoded("aGVsbG8=", nil, nil, func(value any) error { return errors.New("invalid json") })
+		assert.EqualError(t, err, "base64decoded validation failed: invalid json")
+	})
+
+	t.Run("Invalid input", func(t *testing.T) {
+		assert.EqualError(t, Base64Decoded([]byte("aGVsbG8="), nil, nil, nil), "expected a string, got []uint8")
+		assert.EqualError(t, Base64Decoded("aGVsbG8", nil, nil, nil), "invalid Base64 string: aGVsbG8")
+	})
+
+	t.Run("Invalid arguments", func(t *testing.T) {
+		assert.EqualError(t, Base64Decoded("", nil, options("max=-1"), nil), "base64decoded: invalid value for max: -1")
+		assert.EqualError(t, Base64Decoded("", nil, options("hex"), nil), "base64decoded: unknown option hex")
+		assert.EqualError(t, Base64Decoded("", nil, options("mime=text/plain"), nil), "base64decoded: unknown option mime=text/plain")
+	})
+}
@@ -0,0 +1,42 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/functions"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// BCP47 validates that the input is a BCP 47 language tag, e.g. "en", "de-CH" or "zh-Hant-TW".
+// The tag must be well-formed and its language, script and region subtags must be registered.
+// Subtags are separated by hyphens and compared case-insensitively.
+//
+// Parameters:
+// - input (any): The value to be validated. It should be convertible to a string.
+//
+// Returns:
+// - error: If the input is not a valid language tag, it returns an error with details.
+//
+// Example:
+//
+//	err := BCP47("de-CH") // err will be nil
+//	err := BCP47("en_US") // err will be: "invalid bcp47: en_US"
+func BCP47(input any) error {
+	// Check if the input is a string
+	value, err := functions.GetString(input)
+	if err != nil {
+		return fmt.Errorf("expected a string, got %T", input)
+	}
+
+	// The parser also accepts underscores, which are not part of BCP 47
+	if value == "" || strings.Contains(value, "_") {
+		return fmt.Errorf("invalid bcp47: %s", value)
+	}
+
+	if _, err := language.Parse(value); err != nil {
+		return fmt.Errorf("invalid bcp47: %s: %w", value, err)
+	}
+
+	return nil
+}
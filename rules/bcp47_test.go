@@ -0,0 +1,36 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBCP47(t *testing.T) {
+	t.Run("Valid language tags", func(t *testing.T) {
+		for _, tag := range []string{"en", "de-CH", "zh-Hant-TW", "sr-Latn", "es-419", "de-CH-1996", "en-US-x-twain"} {
+			assert.NoError(t, BCP47(tag), tag)
+		}
+	})
+
+	t.Run("Case-insensitive", func(t *testing.T) {
+		assert.NoError(t, BCP47("EN-us"))
+	})
+
+	t.Run("Underscore separator", func(t *testing.T) {
+		err := BCP47("en_US")
+		assert.Error(t, err)
+		assert.Equal(t, "invalid bcp47: en_US", err.Error())
+	})
+
+	t.Run("Unknown language", func(t *testing.T) {
+		err := BCP47("xx-US")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid bcp47: xx-US")
+	})
+
+	t.Run("Malformed tag", func(t *testing.T) {
+		assert.Error(t, BCP47("en-"))
+		assert.Error(t, BCP47(""))
+	})
+}
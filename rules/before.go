@@ -0,0 +1,76 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/functions"
+	"time"
+)
+
+// Before validates that the input is a point in time strictly before the argument.
+// It is expected to be used in validation rules such as `before:2025-01-01`, `before:$EndDate` or `before:$now(24h)`.
+//
+// Parameters:
+// - input: The value being validated. It can be a time.Time, *time.Time, Unix seconds, or a date/time string.
+// - obj: The object containing additional data (can be used for Field references within the args).
+// - args: A map containing exactly one argument, the time to compare against.
+//
+// Returns nil if the input is valid, or an error if:
+// - The number of arguments is not equal to 1
+// - The input or the argument cannot be converted to a time
+// - The input is not before the argument.
+//
+// Example:
+//
+//	input := "2024-06-01"
+//	obj := nil
+//	args := map[string]Arg{
+//	    "2024-01-01": {Value: "2024-01-01"},
+//	}
+//	err := Before(input, obj, args)  // err will be: "before validation failed: 2024-06-01T00:00:00Z is not before 2024-01-01T00:00:00Z"
+func Before(input any, obj any, arguments map[string]args.Arg) error {
+	lhs, rhs, err := timeArgs("before", input, obj, arguments)
+	if err != nil {
+		return err
+	}
+
+	if !lhs.Before(rhs) {
+		return fmt.Errorf("before validation failed: %s is not before %s", lhs.Format(time.RFC3339), rhs.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// timeArgs converts the input and the single argument of a temporal rule into times.
+func timeArgs(name string, input any, obj any, arguments map[string]args.Arg) (time.Time, time.Time, error) {
+	if len(arguments) != 1 {
+		return time.Time{}, time.Time{}, fmt.Errorf("%s expects exactly 1 argument, got %d", name, len(arguments))
+	}
+
+	// Get the first (and only) argument
+	var arg args.Arg
+	for _, v := range arguments {
+		arg = v
+		break
+	}
+
+	// Evaluate the argument
+	eval, err := arg.Evaluate(obj)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	// Get the value of the input
+	lhs, err := functions.GetTime(input)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("unsupported type for input field: %w", err)
+	}
+
+	// Get the value to compare against
+	rhs, err := functions.GetTime(eval)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("unsupported type for %s argument: %w", name, err)
+	}
+
+	return lhs, rhs, nil
+}
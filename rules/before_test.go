@@ -0,0 +1,67 @@
+package rules
+
+import (
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/functions"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBefore(t *testing.T) {
+	t.Run("Date before literal", func(t *testing.T) {
+		err := Before("2023-12-31", nil, map[string]args.Arg{
+			"2024-01-01": {Value: "2024-01-01"},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Date equal to literal", func(t *testing.T) {
+		err := Before("2024-01-01", nil, map[string]args.Arg{
+			"2024-01-01": {Value: "2024-01-01"},
+		})
+		assert.EqualError(t, err, "before validation failed: 2024-01-01T00:00:00Z is not before 2024-01-01T00:00:00Z")
+	})
+
+	t.Run("Time before field", func(t *testing.T) {
+		obj := struct {
+			End time.Time
+		}{End: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)}
+
+		err := Before(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), obj, map[string]args.Arg{
+			"$End": {Type: args.FieldArg, Field: "End"},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Relative bound using now", func(t *testing.T) {
+		restore := functions.SetClock(func() time.Time { return time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC) })
+		defer restore()
+
+		nowPlusDay := map[string]args.Arg{
+			"$now(24h)": {Type: args.FunctionArg, Function: args.Function{
+				Name: "now",
+				Args: []args.Arg{{Value: "24h"}},
+			}},
+		}
+		assert.NoError(t, Before("2024-06-02T11:00:00Z", nil, nowPlusDay))
+		assert.Error(t, Before("2024-06-02T13:00:00Z", nil, nowPlusDay))
+	})
+
+	t.Run("Unparseable input", func(t *testing.T) {
+		err := Before("soon", nil, map[string]args.Arg{
+			"2024-01-01": {Value: "2024-01-01"},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported type for input field")
+	})
+
+	t.Run("Unparseable argument", func(t *testing.T) {
+		err := Before("2024-01-01", nil, map[string]args.Arg{
+			"soon": {Value: "soon"},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported type for before argument")
+	})
+}
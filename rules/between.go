@@ -4,21 +4,23 @@ import (
 	"fmt"
 	"go-runtimevalidation/args"
 	"go-runtimevalidation/functions"
+	"sort"
 )
 
 // Between validates that the input is between two specified bounds.
-// The input must be an integer or a type that can be converted into an integer.
-// It checks against exactly two arguments provided in the args map.
+// The input must be a number: an integer, a float, a *big.Int, *big.Float or *big.Rat, or a decimal string.
+// Numbers are compared exactly, as in Min.
+// It checks against exactly two arguments provided in the args map, taken in the order of their keys.
 //
 // Parameters:
-// - input: The value being validated, expected to be convertible to an integer.
+// - input: The value being validated, expected to be convertible to a number.
 // - obj: The object containing additional data (can be used for Field references within the args).
 // - args: A map of arguments where exactly two entries are expected to specify the bounds.
 //
 // Returns nil if the input is valid, or an error if:
 // - The number of arguments is not equal to 2
-// - The input cannot be converted to an integer
-// - Any of the arguments cannot be converted to an integer
+// - The input cannot be converted to a number
+// - Any of the arguments cannot be converted to a number
 // - The input is not inclusively between the two specified bounds.
 //
 // Example:
@@ -31,21 +33,34 @@ import (
 //	}
 //	err := Between(input, obj, args)  // err will be nil
 func Between(input any, obj any, arguments map[string]args.Arg) error {
+	// Extract the two arguments, ordered by key so that the bounds are read the same way on every call
+	keys := make([]string, 0, len(arguments))
+	for k := range arguments {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	bounds := make([]args.Arg, 0, len(arguments))
+	for _, k := range keys {
+		bounds = append(bounds, arguments[k])
+	}
+
+	return BetweenList(input, obj, bounds)
+}
+
+// BetweenList is Between with the bounds given in order, as read by args.ParseArgList.
+// The validation rule uses it, so that equal bounds such as `between:5,5` are kept as two arguments.
+//
+// Example:
+//
+//	err := BetweenList(input, obj, []Arg{{Value: 10}, {Value: 20}})
+func BetweenList(input any, obj any, arguments []args.Arg) error {
 	if len(arguments) != 2 {
 		return fmt.Errorf("between expects exactly 2 arguments, got %d", len(arguments))
 	}
 
-	// Extract the two arguments
-	var lhsArg, rhsArg args.Arg
-	i := 0
-	for _, v := range arguments {
-		if i == 0 {
-			lhsArg = v
-		} else {
-			rhsArg = v
-		}
-		i++
-	}
+	// Extract the two arguments, in order
+	lhsArg, rhsArg := arguments[0], arguments[1]
 
 	// Evaluate the arguments
 	lhsEval, err := lhsArg.Evaluate(obj)
@@ -59,25 +74,26 @@ func Between(input any, obj any, arguments map[string]args.Arg) error {
 	}
 
 	// Get the value of the input
-	inputVal, err := functions.GetInt(input)
+	inputVal, err := getComparedNumber(input)
 	if err != nil {
 		return fmt.Errorf("unsupported type for input field: %w", err)
 	}
 
 	// Get the values to compare against
-	lhs, err := functions.GetInt(lhsEval)
+	lhs, err := getComparedNumber(lhsEval)
 	if err != nil {
 		return fmt.Errorf("unsupported type for lower bound argument: %w", err)
 	}
 
-	rhs, err := functions.GetInt(rhsEval)
+	rhs, err := getComparedNumber(rhsEval)
 	if err != nil {
 		return fmt.Errorf("unsupported type for upper bound argument: %w", err)
 	}
 
 	// Compare values to determine if input is between bounds
-	if (inputVal < lhs && inputVal < rhs) || (inputVal > lhs && inputVal > rhs) {
-		return fmt.Errorf("between validation failed: %d is not inclusively between %d and %d", inputVal, lhs, rhs)
+	if (inputVal.Cmp(lhs) < 0 && inputVal.Cmp(rhs) < 0) || (inputVal.Cmp(lhs) > 0 && inputVal.Cmp(rhs) > 0) {
+		return fmt.Errorf("between validation failed: %s is not inclusively between %s and %s",
+			functions.FormatNumber(inputVal), functions.FormatNumber(lhs), functions.FormatNumber(rhs))
 	}
 
 	// Validation passed
@@ -2,6 +2,7 @@ package rules
 
 import (
 	"go-runtimevalidation/args"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -82,4 +83,49 @@ func TestBetween(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "failed to parse \"test\" of type string as int64")
 	})
+
+	// Test case 8: Big numbers and decimal strings are compared exactly
+	t.Run("Arbitrary precision", func(t *testing.T) {
+		arguments := map[string]args.Arg{
+			"0.01":         {Value: 0.01},
+			"$MaxTransfer": {Type: args.FieldArg, Field: "MaxTransfer"},
+		}
+		account := struct{ MaxTransfer *big.Rat }{MaxTransfer: big.NewRat(100000001, 100)}
+
+		assert.NoError(t, Between("1000000.01", account, arguments))
+
+		err := Between("1000000.02", account, arguments)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "between validation failed: 1000000.02 is not inclusively between")
+	})
+}
+
+func TestBetweenList(t *testing.T) {
+	// Test case 1: Bounds are read in order
+	t.Run("Ordered bounds", func(t *testing.T) {
+		obj := struct{ Upper int }{Upper: 20}
+		assert.NoError(t, BetweenList(15, obj, []args.Arg{
+			{Value: 10},
+			{Type: args.FieldArg, Field: "Upper"},
+		}))
+	})
+
+	// Test case 2: Equal bounds only accept that value
+	t.Run("Equal bounds", func(t *testing.T) {
+		arguments := []args.Arg{
+			{Value: 5},
+			{Value: 5},
+		}
+		assert.NoError(t, BetweenList(5, nil, arguments))
+
+		err := BetweenList(6, nil, arguments)
+		assert.EqualError(t, err, "between validation failed: 6 is not inclusively between 5 and 5")
+	})
+
+	// Test case 3: Wrong number of arguments
+	t.Run("Wrong number of arguments", func(t *testing.T) {
+		err := BetweenList(15, nil, []args.Arg{{Value: 10}})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "expects exactly 2 arguments, got 1")
+	})
 }
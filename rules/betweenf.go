@@ -4,11 +4,13 @@ import (
 	"fmt"
 	"go-runtimevalidation/args"
 	"go-runtimevalidation/functions"
+	"math"
+	"sort"
 )
 
 // BetweenF validates that the input is between two specified bounds.
 // The input must be a number or a type that can be converted into a float.
-// It checks against exactly two arguments provided in the args map.
+// It checks against exactly two arguments provided in the args map, taken in the order of their keys.
 //
 // Parameters:
 // - input: The value being validated, expected to be convertible to a float.
@@ -31,21 +33,34 @@ import (
 //	}
 //	err := BetweenF(input, obj, args)  // err will be nil
 func BetweenF(input any, obj any, arguments map[string]args.Arg) error {
+	// Extract the two arguments, ordered by key so that the bounds are read the same way on every call
+	keys := make([]string, 0, len(arguments))
+	for k := range arguments {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	bounds := make([]args.Arg, 0, len(arguments))
+	for _, k := range keys {
+		bounds = append(bounds, arguments[k])
+	}
+
+	return BetweenFList(input, obj, bounds)
+}
+
+// BetweenFList is BetweenF with the bounds given in order, as read by args.ParseArgList.
+// The validation rule uses it, so that equal bounds such as `betweenf:5,5` are kept as two arguments.
+//
+// Example:
+//
+//	err := BetweenFList(input, obj, []Arg{{Value: 10}, {Value: 20}})
+func BetweenFList(input any, obj any, arguments []args.Arg) error {
 	if len(arguments) != 2 {
 		return fmt.Errorf("between expects exactly 2 arguments, got %d", len(arguments))
 	}
 
-	// Extract the two arguments
-	var lhsArg, rhsArg args.Arg
-	i := 0
-	for _, v := range arguments {
-		if i == 0 {
-			lhsArg = v
-		} else {
-			rhsArg = v
-		}
-		i++
-	}
+	// Extract the two arguments, in order
+	lhsArg, rhsArg := arguments[0], arguments[1]
 
 	// Evaluate the arguments
 	lhsEval, err := lhsArg.Evaluate(obj)
@@ -63,6 +78,10 @@ func BetweenF(input any, obj any, arguments map[string]args.Arg) error {
 	if err != nil {
 		return fmt.Errorf("unsupported type for input field: %w", err)
 	}
+	// NaN compares false to every bound, so it would otherwise pass
+	if math.IsNaN(inputVal) {
+		return fmt.Errorf("between validation failed: NaN is not a number")
+	}
 
 	// Get the values to compare against
 	lhs, err := functions.GetFloat(lhsEval)
@@ -2,6 +2,7 @@ package rules
 
 import (
 	"go-runtimevalidation/args"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -160,4 +161,43 @@ func TestBetweenF(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "failed to parse \"test\" of type string as float64")
 	})
+
+	// Test that NaN is rejected
+	t.Run("NaN input", func(t *testing.T) {
+		err := BetweenF(math.NaN(), nil, map[string]args.Arg{
+			"lowerBound": {Value: 0},
+			"upperBound": {Value: 1},
+		})
+		assert.EqualError(t, err, "between validation failed: NaN is not a number")
+	})
+}
+
+func TestBetweenFList(t *testing.T) {
+	// Test case 1: Bounds are read in order
+	t.Run("Ordered bounds", func(t *testing.T) {
+		obj := struct{ Upper int }{Upper: 20}
+		assert.NoError(t, BetweenFList(15, obj, []args.Arg{
+			{Value: 10},
+			{Type: args.FieldArg, Field: "Upper"},
+		}))
+	})
+
+	// Test case 2: Equal bounds only accept that value
+	t.Run("Equal bounds", func(t *testing.T) {
+		arguments := []args.Arg{
+			{Value: 0.5},
+			{Value: 0.5},
+		}
+		assert.NoError(t, BetweenFList(0.5, nil, arguments))
+
+		err := BetweenFList(0.6, nil, arguments)
+		assert.EqualError(t, err, "between validation failed: 0.600000 is not inclusively between 0.500000 and 0.500000")
+	})
+
+	// Test case 3: Wrong number of arguments
+	t.Run("Wrong number of arguments", func(t *testing.T) {
+		err := BetweenFList(15, nil, []args.Arg{{Value: 10}})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "expects exactly 2 arguments, got 1")
+	})
 }
@@ -0,0 +1,79 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/args"
+	"strings"
+)
+
+// ibanBICCountries lists the territories whose banks may hold a BIC with their own country code
+// while issuing IBANs with the country code of the state they belong to.
+var ibanBICCountries = map[string][]string{
+	"FR": {"GF", "GP", "MQ", "RE", "YT", "NC", "PF", "PM", "TF", "WF", "BL", "MF"},
+	"GB": {"GG", "JE", "IM"},
+	"FI": {"AX"},
+}
+
+// BICIBAN validates that the input is a valid BIC whose country matches the country of an IBAN.
+// It is expected to be used on the BIC field with the IBAN field as argument, e.g. `biciban:$IBAN`.
+// Overseas territories that use the IBAN format of their state (e.g. a BIC from Réunion with a French IBAN) are accepted.
+//
+// Parameters:
+// - input: The value being validated, expected to be a BIC.
+// - obj: The object containing additional data (can be used for Field references within the args).
+// - args: A map containing exactly one argument, the IBAN.
+//
+// Returns nil if the input is valid, or an error if:
+// - The number of arguments is not equal to 1
+// - The input is not a valid BIC, or the argument is not a valid IBAN
+// - The BIC and IBAN countries differ.
+//
+// Example:
+//
+//	input := "DEUTDEFF"
+//	obj := struct{ IBAN string }{IBAN: "FR14 2004 1010 0505 0001 3M02 606"}
+//	args := map[string]Arg{
+//	    "$IBAN": {Type: FieldArg, Field: "IBAN"},
+//	}
+//	err := BICIBAN(input, obj, args)  // err will be: "biciban validation failed: bic country DE does not match iban country FR"
+func BICIBAN(input any, obj any, arguments map[string]args.Arg) error {
+	if len(arguments) != 1 {
+		return fmt.Errorf("biciban expects exactly 1 argument, got %d", len(arguments))
+	}
+
+	// Get the first (and only) argument
+	var arg args.Arg
+	for _, v := range arguments {
+		arg = v
+		break
+	}
+
+	// Evaluate the argument
+	eval, err := arg.Evaluate(obj)
+	if err != nil {
+		return err
+	}
+
+	if err := BIC(input); err != nil {
+		return err
+	}
+
+	iban, err := normalizeIBAN(eval)
+	if err != nil {
+		return fmt.Errorf("unsupported type for biciban argument: %w", err)
+	}
+
+	bicCountry := strings.ToUpper(fmt.Sprint(input)[4:6])
+	ibanCountry := iban[:2]
+	if bicCountry == ibanCountry {
+		return nil
+	}
+
+	for _, territory := range ibanBICCountries[ibanCountry] {
+		if bicCountry == territory {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("biciban validation failed: bic country %s does not match iban country %s", bicCountry, ibanCountry)
+}
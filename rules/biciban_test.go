@@ -0,0 +1,51 @@
+package rules
+
+import (
+	"go-runtimevalidation/args"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBICIBAN(t *testing.T) {
+	ibanArg := map[string]args.Arg{"$IBAN": {Type: args.FieldArg, Field: "IBAN"}}
+
+	t.Run("Matching countries", func(t *testing.T) {
+		obj := struct{ IBAN string }{IBAN: "DE89 3704 0044 0532 0130 00"}
+		err := BICIBAN("DEUTDEFF", obj, ibanArg)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Territory using its state's IBAN", func(t *testing.T) {
+		obj := struct{ IBAN string }{IBAN: "FR1420041010050500013M02606"}
+		err := BICIBAN("BNPARERX", obj, ibanArg)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Mismatching countries", func(t *testing.T) {
+		obj := struct{ IBAN string }{IBAN: "FR14 2004 1010 0505 0001 3M02 606"}
+		err := BICIBAN("DEUTDEFF", obj, ibanArg)
+		assert.Error(t, err)
+		assert.Equal(t, "biciban validation failed: bic country DE does not match iban country FR", err.Error())
+	})
+
+	t.Run("Invalid BIC", func(t *testing.T) {
+		obj := struct{ IBAN string }{IBAN: "DE89370400440532013000"}
+		err := BICIBAN("DEUT@EFF", obj, ibanArg)
+		assert.Error(t, err)
+		assert.Equal(t, "invalid bic: DEUT@EFF", err.Error())
+	})
+
+	t.Run("Invalid IBAN", func(t *testing.T) {
+		obj := struct{ IBAN string }{IBAN: "DE89370400440532013001"}
+		err := BICIBAN("DEUTDEFF", obj, ibanArg)
+		assert.Error(t, err)
+		assert.Equal(t, "unsupported type for biciban argument: invalid iban: DE89370400440532013001 fails checksum", err.Error())
+	})
+
+	t.Run("Wrong number of arguments", func(t *testing.T) {
+		err := BICIBAN("DEUTDEFF", nil, map[string]args.Arg{})
+		assert.Error(t, err)
+		assert.Equal(t, "biciban expects exactly 1 argument, got 0", err.Error())
+	})
+}
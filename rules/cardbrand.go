@@ -0,0 +1,72 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/functions"
+	"sort"
+	"strings"
+)
+
+// CardBrand validates that the input is a valid card number issued by one of the given brands.
+// It is expected to be used in validation rules such as `cardbrand:visa,mastercard,amex`.
+//
+// The brand is detected from the issuer identification number (IIN) prefix and the number of digits.
+// Supported brands are visa, mastercard, amex, discover, dinersclub, jcb, unionpay, maestro and mir.
+// Brand names are compared case-insensitively. Error messages only show the last four digits.
+//
+// Parameters:
+// - input: The value being validated, expected to be a string.
+// - obj: The object containing additional data (can be used for Field references within the args).
+// - args: A map of arguments, each naming an accepted brand.
+//
+// Returns nil if the input is valid, or an error if:
+// - No arguments are provided
+// - The input is not a valid card number (see CreditCard)
+// - The brand cannot be detected or is not one of the accepted brands.
+//
+// Example:
+//
+//	input := "378282246310005"
+//	obj := nil
+//	args := map[string]Arg{
+//	    "visa": {Value: "visa"},
+//	}
+//	err := CardBrand(input, obj, args)  // err will be: "cardbrand validation failed: ***********0005 is amex, expected one of visa"
+func CardBrand(input any, obj any, arguments map[string]args.Arg) error {
+	if len(arguments) == 0 {
+		return fmt.Errorf("cardbrand expects atleast 1 argument, got %d", len(arguments))
+	}
+
+	digits, err := cardDigits(input)
+	if err != nil {
+		return err
+	}
+
+	brand, ok := cardBrand(digits)
+
+	brands := make([]string, 0, len(arguments))
+	for _, v := range arguments {
+		eval, err := v.Evaluate(obj)
+		if err != nil {
+			return err
+		}
+
+		name, err := functions.GetString(eval)
+		if err != nil {
+			return fmt.Errorf("unsupported type for cardbrand argument: %w", err)
+		}
+
+		if ok && strings.EqualFold(name, brand) {
+			return nil
+		}
+		brands = append(brands, name)
+	}
+
+	if !ok {
+		return fmt.Errorf("cardbrand validation failed: %s has an unknown brand", maskCard(digits))
+	}
+
+	sort.Strings(brands)
+	return fmt.Errorf("cardbrand validation failed: %s is %s, expected one of %s", maskCard(digits), brand, strings.Join(brands, ", "))
+}
@@ -0,0 +1,53 @@
+package rules
+
+import (
+	"go-runtimevalidation/args"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCardBrand(t *testing.T) {
+	accepted := map[string]args.Arg{
+		"visa":       {Value: "visa"},
+		"mastercard": {Value: "mastercard"},
+	}
+
+	t.Run("Accepted brand", func(t *testing.T) {
+		assert.NoError(t, CardBrand("5555 5555 5555 4444", nil, accepted))
+	})
+
+	t.Run("Accepted brand with different case", func(t *testing.T) {
+		err := CardBrand("4111111111111111", nil, map[string]args.Arg{
+			"VISA": {Value: "VISA"},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Rejected brand is masked", func(t *testing.T) {
+		err := CardBrand("378282246310005", nil, map[string]args.Arg{
+			"visa": {Value: "visa"},
+		})
+		assert.EqualError(t, err, "cardbrand validation failed: ***********0005 is amex, expected one of visa")
+	})
+
+	t.Run("Rejected brand lists the accepted brands in order", func(t *testing.T) {
+		err := CardBrand("378282246310005", nil, accepted)
+		assert.EqualError(t, err, "cardbrand validation failed: ***********0005 is amex, expected one of mastercard, visa")
+	})
+
+	t.Run("Unknown brand", func(t *testing.T) {
+		err := CardBrand("9999999999999995", nil, accepted)
+		assert.EqualError(t, err, "cardbrand validation failed: ************9995 has an unknown brand")
+	})
+
+	t.Run("Invalid card number", func(t *testing.T) {
+		err := CardBrand("4111111111111112", nil, accepted)
+		assert.EqualError(t, err, "invalid credit card: ************1112 fails checksum")
+	})
+
+	t.Run("No arguments", func(t *testing.T) {
+		err := CardBrand("4111111111111111", nil, map[string]args.Arg{})
+		assert.EqualError(t, err, "cardbrand expects atleast 1 argument, got 0")
+	})
+}
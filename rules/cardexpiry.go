@@ -0,0 +1,111 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/functions"
+	"go-runtimevalidation/regex"
+	"strconv"
+	"strings"
+)
+
+// maxCardExpiryYears is how far in the future an expiry date may be before it is considered implausible.
+const maxCardExpiryYears = 20
+
+// CardExpiry validates that the input is a card expiry date in "MM/YY" or "MM/YYYY" form (a dash may be used
+// instead of the slash) and that the card has not expired. A card is valid through the last day of its expiry month.
+// The current date is read from functions.Now, so it can be fixed in tests with functions.SetClock.
+//
+// Parameters:
+// - input: The value being validated, expected to be a string.
+//
+// Returns nil if the input is a valid, unexpired expiry date, or an error if:
+// - The input is not a string
+// - The input is not in "MM/YY" or "MM/YYYY" form
+// - The expiry month is in the past, or more than 20 years in the future.
+//
+// Example:
+//
+//	err := CardExpiry("12/99") // err will be nil until the end of December 2099
+//	err := CardExpiry("13/25") // err will be: "invalid card expiry: 13/25"
+func CardExpiry(input any) error {
+	// Check if the input is a string
+	value, ok := input.(string)
+	if !ok {
+		return fmt.Errorf("expected a string, got %T", input)
+	}
+
+	matches := regex.CardExpiryRegex().FindStringSubmatch(strings.TrimSpace(value))
+	if matches == nil {
+		return fmt.Errorf("invalid card expiry: %s", value)
+	}
+
+	month, _ := strconv.Atoi(matches[1])
+	year, err := cardExpiryYear(matches[2])
+	if err != nil {
+		return err
+	}
+
+	return checkCardExpiry(month, year)
+}
+
+// cardExpiryYear converts a two or four digit year into a four digit year.
+func cardExpiryYear(input any) (int, error) {
+	year, err := cardInt(input)
+	if err != nil {
+		return 0, fmt.Errorf("unsupported type for card expiry year: %w", err)
+	}
+
+	if year >= 0 && year < 100 {
+		year += 2000
+	}
+	if year < 2000 || year > 9999 {
+		return 0, fmt.Errorf("invalid card expiry year: %d", year)
+	}
+
+	return year, nil
+}
+
+// cardExpiryMonth converts the input into a month between 1 and 12.
+func cardExpiryMonth(input any) (int, error) {
+	month, err := cardInt(input)
+	if err != nil {
+		return 0, fmt.Errorf("unsupported type for card expiry month: %w", err)
+	}
+
+	if month < 1 || month > 12 {
+		return 0, fmt.Errorf("invalid card expiry month: %d", month)
+	}
+
+	return month, nil
+}
+
+// cardInt converts the input into an int. Strings are parsed as decimal so that "08" is eight, not an octal error.
+func cardInt(input any) (int, error) {
+	if value, ok := input.(string); ok {
+		n, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil {
+			return 0, fmt.Errorf("failed to parse %q of type %T as int", value, value)
+		}
+		return n, nil
+	}
+
+	n, err := functions.GetInt(input)
+	return int(n), err
+}
+
+// checkCardExpiry reports an error if the card expired before the current month,
+// or if the expiry is implausibly far in the future.
+func checkCardExpiry(month, year int) error {
+	now := functions.Now()
+	current := now.Year()*12 + int(now.Month()) - 1
+	expiry := year*12 + month - 1
+
+	if expiry < current {
+		return fmt.Errorf("card expired: %02d/%d", month, year)
+	}
+	if expiry > current+maxCardExpiryYears*12 {
+		return fmt.Errorf("card expiry too far in the future: %02d/%d", month, year)
+	}
+
+	return nil
+}
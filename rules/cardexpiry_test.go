@@ -0,0 +1,46 @@
+package rules
+
+import (
+	"go-runtimevalidation/functions"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCardExpiry(t *testing.T) {
+	restore := functions.SetClock(func() time.Time { return time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC) })
+	defer restore()
+
+	t.Run("Expires later this year", func(t *testing.T) {
+		assert.NoError(t, CardExpiry("12/24"))
+	})
+
+	t.Run("Expires this month", func(t *testing.T) {
+		assert.NoError(t, CardExpiry("06/2024"))
+	})
+
+	t.Run("Dash separator", func(t *testing.T) {
+		assert.NoError(t, CardExpiry("01-27"))
+	})
+
+	t.Run("Expired last month", func(t *testing.T) {
+		assert.EqualError(t, CardExpiry("05/24"), "card expired: 05/2024")
+	})
+
+	t.Run("Too far in the future", func(t *testing.T) {
+		assert.EqualError(t, CardExpiry("07/2044"), "card expiry too far in the future: 07/2044")
+	})
+
+	t.Run("Invalid month", func(t *testing.T) {
+		assert.EqualError(t, CardExpiry("13/25"), "invalid card expiry: 13/25")
+	})
+
+	t.Run("Invalid format", func(t *testing.T) {
+		assert.EqualError(t, CardExpiry("2025-01"), "invalid card expiry: 2025-01")
+	})
+
+	t.Run("Invalid input type", func(t *testing.T) {
+		assert.EqualError(t, CardExpiry(1225), "expected a string, got int")
+	})
+}
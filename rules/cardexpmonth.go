@@ -0,0 +1,59 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/args"
+)
+
+// CardExpMonth validates that the input is a card expiry month (1 to 12) that, together with the expiry year
+// given as argument, has not passed. It is expected to be used on the month field of a card with the year field
+// as argument, e.g. `cardexpmonth:$ExpYear`. A card is valid through the last day of its expiry month.
+//
+// Parameters:
+// - input: The value being validated. It can be an integer or a string such as "08".
+// - obj: The object containing additional data (can be used for Field references within the args).
+// - args: A map containing exactly one argument, the expiry year (two or four digits).
+//
+// Returns nil if the input is valid, or an error if:
+// - The number of arguments is not equal to 1
+// - The month is not between 1 and 12, or the year is not a valid year
+// - The card expired before the current month, or expires more than 20 years in the future.
+//
+// Example:
+//
+//	input := "08"
+//	obj := struct{ ExpYear int }{ExpYear: 2020}
+//	args := map[string]Arg{
+//	    "$ExpYear": {Type: FieldArg, Field: "ExpYear"},
+//	}
+//	err := CardExpMonth(input, obj, args)  // err will be: "card expired: 08/2020"
+func CardExpMonth(input any, obj any, arguments map[string]args.Arg) error {
+	if len(arguments) != 1 {
+		return fmt.Errorf("cardexpmonth expects exactly 1 argument, got %d", len(arguments))
+	}
+
+	// Get the first (and only) argument
+	var arg args.Arg
+	for _, v := range arguments {
+		arg = v
+		break
+	}
+
+	// Evaluate the argument
+	eval, err := arg.Evaluate(obj)
+	if err != nil {
+		return err
+	}
+
+	month, err := cardExpiryMonth(input)
+	if err != nil {
+		return err
+	}
+
+	year, err := cardExpiryYear(eval)
+	if err != nil {
+		return err
+	}
+
+	return checkCardExpiry(month, year)
+}
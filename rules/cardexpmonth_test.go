@@ -0,0 +1,49 @@
+package rules
+
+import (
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/functions"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCardExpMonth(t *testing.T) {
+	restore := functions.SetClock(func() time.Time { return time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC) })
+	defer restore()
+
+	year := map[string]args.Arg{
+		"$ExpYear": {Type: args.FieldArg, Field: "ExpYear"},
+	}
+
+	t.Run("Month not yet passed", func(t *testing.T) {
+		obj := struct{ ExpYear int }{ExpYear: 2024}
+		assert.NoError(t, CardExpMonth("06", obj, year))
+		assert.NoError(t, CardExpMonth(12, obj, year))
+	})
+
+	t.Run("Month passed", func(t *testing.T) {
+		obj := struct{ ExpYear string }{ExpYear: "24"}
+		assert.EqualError(t, CardExpMonth("05", obj, year), "card expired: 05/2024")
+	})
+
+	t.Run("Later year", func(t *testing.T) {
+		obj := struct{ ExpYear int }{ExpYear: 2025}
+		assert.NoError(t, CardExpMonth(1, obj, year))
+	})
+
+	t.Run("Invalid month", func(t *testing.T) {
+		obj := struct{ ExpYear int }{ExpYear: 2025}
+		assert.EqualError(t, CardExpMonth("13", obj, year), "invalid card expiry month: 13")
+	})
+
+	t.Run("Invalid year", func(t *testing.T) {
+		obj := struct{ ExpYear string }{ExpYear: "next year"}
+		assert.Error(t, CardExpMonth("01", obj, year))
+	})
+
+	t.Run("No arguments", func(t *testing.T) {
+		assert.EqualError(t, CardExpMonth("01", nil, map[string]args.Arg{}), "cardexpmonth expects exactly 1 argument, got 0")
+	})
+}
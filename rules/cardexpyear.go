@@ -0,0 +1,38 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/functions"
+)
+
+// CardExpYear validates that the input is a card expiry year that is not in the past,
+// nor more than 20 years in the future. Two digit years are interpreted as 20YY.
+// The current year is read from functions.Now, so it can be fixed in tests with functions.SetClock.
+//
+// Parameters:
+// - input: The value being validated. It can be an integer or a string.
+//
+// Returns nil if the input is a valid expiry year, or an error if:
+// - The input cannot be converted to an integer
+// - The year is before the current year, or more than 20 years after it.
+//
+// Example:
+//
+//	err := CardExpYear(2099) // err will be nil
+//	err := CardExpYear("19") // err will be: "card expired: 2019"
+func CardExpYear(input any) error {
+	year, err := cardExpiryYear(input)
+	if err != nil {
+		return err
+	}
+
+	current := functions.Now().Year()
+	if year < current {
+		return fmt.Errorf("card expired: %d", year)
+	}
+	if year > current+maxCardExpiryYears {
+		return fmt.Errorf("card expiry too far in the future: %d", year)
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package rules
+
+import (
+	"go-runtimevalidation/functions"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCardExpYear(t *testing.T) {
+	restore := functions.SetClock(func() time.Time { return time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC) })
+	defer restore()
+
+	t.Run("Current year", func(t *testing.T) {
+		assert.NoError(t, CardExpYear(2024))
+	})
+
+	t.Run("Two digit year", func(t *testing.T) {
+		assert.NoError(t, CardExpYear("27"))
+	})
+
+	t.Run("Past year", func(t *testing.T) {
+		assert.EqualError(t, CardExpYear("19"), "card expired: 2019")
+	})
+
+	t.Run("Too far in the future", func(t *testing.T) {
+		assert.EqualError(t, CardExpYear(2045), "card expiry too far in the future: 2045")
+	})
+
+	t.Run("Not a number", func(t *testing.T) {
+		assert.Error(t, CardExpYear("soon"))
+	})
+}
@@ -0,0 +1,50 @@
+package rules
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+)
+
+// CIDR validates that the input is a valid IPv4 or IPv6 network in CIDR notation, e.g. "10.0.0.0/8".
+// Host bits are allowed to be set, as with net.ParseCIDR, so "10.1.2.3/8" is accepted.
+//
+// Parameters:
+// - input: The value being validated. It can be a string, a netip.Prefix or a *net.IPNet.
+//
+// Returns nil if the input is a valid CIDR, or an error if:
+// - The input is not a string, netip.Prefix or *net.IPNet
+// - The input is not a valid CIDR.
+//
+// Example:
+//
+//	err := CIDR("192.168.0.0/16") // err will be nil
+//	err := CIDR("192.168.0.0/33") // err will be: "invalid cidr: 192.168.0.0/33"
+func CIDR(input any) error {
+	_, err := getPrefix(input)
+	return err
+}
+
+// getPrefix converts the input into a netip.Prefix.
+func getPrefix(input any) (netip.Prefix, error) {
+	switch v := input.(type) {
+	case string:
+		prefix, err := netip.ParsePrefix(v)
+		if err != nil {
+			return netip.Prefix{}, fmt.Errorf("invalid cidr: %s", v)
+		}
+		return prefix, nil
+	case netip.Prefix:
+		if !v.IsValid() {
+			return netip.Prefix{}, fmt.Errorf("invalid cidr: %s", v)
+		}
+		return v, nil
+	case *net.IPNet:
+		if v == nil {
+			return netip.Prefix{}, fmt.Errorf("invalid cidr: %v", v)
+		}
+		return getPrefix(v.String())
+	default:
+		return netip.Prefix{}, fmt.Errorf("expected a string or network prefix, got %T", input)
+	}
+}
@@ -0,0 +1,44 @@
+package rules
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCIDR(t *testing.T) {
+	t.Run("Valid IPv4 CIDR", func(t *testing.T) {
+		assert.NoError(t, CIDR("192.168.0.0/16"))
+	})
+
+	t.Run("Valid IPv6 CIDR", func(t *testing.T) {
+		assert.NoError(t, CIDR("2001:db8::/32"))
+	})
+
+	t.Run("Host bits set", func(t *testing.T) {
+		assert.NoError(t, CIDR("10.1.2.3/8"))
+	})
+
+	t.Run("Valid netip.Prefix", func(t *testing.T) {
+		assert.NoError(t, CIDR(netip.MustParsePrefix("10.0.0.0/8")))
+	})
+
+	t.Run("Valid *net.IPNet", func(t *testing.T) {
+		_, network, _ := net.ParseCIDR("10.0.0.0/8")
+		assert.NoError(t, CIDR(network))
+	})
+
+	t.Run("Prefix length out of range", func(t *testing.T) {
+		assert.EqualError(t, CIDR("192.168.0.0/33"), "invalid cidr: 192.168.0.0/33")
+	})
+
+	t.Run("Missing prefix length", func(t *testing.T) {
+		assert.EqualError(t, CIDR("192.168.0.0"), "invalid cidr: 192.168.0.0")
+	})
+
+	t.Run("Invalid input type", func(t *testing.T) {
+		assert.EqualError(t, CIDR(8), "expected a string or network prefix, got int")
+	})
+}
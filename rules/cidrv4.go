@@ -0,0 +1,31 @@
+package rules
+
+import (
+	"fmt"
+)
+
+// CIDRv4 validates that the input is a valid IPv4 network in CIDR notation, e.g. "10.0.0.0/8".
+//
+// Parameters:
+// - input: The value being validated. It can be a string, a netip.Prefix or a *net.IPNet.
+//
+// Returns nil if the input is a valid IPv4 CIDR, or an error if:
+// - The input is not a string, netip.Prefix or *net.IPNet
+// - The input is not a valid IPv4 CIDR.
+//
+// Example:
+//
+//	err := CIDRv4("10.0.0.0/8")    // err will be nil
+//	err := CIDRv4("2001:db8::/32") // err will be: "invalid cidrv4: 2001:db8::/32"
+func CIDRv4(input any) error {
+	prefix, err := getPrefix(input)
+	if err != nil {
+		return err
+	}
+
+	if !prefix.Addr().Is4() {
+		return fmt.Errorf("invalid cidrv4: %s", prefix)
+	}
+
+	return nil
+}
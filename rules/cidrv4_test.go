@@ -0,0 +1,21 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCIDRv4(t *testing.T) {
+	t.Run("Valid IPv4 CIDR", func(t *testing.T) {
+		assert.NoError(t, CIDRv4("10.0.0.0/8"))
+	})
+
+	t.Run("IPv6 CIDR", func(t *testing.T) {
+		assert.EqualError(t, CIDRv4("2001:db8::/32"), "invalid cidrv4: 2001:db8::/32")
+	})
+
+	t.Run("Invalid CIDR", func(t *testing.T) {
+		assert.EqualError(t, CIDRv4("10.0.0.0/40"), "invalid cidr: 10.0.0.0/40")
+	})
+}
@@ -0,0 +1,31 @@
+package rules
+
+import (
+	"fmt"
+)
+
+// CIDRv6 validates that the input is a valid IPv6 network in CIDR notation, e.g. "2001:db8::/32".
+//
+// Parameters:
+// - input: The value being validated. It can be a string, a netip.Prefix or a *net.IPNet.
+//
+// Returns nil if the input is a valid IPv6 CIDR, or an error if:
+// - The input is not a string, netip.Prefix or *net.IPNet
+// - The input is not a valid IPv6 CIDR.
+//
+// Example:
+//
+//	err := CIDRv6("2001:db8::/32") // err will be nil
+//	err := CIDRv6("10.0.0.0/8")    // err will be: "invalid cidrv6: 10.0.0.0/8"
+func CIDRv6(input any) error {
+	prefix, err := getPrefix(input)
+	if err != nil {
+		return err
+	}
+
+	if !prefix.Addr().Is6() {
+		return fmt.Errorf("invalid cidrv6: %s", prefix)
+	}
+
+	return nil
+}
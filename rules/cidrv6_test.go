@@ -0,0 +1,21 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCIDRv6(t *testing.T) {
+	t.Run("Valid IPv6 CIDR", func(t *testing.T) {
+		assert.NoError(t, CIDRv6("2001:db8::/32"))
+	})
+
+	t.Run("IPv4 CIDR", func(t *testing.T) {
+		assert.EqualError(t, CIDRv6("10.0.0.0/8"), "invalid cidrv6: 10.0.0.0/8")
+	})
+
+	t.Run("Invalid CIDR", func(t *testing.T) {
+		assert.EqualError(t, CIDRv6("2001:db8::/129"), "invalid cidr: 2001:db8::/129")
+	})
+}
@@ -0,0 +1,33 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/functions"
+	"go-runtimevalidation/iso"
+)
+
+// Country2 validates that the input is an ISO 3166-1 alpha-2 country code, e.g. "DE". Codes are case-sensitive, so "de" is rejected.
+//
+// Parameters:
+// - input (any): The value to be validated. It should be convertible to a string.
+//
+// Returns:
+// - error: If the input is not a known code, it returns an error with details.
+//
+// Example:
+//
+//	err := Country2("DE") // err will be nil
+//	err := Country2("XX") // err will be: "invalid country2: XX"
+func Country2(input any) error {
+	// Check if the input is a string
+	value, err := functions.GetString(input)
+	if err != nil {
+		return fmt.Errorf("expected a string, got %T", input)
+	}
+
+	if _, ok := iso.CountryByAlpha2(value); !ok {
+		return fmt.Errorf("invalid country2: %s", value)
+	}
+
+	return nil
+}
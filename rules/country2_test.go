@@ -0,0 +1,36 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountry2(t *testing.T) {
+	t.Run("Valid country code", func(t *testing.T) {
+		assert.NoError(t, Country2("DE"))
+		assert.NoError(t, Country2("US"))
+	})
+
+	t.Run("Unknown country code", func(t *testing.T) {
+		err := Country2("XX")
+		assert.Error(t, err)
+		assert.Equal(t, "invalid country2: XX", err.Error())
+	})
+
+	t.Run("Lower case code", func(t *testing.T) {
+		err := Country2("de")
+		assert.Error(t, err)
+		assert.Equal(t, "invalid country2: de", err.Error())
+	})
+
+	t.Run("Alpha-3 code", func(t *testing.T) {
+		assert.Error(t, Country2("DEU"))
+	})
+
+	t.Run("Invalid input type", func(t *testing.T) {
+		err := Country2([]string{"DE"})
+		assert.Error(t, err)
+		assert.Equal(t, "expected a string, got []string", err.Error())
+	})
+}
@@ -0,0 +1,33 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/functions"
+	"go-runtimevalidation/iso"
+)
+
+// Country3 validates that the input is an ISO 3166-1 alpha-3 country code, e.g. "DEU". Codes are case-sensitive, so "deu" is rejected.
+//
+// Parameters:
+// - input (any): The value to be validated. It should be convertible to a string.
+//
+// Returns:
+// - error: If the input is not a known code, it returns an error with details.
+//
+// Example:
+//
+//	err := Country3("DEU") // err will be nil
+//	err := Country3("XXX") // err will be: "invalid country3: XXX"
+func Country3(input any) error {
+	// Check if the input is a string
+	value, err := functions.GetString(input)
+	if err != nil {
+		return fmt.Errorf("expected a string, got %T", input)
+	}
+
+	if _, ok := iso.CountryByAlpha3(value); !ok {
+		return fmt.Errorf("invalid country3: %s", value)
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountry3(t *testing.T) {
+	t.Run("Valid country code", func(t *testing.T) {
+		assert.NoError(t, Country3("DEU"))
+		assert.NoError(t, Country3("USA"))
+	})
+
+	t.Run("Unknown country code", func(t *testing.T) {
+		err := Country3("XXX")
+		assert.Error(t, err)
+		assert.Equal(t, "invalid country3: XXX", err.Error())
+	})
+
+	t.Run("Alpha-2 code", func(t *testing.T) {
+		err := Country3("DE")
+		assert.Error(t, err)
+		assert.Equal(t, "invalid country3: DE", err.Error())
+	})
+}
@@ -0,0 +1,38 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/functions"
+	"go-runtimevalidation/iso"
+)
+
+// CountryNum validates that the input is an ISO 3166-1 numeric country code, e.g. "276" for Germany.
+// Strings must have exactly three digits ("004"); integers are zero-padded, so 4 is accepted as "004".
+//
+// Parameters:
+// - input (any): The value to be validated. It should be a string or an integer.
+//
+// Returns:
+// - error: If the input is not a known code, it returns an error with details.
+//
+// Example:
+//
+//	err := CountryNum(276) // err will be nil
+//	err := CountryNum("999") // err will be: "invalid countrynum: 999"
+func CountryNum(input any) error {
+	value, ok := input.(string)
+	if !ok {
+		// Integers are zero-padded to three digits
+		number, err := functions.GetInt(input)
+		if err != nil {
+			return fmt.Errorf("expected a string or an integer, got %T", input)
+		}
+		value = fmt.Sprintf("%03d", number)
+	}
+
+	if _, ok := iso.CountryByNumeric(value); !ok {
+		return fmt.Errorf("invalid countrynum: %s", value)
+	}
+
+	return nil
+}
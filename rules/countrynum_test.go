@@ -0,0 +1,37 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountryNum(t *testing.T) {
+	t.Run("Valid numeric code", func(t *testing.T) {
+		assert.NoError(t, CountryNum("276"))
+		assert.NoError(t, CountryNum("004"))
+	})
+
+	t.Run("Integer is zero-padded", func(t *testing.T) {
+		assert.NoError(t, CountryNum(4))
+		assert.NoError(t, CountryNum(uint16(840)))
+	})
+
+	t.Run("Unpadded string", func(t *testing.T) {
+		err := CountryNum("4")
+		assert.Error(t, err)
+		assert.Equal(t, "invalid countrynum: 4", err.Error())
+	})
+
+	t.Run("Unknown numeric code", func(t *testing.T) {
+		err := CountryNum(999)
+		assert.Error(t, err)
+		assert.Equal(t, "invalid countrynum: 999", err.Error())
+	})
+
+	t.Run("Invalid input type", func(t *testing.T) {
+		err := CountryNum(2.5)
+		assert.Error(t, err)
+		assert.Equal(t, "expected a string or an integer, got float64", err.Error())
+	})
+}
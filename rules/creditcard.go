@@ -0,0 +1,144 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/regex"
+	"strings"
+)
+
+// cardRange is a row of the issuer identification number (IIN) table.
+// A card belongs to the brand if its first len(From) digits are between From and To, inclusively,
+// and its total number of digits is one of Lengths.
+type cardRange struct {
+	Brand   string
+	From    string
+	To      string
+	Lengths []int
+}
+
+// cardRanges lists the IIN prefixes and card number lengths of the major card brands.
+// When several ranges match, the one with the longest prefix wins, so that e.g. Discover's
+// 622126-622925 co-branded range is preferred over UnionPay's 62.
+var cardRanges = []cardRange{
+	{Brand: "visa", From: "4", To: "4", Lengths: []int{13, 16, 19}},
+	{Brand: "mastercard", From: "51", To: "55", Lengths: []int{16}},
+	{Brand: "mastercard", From: "2221", To: "2720", Lengths: []int{16}},
+	{Brand: "amex", From: "34", To: "34", Lengths: []int{15}},
+	{Brand: "amex", From: "37", To: "37", Lengths: []int{15}},
+	{Brand: "discover", From: "6011", To: "6011", Lengths: []int{16, 17, 18, 19}},
+	{Brand: "discover", From: "644", To: "649", Lengths: []int{16, 17, 18, 19}},
+	{Brand: "discover", From: "65", To: "65", Lengths: []int{16, 17, 18, 19}},
+	{Brand: "discover", From: "622126", To: "622925", Lengths: []int{16, 17, 18, 19}},
+	{Brand: "dinersclub", From: "300", To: "305", Lengths: []int{14, 15, 16, 17, 18, 19}},
+	{Brand: "dinersclub", From: "36", To: "36", Lengths: []int{14, 15, 16, 17, 18, 19}},
+	{Brand: "dinersclub", From: "38", To: "39", Lengths: []int{14, 15, 16, 17, 18, 19}},
+	{Brand: "jcb", From: "3528", To: "3589", Lengths: []int{16, 17, 18, 19}},
+	{Brand: "unionpay", From: "62", To: "62", Lengths: []int{16, 17, 18, 19}},
+	{Brand: "maestro", From: "50", To: "50", Lengths: []int{12, 13, 14, 15, 16, 17, 18, 19}},
+	{Brand: "maestro", From: "56", To: "58", Lengths: []int{12, 13, 14, 15, 16, 17, 18, 19}},
+	{Brand: "maestro", From: "67", To: "67", Lengths: []int{12, 13, 14, 15, 16, 17, 18, 19}},
+	{Brand: "mir", From: "2200", To: "2204", Lengths: []int{16, 17, 18, 19}},
+}
+
+// CreditCard validates that the input is a payment card number with a valid Luhn checksum.
+// Digits may be grouped with single spaces or dashes, e.g. "4111 1111 1111 1111".
+// The number must have between 12 and 19 digits. Error messages only show the last four digits.
+//
+// Parameters:
+// - input: The value being validated, expected to be a string.
+//
+// Returns nil if the input is a valid card number, or an error if:
+// - The input is not a string
+// - The input contains characters other than digits and single space or dash separators
+// - The number of digits is not between 12 and 19
+// - The Luhn checksum fails.
+//
+// Example:
+//
+//	err := CreditCard("4111 1111 1111 1111") // err will be nil
+//	err := CreditCard("4111 1111 1111 1112") // err will be: "invalid credit card: ************1112 fails checksum"
+func CreditCard(input any) error {
+	_, err := cardDigits(input)
+	return err
+}
+
+// cardDigits validates the input as a card number and returns its digits.
+func cardDigits(input any) (string, error) {
+	// Check if the input is a string
+	value, ok := input.(string)
+	if !ok {
+		return "", fmt.Errorf("expected a string, got %T", input)
+	}
+
+	if !regex.CreditCardRegex().MatchString(value) {
+		return "", fmt.Errorf("invalid credit card: %s", maskCard(value))
+	}
+
+	// Remove the separators
+	digits := regex.ExtractDigitsRegex().ReplaceAllString(value, "")
+	if len(digits) < 12 || len(digits) > 19 {
+		return "", fmt.Errorf("invalid credit card: %s must contain between 12 and 19 digits", maskCard(digits))
+	}
+
+	if !luhn(digits) {
+		return "", fmt.Errorf("invalid credit card: %s fails checksum", maskCard(digits))
+	}
+
+	return digits, nil
+}
+
+// luhn reports whether a string of digits passes the Luhn (mod 10) checksum.
+func luhn(digits string) bool {
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		digit := int(digits[i] - '0')
+		if double {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// cardBrand detects the brand of a card number from the IIN table.
+// Returns false if no range matches the prefix and length of the number.
+func cardBrand(digits string) (string, bool) {
+	brand := ""
+	longest := 0
+	for _, r := range cardRanges {
+		n := len(r.From)
+		if len(digits) < n || n <= longest {
+			continue
+		}
+
+		// Prefixes of the same length compare numerically as strings
+		prefix := digits[:n]
+		if prefix < r.From || prefix > r.To {
+			continue
+		}
+
+		for _, length := range r.Lengths {
+			if length == len(digits) {
+				brand = r.Brand
+				longest = n
+				break
+			}
+		}
+	}
+
+	return brand, brand != ""
+}
+
+// maskCard replaces every character except the last four with an asterisk,
+// so that card numbers never appear in full in error messages.
+func maskCard(value string) string {
+	if len(value) <= 4 {
+		return strings.Repeat("*", len(value))
+	}
+	return strings.Repeat("*", len(value)-4) + value[len(value)-4:]
+}
@@ -0,0 +1,77 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreditCard(t *testing.T) {
+	t.Run("Valid card number", func(t *testing.T) {
+		assert.NoError(t, CreditCard("4111111111111111"))
+	})
+
+	t.Run("Valid card number with spaces", func(t *testing.T) {
+		assert.NoError(t, CreditCard("4111 1111 1111 1111"))
+	})
+
+	t.Run("Valid card number with dashes", func(t *testing.T) {
+		assert.NoError(t, CreditCard("3782-822463-10005"))
+	})
+
+	t.Run("Checksum failure is masked", func(t *testing.T) {
+		assert.EqualError(t, CreditCard("4111 1111 1111 1112"), "invalid credit card: ************1112 fails checksum")
+	})
+
+	t.Run("Too few digits", func(t *testing.T) {
+		assert.EqualError(t, CreditCard("42424242"), "invalid credit card: ****4242 must contain between 12 and 19 digits")
+	})
+
+	t.Run("Invalid characters", func(t *testing.T) {
+		assert.EqualError(t, CreditCard("4111-1111-1111-111a"), "invalid credit card: ***************111a")
+	})
+
+	t.Run("Double separators", func(t *testing.T) {
+		assert.Error(t, CreditCard("4111  1111 1111 1111"))
+	})
+
+	t.Run("Invalid input type", func(t *testing.T) {
+		assert.EqualError(t, CreditCard(4111111111111111), "expected a string, got int")
+	})
+}
+
+func TestCardBrandDetection(t *testing.T) {
+	vectors := map[string]string{
+		"4111111111111111": "visa",
+		"4222222222222":    "visa",
+		"5555555555554444": "mastercard",
+		"2223003122003222": "mastercard",
+		"378282246310005":  "amex",
+		"6011111111111117": "discover",
+		"6221260000000000": "discover",
+		"30569309025904":   "dinersclub",
+		"38520000023237":   "dinersclub",
+		"3530111333300000": "jcb",
+		"6200000000000005": "unionpay",
+		"6759649826438453": "maestro",
+		"2200000000000004": "mir",
+	}
+
+	for number, expected := range vectors {
+		t.Run(expected+" "+number, func(t *testing.T) {
+			brand, ok := cardBrand(number)
+			assert.True(t, ok)
+			assert.Equal(t, expected, brand)
+		})
+	}
+
+	t.Run("Unknown prefix", func(t *testing.T) {
+		_, ok := cardBrand("9999999999999995")
+		assert.False(t, ok)
+	})
+
+	t.Run("Known prefix with wrong length", func(t *testing.T) {
+		_, ok := cardBrand("37828224631000")
+		assert.False(t, ok)
+	})
+}
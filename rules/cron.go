@@ -2,6 +2,8 @@ package rules
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/adhocore/gronx"
 )
@@ -16,6 +18,9 @@ import (
 // - Day of week (0 - 6 or SUN - SAT)
 // Optionally, there can be a sixth field representing the year.
 //
+// The expression may be qualified with a time zone, as in "CRON_TZ=Europe/Berlin 0 9 * * MON-FRI"
+// (the TZ= prefix is accepted as well). The time zone must be known to the time package.
+//
 // Parameters:
 // - input (any): The value to be validated. It should be convertible to a string.
 //
@@ -28,11 +33,77 @@ func Cron(input any) error {
 		return fmt.Errorf("expected a string, got %T", input)
 	}
 
+	if _, err := parseCron(value); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// cronExpression is a parsed CRON expression with its optional time zone.
+type cronExpression struct {
+	expr     string
+	location *time.Location
+	zoned    bool
+	fields   int
+}
+
+// parseCron splits off the time zone prefix of a CRON expression and checks the remaining expression.
+func parseCron(value string) (cronExpression, error) {
+	cron := cronExpression{expr: strings.TrimSpace(value), location: time.UTC}
+
+	for _, prefix := range []string{"CRON_TZ=", "TZ="} {
+		if rest, ok := strings.CutPrefix(cron.expr, prefix); ok {
+			name, expr, _ := strings.Cut(rest, " ")
+			location, err := time.LoadLocation(name)
+			if err != nil || name == "" || name == "Local" {
+				return cron, fmt.Errorf("invalid cron: %s: unknown time zone %q", value, name)
+			}
+			cron.expr, cron.location, cron.zoned = strings.TrimSpace(expr), location, true
+			break
+		}
+	}
+
 	// Check if the string is a valid cron
 	gron := gronx.New()
-	if !gron.IsValid(value) {
-		return fmt.Errorf("invalid cron: %s", value)
+	if !gron.IsValid(cron.expr) {
+		return cron, fmt.Errorf("invalid cron: %s", value)
 	}
 
-	return nil
+	switch {
+	case strings.EqualFold(cron.expr, "@everysecond"):
+		cron.fields = 6
+	case strings.HasPrefix(cron.expr, "@"):
+		cron.fields = 5
+	default:
+		cron.fields = len(strings.Fields(cron.expr))
+	}
+
+	return cron, nil
+}
+
+// cronSearchYears bounds how far ahead next looks past dates that do not exist. February 29 comes back within 8 years.
+const cronSearchYears = 9
+
+// next returns the first run of the expression strictly after the given time, in the expression's time zone.
+// gronx rolls dates that do not exist, such as February 30, over into the next month, so each tick is checked
+// with IsDue. Expressions that only match such dates have no next run.
+func (c cronExpression) next(after time.Time) (time.Time, error) {
+	gron := gronx.New()
+	from := after.In(c.location)
+	limit := from.AddDate(cronSearchYears, 0, 0)
+
+	for {
+		next, err := gronx.NextTickAfter(c.expr, from, false)
+		if err != nil {
+			return next, err
+		}
+		if due, err := gron.IsDue(c.expr, next); err == nil && due {
+			return next, nil
+		}
+		if next.After(limit) || !next.After(from) {
+			return time.Time{}, fmt.Errorf("invalid cron: %s: no date matches", c.expr)
+		}
+		from = next
+	}
 }
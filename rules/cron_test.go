@@ -40,3 +40,20 @@ func TestCRON(t *testing.T) {
 		assert.Equal(t, "invalid cron: */15 0 1,15 * 1-5 2024 extra", err.Error())
 	})
 }
+
+func TestCronTimeZone(t *testing.T) {
+	t.Run("Valid time zone", func(t *testing.T) {
+		assert.NoError(t, Cron("CRON_TZ=Europe/Berlin 0 9 * * MON-FRI"))
+		assert.NoError(t, Cron("TZ=America/New_York @daily"))
+	})
+
+	t.Run("Unknown time zone", func(t *testing.T) {
+		err := Cron("CRON_TZ=Mars/Olympus 0 9 * * *")
+		assert.EqualError(t, err, `invalid cron: CRON_TZ=Mars/Olympus 0 9 * * *: unknown time zone "Mars/Olympus"`)
+	})
+
+	t.Run("Invalid expression after time zone", func(t *testing.T) {
+		err := Cron("CRON_TZ=UTC 0 12 * *")
+		assert.EqualError(t, err, "invalid cron: CRON_TZ=UTC 0 12 * *")
+	})
+}
@@ -0,0 +1,172 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/functions"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// cronSampleRuns and cronSampleWindow bound how far ahead the interval option looks for the closest pair of runs.
+	cronSampleRuns   = 1000
+	cronSampleWindow = 366 * 24 * time.Hour
+)
+
+// cronPolicy is the set of constraints configured by the arguments of the cron rule.
+type cronPolicy struct {
+	fields   []int
+	interval time.Duration
+	within   time.Duration
+	zoned    bool
+}
+
+// CronSchedule validates that the input is a CRON expression (see Cron) whose schedule satisfies a set of constraints.
+// It is expected to be used in validation rules such as `cron:fields=5,interval=15m,within=24h,tz`.
+//
+// Arguments (in any order):
+// - fields=N: the expression must have N fields (5, 6 or 7). May be given more than once to allow several counts.
+// - interval=D: consecutive runs must be at least D apart, e.g. 15m. The runs of roughly the next year (at most 1000 of them) are compared.
+// - within=D: the next run must be at most D from now, e.g. 24h. Expressions that never run again are rejected as well.
+// - tz: the expression must be qualified with a time zone, e.g. "CRON_TZ=Europe/Berlin 0 9 * * *".
+//
+// Runs are computed in the expression's time zone (UTC if it has none), starting from the validation clock (see functions.Now).
+//
+// Parameters:
+// - input: The value being validated, expected to be a string.
+// - obj: The object containing additional data (not used by this rule).
+// - args: A map of constraint arguments. At least one is required.
+//
+// Returns nil if the input is valid, or an error if:
+// - An argument is not a known option or has an invalid value
+// - The input is not a string or not a valid CRON expression
+// - Any constraint is not met.
+//
+// Example:
+//
+//	input := "* * * * *"
+//	obj := nil
+//	args := map[string]Arg{
+//	    "interval=5m": {Value: "interval=5m"},
+//	}
+//	err := CronSchedule(input, obj, args)  // err will be: "cron validation failed: runs are 1m0s apart, less than the minimum interval of 5m0s"
+func CronSchedule(input any, obj any, arguments map[string]args.Arg) error {
+	policy, err := parseCronPolicy(arguments)
+	if err != nil {
+		return err
+	}
+
+	// Check if the input is a string
+	value, ok := input.(string)
+	if !ok {
+		return fmt.Errorf("expected a string, got %T", input)
+	}
+
+	cron, err := parseCron(value)
+	if err != nil {
+		return err
+	}
+
+	if len(policy.fields) > 0 && !slices.Contains(policy.fields, cron.fields) {
+		allowed := make([]string, len(policy.fields))
+		for i, n := range policy.fields {
+			allowed[i] = strconv.Itoa(n)
+		}
+		return fmt.Errorf("cron validation failed: expected %s fields, got %d", strings.Join(allowed, " or "), cron.fields)
+	}
+
+	if policy.zoned && !cron.zoned {
+		return fmt.Errorf("cron validation failed: expression has no CRON_TZ time zone")
+	}
+
+	now := functions.Now()
+
+	if policy.within > 0 {
+		next, err := cron.next(now)
+		if err != nil {
+			return fmt.Errorf("cron validation failed: no upcoming run")
+		}
+		if wait := next.Sub(now); wait > policy.within {
+			return fmt.Errorf("cron validation failed: next run at %s is %s away, more than %s", next.Format(time.RFC3339), wait, policy.within)
+		}
+	}
+
+	if policy.interval > 0 {
+		if gap, ok := cron.minInterval(now); ok && gap < policy.interval {
+			return fmt.Errorf("cron validation failed: runs are %s apart, less than the minimum interval of %s", gap, policy.interval)
+		}
+	}
+
+	return nil
+}
+
+// cronOptions are the options of the cron rule.
+var cronOptions = args.Options{Rule: "cron", Values: []string{"fields", "interval", "within"}, Flags: []string{"tz"}}
+
+// parseCronPolicy builds the constraints from the rule arguments.
+func parseCronPolicy(arguments map[string]args.Arg) (cronPolicy, error) {
+	policy := cronPolicy{}
+	if len(arguments) == 0 {
+		return policy, fmt.Errorf("cron expects at least one argument")
+	}
+
+	for key, arg := range arguments {
+		option, err := cronOptions.Parse(key, arg)
+		if err != nil {
+			return policy, err
+		}
+
+		switch option.Name {
+		case "tz":
+			policy.zoned = true
+		case "fields":
+			n, err := strconv.Atoi(option.Text)
+			if err != nil || n < 5 || n > 7 {
+				return policy, fmt.Errorf("cron: invalid value for %s: %s", option.Name, option.Text)
+			}
+			policy.fields = append(policy.fields, n)
+		case "interval", "within":
+			d, err := functions.GetDuration(option.Text)
+			if err != nil || d <= 0 {
+				return policy, fmt.Errorf("cron: invalid value for %s: %s", option.Name, option.Text)
+			}
+			if option.Name == "interval" {
+				policy.interval = d
+			} else {
+				policy.within = d
+			}
+		}
+	}
+
+	slices.Sort(policy.fields)
+
+	return policy, nil
+}
+
+// minInterval returns the shortest time between two consecutive runs after the given time,
+// looking at no more than cronSampleRuns runs within cronSampleWindow. It reports false if there are fewer than two runs.
+func (c cronExpression) minInterval(after time.Time) (time.Duration, bool) {
+	previous, err := c.next(after)
+	if err != nil {
+		return 0, false
+	}
+
+	end := previous.Add(cronSampleWindow)
+	var shortest time.Duration
+	found := false
+	for i := 1; i < cronSampleRuns; i++ {
+		next, err := c.next(previous)
+		if err != nil || next.After(end) || !next.After(previous) {
+			break
+		}
+		if gap := next.Sub(previous); !found || gap < shortest {
+			shortest, found = gap, true
+		}
+		previous = next
+	}
+
+	return shortest, found
+}
@@ -0,0 +1,101 @@
+package rules
+
+import (
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/functions"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCronSchedule(t *testing.T) {
+	// Monday, 1 July 2024, 08:00 UTC
+	restore := functions.SetClock(func() time.Time { return time.Date(2024, 7, 1, 8, 0, 0, 0, time.UTC) })
+	defer restore()
+
+	options := func(values ...string) map[string]args.Arg {
+		arguments := map[string]args.Arg{}
+		for _, v := range values {
+			arguments[v] = args.Arg{Value: v}
+		}
+		return arguments
+	}
+
+	t.Run("Field count", func(t *testing.T) {
+		assert.NoError(t, CronSchedule("0 12 * * *", nil, options("fields=5")))
+		assert.NoError(t, CronSchedule("@daily", nil, options("fields=5")))
+		assert.NoError(t, CronSchedule("0 0 12 * * *", nil, options("fields=5", "fields=6")))
+
+		err := CronSchedule("0 0 12 * * *", nil, options("fields=5"))
+		assert.EqualError(t, err, "cron validation failed: expected 5 fields, got 6")
+
+		err = CronSchedule("0 0 12 * * * 2030", nil, options("fields=6", "fields=5"))
+		assert.EqualError(t, err, "cron validation failed: expected 5 or 6 fields, got 7")
+	})
+
+	t.Run("Minimum interval", func(t *testing.T) {
+		assert.NoError(t, CronSchedule("*/15 * * * *", nil, options("interval=15m")))
+		assert.NoError(t, CronSchedule("0 9 * * MON-FRI", nil, options("interval=24h")))
+
+		err := CronSchedule("* * * * *", nil, options("interval=5m"))
+		assert.EqualError(t, err, "cron validation failed: runs are 1m0s apart, less than the minimum interval of 5m0s")
+
+		// Evenly spaced most of the hour, but 10:55 and 11:00 are only 5 minutes apart
+		err = CronSchedule("0,55 * * * *", nil, options("interval=10m"))
+		assert.EqualError(t, err, "cron validation failed: runs are 5m0s apart, less than the minimum interval of 10m0s")
+
+		err = CronSchedule("* * * * * *", nil, options("interval=1m"))
+		assert.EqualError(t, err, "cron validation failed: runs are 1s apart, less than the minimum interval of 1m0s")
+	})
+
+	t.Run("Next run window", func(t *testing.T) {
+		assert.NoError(t, CronSchedule("0 9 * * *", nil, options("within=1h")))
+
+		err := CronSchedule("0 9 * * SUN", nil, options("within=24h"))
+		assert.EqualError(t, err, "cron validation failed: next run at 2024-07-07T09:00:00Z is 145h0m0s away, more than 24h0m0s")
+
+		err = CronSchedule("0 9 1 1 * 2020", nil, options("within=24h"))
+		assert.EqualError(t, err, "cron validation failed: no upcoming run")
+	})
+
+	t.Run("Dates that do not exist", func(t *testing.T) {
+		// The next February 29 is in 2028, not the March date that February 30 and 31 roll over to
+		err := CronSchedule("0 0 29 2 *", nil, options("within=24h"))
+		assert.EqualError(t, err, "cron validation failed: next run at 2028-02-29T00:00:00Z is 32104h0m0s away, more than 24h0m0s")
+
+		err = CronSchedule("0 0 30 2 *", nil, options("within=8760h"))
+		assert.EqualError(t, err, "cron validation failed: no upcoming run")
+
+		err = CronSchedule("0 0 31 2 *", nil, options("within=8760h"))
+		assert.EqualError(t, err, "cron validation failed: no upcoming run")
+
+		// The 31st of each month runs at least 31 days apart, as months without one are skipped
+		assert.NoError(t, CronSchedule("0 0 31 * *", nil, options("interval=744h")))
+	})
+
+	t.Run("Time zone", func(t *testing.T) {
+		// 08:00 UTC is 10:00 in Berlin, so the next 09:00 run there is 23 hours away
+		assert.NoError(t, CronSchedule("CRON_TZ=Europe/Berlin 0 9 * * *", nil, options("tz", "within=23h")))
+		assert.Error(t, CronSchedule("CRON_TZ=Europe/Berlin 0 9 * * *", nil, options("within=22h")))
+
+		err := CronSchedule("0 9 * * *", nil, options("tz"))
+		assert.EqualError(t, err, "cron validation failed: expression has no CRON_TZ time zone")
+	})
+
+	t.Run("Invalid expression", func(t *testing.T) {
+		err := CronSchedule("0 12 * *", nil, options("fields=5"))
+		assert.EqualError(t, err, "invalid cron: 0 12 * *")
+	})
+
+	t.Run("Invalid arguments", func(t *testing.T) {
+		assert.EqualError(t, CronSchedule("* * * * *", nil, nil), "cron expects at least one argument")
+		assert.EqualError(t, CronSchedule("* * * * *", nil, options("fields=4")), "cron: invalid value for fields: 4")
+		assert.EqualError(t, CronSchedule("* * * * *", nil, options("interval=often")), "cron: invalid value for interval: often")
+		assert.EqualError(t, CronSchedule("* * * * *", nil, options("hourly")), "cron: unknown option hourly")
+	})
+
+	t.Run("Wrong type", func(t *testing.T) {
+		assert.EqualError(t, CronSchedule(5, nil, options("tz")), "expected a string, got int")
+	})
+}
@@ -0,0 +1,33 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/functions"
+	"go-runtimevalidation/iso"
+)
+
+// Currency validates that the input is an ISO 4217 alphabetic currency code, e.g. "EUR". Codes are case-sensitive, so "eur" is rejected.
+//
+// Parameters:
+// - input (any): The value to be validated. It should be convertible to a string.
+//
+// Returns:
+// - error: If the input is not a known code, it returns an error with details.
+//
+// Example:
+//
+//	err := Currency("EUR") // err will be nil
+//	err := Currency("ABC") // err will be: "invalid currency: ABC"
+func Currency(input any) error {
+	// Check if the input is a string
+	value, err := functions.GetString(input)
+	if err != nil {
+		return fmt.Errorf("expected a string, got %T", input)
+	}
+
+	if _, ok := iso.CurrencyByCode(value); !ok {
+		return fmt.Errorf("invalid currency: %s", value)
+	}
+
+	return nil
+}
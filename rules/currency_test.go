@@ -0,0 +1,27 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCurrency(t *testing.T) {
+	t.Run("Valid currency code", func(t *testing.T) {
+		assert.NoError(t, Currency("EUR"))
+		assert.NoError(t, Currency("JPY"))
+		assert.NoError(t, Currency("XAU"))
+	})
+
+	t.Run("Unknown currency code", func(t *testing.T) {
+		err := Currency("ABC")
+		assert.Error(t, err)
+		assert.Equal(t, "invalid currency: ABC", err.Error())
+	})
+
+	t.Run("Lower case code", func(t *testing.T) {
+		err := Currency("eur")
+		assert.Error(t, err)
+		assert.Equal(t, "invalid currency: eur", err.Error())
+	})
+}
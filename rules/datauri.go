@@ -12,7 +12,7 @@ import (
 // the Data URI (e.g., "data:[<mediatype>][;base64],<data>").
 //
 // Note: This function does not verify the validity or integrity of the content within
-// the Data URI, only the structural format.
+// the Data URI, only the structural format. Use DataURIDecoded to decode the data and check its media type.
 //
 // If the input is not a string or does not match the expected Data URI pattern,
 // the function returns an error.
@@ -0,0 +1,137 @@
+package rules
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"go-runtimevalidation/args"
+	"mime"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// DataURIDecoded decodes a Data URI and validates its media type and content.
+// It is expected to be used in validation rules such as `datauridecoded:mime=image/png,mime=image/jpeg,max=65536`
+// or `datauridecoded(json):mime=application/json`, where the rule in parentheses is parsed by the validation package
+// and applied to the decoded content as a string.
+//
+// Media types are compared without their parameters, case-insensitively, and `type/*` allows every subtype of a type.
+// A Data URI without a media type has the type text/plain.
+//
+// Parameters:
+//   - input (any): The value being validated, a string.
+//   - obj (any): The object containing additional data (can be used for Field references within the args).
+//   - arguments: The allowed media types as `mime=type/subtype`, and the decoded length bounds `min=N` and `max=N`,
+//     given as numbers or Field references. When no media type is given, every media type is allowed.
+//   - validate: The validation to apply to the decoded content, or nil.
+//
+// Returns nil if the input is valid, or an error if:
+//   - The input is not a string or not a valid Data URI
+//   - The media type is not allowed
+//   - The decoded content is shorter than min or longer than max bytes
+//   - The validation fails for the decoded content.
+//
+// Example:
+//
+//	input := "data:image/gif;base64,R0lGODlhAQABAAAAACw="
+//	obj := nil
+//	args := map[string]Arg{
+//	    "mime=image/png": {Value: "mime=image/png"},
+//	}
+//	err := DataURIDecoded(input, obj, args, nil)  // err will be: "datauridecoded validation failed: media type image/gif is not one of image/png"
+func DataURIDecoded(input any, obj any, arguments map[string]args.Arg, validate func(value any) error) error {
+	policy, err := parseDecodedPolicy("datauridecoded", obj, arguments, "mime")
+	if err != nil {
+		return err
+	}
+
+	// Check if the input is a string
+	value, ok := input.(string)
+	if !ok {
+		return fmt.Errorf("expected a string, got %T", input)
+	}
+
+	uri, err := parseDataURI(value)
+	if err != nil {
+		return fmt.Errorf("invalid data uri: %s: %w", value, err)
+	}
+
+	if len(policy.mimeTypes) > 0 && !mediaTypeAllowed(uri.mediaType, policy.mimeTypes) {
+		allowed := append([]string(nil), policy.mimeTypes...)
+		sort.Strings(allowed)
+		return fmt.Errorf("datauridecoded validation failed: media type %s is not one of %s", uri.mediaType, strings.Join(allowed, ", "))
+	}
+
+	return policy.check("datauridecoded", uri.data, validate)
+}
+
+// mediaTypeAllowed reports whether the media type matches one of the allowed types, which may end with a /* wildcard.
+func mediaTypeAllowed(mediaType string, allowed []string) bool {
+	for _, pattern := range allowed {
+		if prefix, ok := strings.CutSuffix(pattern, "/*"); ok {
+			if strings.HasPrefix(mediaType, prefix+"/") {
+				return true
+			}
+		} else if mediaType == pattern {
+			return true
+		}
+	}
+	return false
+}
+
+// dataURI is a parsed Data URI.
+type dataURI struct {
+	mediaType string // The lower case type/subtype, text/plain when the URI does not name one
+	params    map[string]string
+	data      []byte
+}
+
+// parseDataURI parses and decodes a Data URI as described in RFC 2397. Unlike the datauri rule, which only
+// checks the structure of the URI, it requires a well-formed media type and strictly decodable data.
+func parseDataURI(value string) (dataURI, error) {
+	uri := dataURI{}
+
+	scheme, rest, found := strings.Cut(value, ":")
+	if !found || !strings.EqualFold(scheme, "data") {
+		return uri, errors.New("scheme is not data")
+	}
+
+	header, payload, found := strings.Cut(rest, ",")
+	if !found {
+		return uri, errors.New("missing comma before the data")
+	}
+
+	header, isBase64 := strings.CutSuffix(header, ";base64")
+	if header == "" || strings.HasPrefix(header, ";") {
+		// The media type defaults to text/plain, with the given parameters or US-ASCII
+		if header == "" {
+			header = ";charset=US-ASCII"
+		}
+		header = "text/plain" + header
+	}
+
+	mediaType, params, err := mime.ParseMediaType(header)
+	if err != nil {
+		return uri, fmt.Errorf("invalid media type %q", header)
+	}
+	if !strings.Contains(mediaType, "/") {
+		return uri, fmt.Errorf("invalid media type %q: missing subtype", header)
+	}
+	uri.mediaType, uri.params = mediaType, params
+
+	if isBase64 {
+		uri.data, err = base64.StdEncoding.Strict().DecodeString(payload)
+		if err != nil {
+			return uri, errors.New("invalid Base64 data")
+		}
+	} else {
+		text, err := url.PathUnescape(payload)
+		if err != nil {
+			return uri, errors.New("invalid percent-encoded data")
+		}
+		uri.data = []byte(text)
+	}
+
+	return uri, nil
+}
@@ -0,0 +1,81 @@
+package rules
+
+import (
+	"errors"
+	"go-runtimevalidation/args"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataURIDecoded(t *testing.T) {
+	images := map[string]args.Arg{"mime=image/png": {Value: "mime=image/png"}, "mime=image/jpeg": {Value: "mime=image/jpeg"}}
+	gif := "data:image/gif;base64,R0lGODlhAQABAAAAACw="
+
+	t.Run("Allowed media types", func(t *testing.T) {
+		assert.NoError(t, DataURIDecoded("data:image/png;base64,iVBORw0KGgo=", nil, images, nil))
+		assert.NoError(t, DataURIDecoded("data:IMAGE/JPEG;name=a.jpg;base64,/9j/4A==", nil, images, nil))
+
+		err := DataURIDecoded(gif, nil, images, nil)
+		assert.EqualError(t, err, "datauridecoded validation failed: media type image/gif is not one of image/jpeg, image/png")
+	})
+
+	t.Run("Media type wildcard", func(t *testing.T) {
+		arguments := map[string]args.Arg{"mime=image/*": {Value: "mime=image/*"}}
+		assert.NoError(t, DataURIDecoded(gif, nil, arguments, nil))
+
+		err := DataURIDecoded("data:,Hello", nil, arguments, nil)
+		assert.EqualError(t, err, "datauridecoded validation failed: media type text/plain is not one of image/*")
+	})
+
+	t.Run("Decoded length", func(t *testing.T) {
+		arguments := map[string]args.Arg{"max=10": {Value: "max=10"}}
+		assert.NoError(t, DataURIDecoded("data:,Hello%2C%20World", nil, map[string]args.Arg{"max=12": {Value: "max=12"}}, nil))
+
+		err := DataURIDecoded("data:,Hello%2C%20World", nil, arguments, nil)
+		assert.EqualError(t, err, "datauridecoded validation failed: decoded length 12 is more than 10 bytes")
+	})
+
+	t.Run("Nested validation", func(t *testing.T) {
+		err := DataURIDecoded("data:application/json,%7B%22a%22%3A1%7D", nil, nil, func(value any) error {
+			assert.Equal(t, `{"a":1}`, value)
+			return errors.New("invalid json")
+		})
+		assert.EqualError(t, err, "datauridecoded validation failed: invalid json")
+	})
+
+	t.Run("Invalid input", func(t *testing.T) {
+		err := DataURIDecoded("data:image/png;base64,invalid_base64", nil, images, nil)
+		assert.EqualError(t, err, "invalid data uri: data:image/png;base64,invalid_base64: invalid Base64 data")
+
+		assert.EqualError(t, DataURIDecoded(nil, nil, images, nil), "expected a string, got <nil>")
+	})
+
+	t.Run("Percent-encoded and default media types", func(t *testing.T) {
+		assert.NoError(t, DataURIDecoded("data:,Hello%2C%20World%21", nil, nil, nil))
+		assert.NoError(t, DataURIDecoded("data:text/html;charset=utf-8,%3Ch1%3EHello%3C%2Fh1%3E", nil, nil, nil))
+		assert.NoError(t, DataURIDecoded("data:;charset=utf-8;base64,SGVsbG8=", nil, nil, nil))
+	})
+
+	t.Run("Malformed data URI", func(t *testing.T) {
+		err := DataURIDecoded("data:text/plain;base64", nil, nil, nil)
+		assert.EqualError(t, err, "invalid data uri: data:text/plain;base64: missing comma before the data")
+
+		err = DataURIDecoded("data:text,Hello", nil, nil, nil)
+		assert.EqualError(t, err, `invalid data uri: data:text,Hello: invalid media type "text": missing subtype`)
+
+		err = DataURIDecoded("data:image/png;foo,abc", nil, nil, nil)
+		assert.EqualError(t, err, `invalid data uri: data:image/png;foo,abc: invalid media type "image/png;foo"`)
+
+		err = DataURIDecoded("data:,100%", nil, nil, nil)
+		assert.EqualError(t, err, "invalid data uri: data:,100%: invalid percent-encoded data")
+	})
+
+	t.Run("Invalid arguments", func(t *testing.T) {
+		err := DataURIDecoded(gif, nil, map[string]args.Arg{"mime=image": {Value: "mime=image"}}, nil)
+		assert.EqualError(t, err, "datauridecoded: invalid value for mime: image")
+
+		err = DataURIDecoded(gif, nil, map[string]args.Arg{"mime": {Value: "mime"}}, nil)
+		assert.EqualError(t, err, "datauridecoded: missing value for mime")
+	})
+}
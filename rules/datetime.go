@@ -0,0 +1,89 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/functions"
+	"strings"
+	"time"
+)
+
+// namedLayouts maps the layout names accepted by the datetime rule to their Go layouts.
+var namedLayouts = map[string]string{
+	"ansic":       time.ANSIC,
+	"rfc822":      time.RFC822,
+	"rfc822z":     time.RFC822Z,
+	"rfc850":      time.RFC850,
+	"rfc1123":     time.RFC1123,
+	"rfc1123z":    time.RFC1123Z,
+	"rfc3339":     time.RFC3339,
+	"rfc3339nano": time.RFC3339Nano,
+	"kitchen":     time.Kitchen,
+	"datetime":    time.DateTime,
+	"date":        time.DateOnly,
+	"time":        time.TimeOnly,
+}
+
+// DateTime validates that the input string is a date and/or time in the given layout.
+// It is expected to be used in validation rules such as `datetime:2006-01-02`, `datetime:rfc3339` or `datetime:$Layout`.
+//
+// The layout is either a Go reference layout (see the time package) or one of the names
+// ansic, rfc822, rfc822z, rfc850, rfc1123, rfc1123z, rfc3339, rfc3339nano, kitchen, datetime, date and time.
+// Layouts containing commas must use a name, since commas separate rule arguments.
+//
+// Parameters:
+// - input: The value being validated, expected to be a string.
+// - obj: The object containing additional data (can be used for Field references within the args).
+// - args: A map containing exactly one argument, the layout.
+//
+// Returns nil if the input is valid, or an error if:
+// - The number of arguments is not equal to 1
+// - The input is not a string
+// - The input cannot be parsed using the layout.
+//
+// Example:
+//
+//	input := "2024-02-30"
+//	obj := nil
+//	args := map[string]Arg{
+//	    "date": {Value: "date"},
+//	}
+//	err := DateTime(input, obj, args)  // err will be: "datetime validation failed: 2024-02-30 does not match layout 2006-01-02"
+func DateTime(input any, obj any, arguments map[string]args.Arg) error {
+	if len(arguments) != 1 {
+		return fmt.Errorf("datetime expects exactly 1 argument, got %d", len(arguments))
+	}
+
+	// Get the first (and only) argument
+	var arg args.Arg
+	for _, v := range arguments {
+		arg = v
+		break
+	}
+
+	// Evaluate the argument
+	eval, err := arg.Evaluate(obj)
+	if err != nil {
+		return err
+	}
+
+	layout, err := functions.GetString(eval)
+	if err != nil {
+		return fmt.Errorf("unsupported type for datetime argument: %w", err)
+	}
+	if named, ok := namedLayouts[strings.ToLower(layout)]; ok {
+		layout = named
+	}
+
+	// Check if the input is a string
+	value, ok := input.(string)
+	if !ok {
+		return fmt.Errorf("expected a string, got %T", input)
+	}
+
+	if _, err := time.Parse(layout, value); err != nil {
+		return fmt.Errorf("datetime validation failed: %s does not match layout %s", value, layout)
+	}
+
+	return nil
+}
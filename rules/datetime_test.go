@@ -0,0 +1,61 @@
+package rules
+
+import (
+	"go-runtimevalidation/args"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateTime(t *testing.T) {
+	t.Run("Valid date with Go layout", func(t *testing.T) {
+		err := DateTime("2024-02-29", nil, map[string]args.Arg{
+			"2006-01-02": {Value: "2006-01-02"},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Invalid calendar date", func(t *testing.T) {
+		err := DateTime("2024-02-30", nil, map[string]args.Arg{
+			"date": {Value: "date"},
+		})
+		assert.EqualError(t, err, "datetime validation failed: 2024-02-30 does not match layout 2006-01-02")
+	})
+
+	t.Run("Valid RFC 3339 by name", func(t *testing.T) {
+		err := DateTime("2024-01-02T15:04:05+02:00", nil, map[string]args.Arg{
+			"RFC3339": {Value: "RFC3339"},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Layout from field", func(t *testing.T) {
+		obj := struct {
+			Layout string
+		}{Layout: "02/01/2006"}
+
+		err := DateTime("31/12/2024", obj, map[string]args.Arg{
+			"$Layout": {Type: args.FieldArg, Field: "Layout"},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Numeric layout", func(t *testing.T) {
+		err := DateTime("2024", nil, map[string]args.Arg{
+			"2006": {Value: 2006},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Invalid input type", func(t *testing.T) {
+		err := DateTime(20240101, nil, map[string]args.Arg{
+			"date": {Value: "date"},
+		})
+		assert.EqualError(t, err, "expected a string, got int")
+	})
+
+	t.Run("No arguments", func(t *testing.T) {
+		err := DateTime("2024-01-01", nil, map[string]args.Arg{})
+		assert.EqualError(t, err, "datetime expects exactly 1 argument, got 0")
+	})
+}
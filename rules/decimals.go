@@ -0,0 +1,89 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/functions"
+)
+
+// decimalsOptions are the options of the decimals rule.
+var decimalsOptions = args.Options{Rule: "decimals", Values: []string{"min", "max"}}
+
+// Decimals validates the number of decimal places of the input, ignoring trailing zeros.
+// It is expected to be used in validation rules such as `decimals:2` or `decimals:max=2` for at most 2 decimal places,
+// `decimals:min=1,max=3`, or `decimals:$currencyminor($Currency)` to check an amount against the minor unit of its ISO 4217 currency.
+//
+// Parameters:
+// - input: The value being validated. It can be an integer, a float, a *big.Int, *big.Float or *big.Rat, or a decimal string.
+// - obj: The object containing additional data (can be used for Field references within the args).
+// - args: A map containing either the maximum number of decimal places, or the options `min=N` and `max=N`
+// with numbers or Field references.
+//
+// Returns nil if the input is valid, or an error if:
+// - No bound is given, or a bound is given twice
+// - The input is not a finite number, or a bound is not a non-negative integer
+// - The input has fewer or more decimal places than allowed.
+//
+// Example:
+//
+//	input := 12.345
+//	obj := struct{ Currency string }{Currency: "EUR"}
+//	args := map[string]Arg{
+//	    "$currencyminor($Currency)": {Type: FunctionArg, Function: Function{Name: "currencyminor", Args: []Arg{{Type: FieldArg, Field: "Currency"}}}},
+//	}
+//	err := Decimals(input, obj, args)  // err will be: "decimals validation failed: 12.345 has 3 decimal places, more than 2"
+func Decimals(input any, obj any, arguments map[string]args.Arg) error {
+	if len(arguments) == 0 {
+		return fmt.Errorf("decimals expects at least 1 argument, got 0")
+	}
+
+	bounds := map[string]int64{}
+	for key, arg := range arguments {
+		// A bare argument is the maximum
+		name, eval := "max", any(nil)
+		if decimalsOptions.IsOption(arg) {
+			option, err := decimalsOptions.Parse(key, arg)
+			if err != nil {
+				return err
+			}
+			name = option.Name
+
+			value, err := option.Evaluate(obj)
+			if err != nil {
+				return err
+			}
+			eval = value
+		} else {
+			value, err := arg.Evaluate(obj)
+			if err != nil {
+				return err
+			}
+			eval = value
+		}
+
+		if _, ok := bounds[name]; ok {
+			return fmt.Errorf("decimals: %s is given more than once", name)
+		}
+		bound, err := functions.GetInt(eval)
+		if err != nil || bound < 0 {
+			return fmt.Errorf("unsupported type for decimals argument: %v", eval)
+		}
+		bounds[name] = bound
+	}
+
+	decimals, err := functions.GetDecimals(input)
+	if err != nil {
+		return fmt.Errorf("unsupported type for input field: %w", err)
+	}
+
+	if minimum, ok := bounds["min"]; ok && int64(decimals) < minimum {
+		number, _ := functions.GetNumber(input)
+		return fmt.Errorf("decimals validation failed: %s has %d decimal places, less than %d", functions.FormatNumber(number), decimals, minimum)
+	}
+	if maximum, ok := bounds["max"]; ok && int64(decimals) > maximum {
+		number, _ := functions.GetNumber(input)
+		return fmt.Errorf("decimals validation failed: %s has %d decimal places, more than %d", functions.FormatNumber(number), decimals, maximum)
+	}
+
+	return nil
+}
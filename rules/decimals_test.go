@@ -0,0 +1,110 @@
+package rules
+
+import (
+	"go-runtimevalidation/args"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecimals(t *testing.T) {
+	two := map[string]args.Arg{"2": {Value: 2}}
+
+	t.Run("Within decimal places", func(t *testing.T) {
+		assert.NoError(t, Decimals(12.34, nil, two))
+		assert.NoError(t, Decimals(12.5, nil, two))
+		assert.NoError(t, Decimals(12, nil, two))
+		assert.NoError(t, Decimals("12.340", nil, two))
+	})
+
+	t.Run("Too many decimal places", func(t *testing.T) {
+		err := Decimals(12.345, nil, two)
+		assert.Error(t, err)
+		assert.Equal(t, "decimals validation failed: 12.345 has 3 decimal places, more than 2", err.Error())
+	})
+
+	t.Run("Currency minor unit", func(t *testing.T) {
+		arguments := map[string]args.Arg{
+			"$currencyminor($Currency)": {Type: args.FunctionArg, Function: args.Function{Name: "currencyminor", Args: []args.Arg{{Type: args.FieldArg, Field: "Currency"}}}},
+		}
+
+		assert.NoError(t, Decimals("1000", struct{ Currency string }{Currency: "JPY"}, arguments))
+		assert.Error(t, Decimals("1000.5", struct{ Currency string }{Currency: "JPY"}, arguments))
+		assert.NoError(t, Decimals("1.125", struct{ Currency string }{Currency: "KWD"}, arguments))
+
+		err := Decimals("1.125", struct{ Currency string }{Currency: "ABC"}, arguments)
+		assert.Error(t, err)
+		assert.Equal(t, "unknown currency: ABC", err.Error())
+	})
+
+	t.Run("Big numbers", func(t *testing.T) {
+		assert.NoError(t, Decimals("1000000000000000000000.01", nil, two))
+		assert.NoError(t, Decimals(big.NewRat(1, 4), nil, two))
+
+		err := Decimals(big.NewRat(1, 8), nil, two)
+		assert.EqualError(t, err, "decimals validation failed: 0.125 has 3 decimal places, more than 2")
+
+		err = Decimals(big.NewRat(1, 3), nil, two)
+		assert.EqualError(t, err, "unsupported type for input field: 1/3 has no finite decimal representation")
+	})
+
+	t.Run("Not a finite number", func(t *testing.T) {
+		assert.Error(t, Decimals(math.NaN(), nil, two))
+		assert.Error(t, Decimals("abc", nil, two))
+	})
+
+	t.Run("Negative argument", func(t *testing.T) {
+		err := Decimals(1.5, nil, map[string]args.Arg{"-1": {Value: -1}})
+		assert.Error(t, err)
+		assert.Equal(t, "unsupported type for decimals argument: -1", err.Error())
+	})
+
+	t.Run("Wrong number of arguments", func(t *testing.T) {
+		err := Decimals(1.5, nil, map[string]args.Arg{})
+		assert.Error(t, err)
+		assert.Equal(t, "decimals expects at least 1 argument, got 0", err.Error())
+	})
+
+	t.Run("Min and max options", func(t *testing.T) {
+		arguments := map[string]args.Arg{"min=1": {Value: "min=1"}, "max=3": {Value: "max=3"}}
+		assert.NoError(t, Decimals(1.5, nil, arguments))
+		assert.NoError(t, Decimals("1.125", nil, arguments))
+
+		err := Decimals("12.00", nil, arguments)
+		assert.EqualError(t, err, "decimals validation failed: 12 has 0 decimal places, less than 1")
+
+		err = Decimals(1.0625, nil, arguments)
+		assert.EqualError(t, err, "decimals validation failed: 1.0625 has 4 decimal places, more than 3")
+
+		assert.NoError(t, Decimals(1.25, nil, map[string]args.Arg{"max=2": {Value: "max=2"}}))
+		assert.Error(t, Decimals(1.255, nil, map[string]args.Arg{"max=2": {Value: "max=2"}}))
+	})
+
+	t.Run("Option field reference", func(t *testing.T) {
+		obj := struct{ Scale int }{Scale: 2}
+		arguments := map[string]args.Arg{"max=$Scale": {Value: "max=$Scale"}}
+		assert.NoError(t, Decimals(1.25, obj, arguments))
+		assert.Error(t, Decimals(1.255, obj, arguments))
+	})
+
+	t.Run("Option function call", func(t *testing.T) {
+		arguments := map[string]args.Arg{"max=$currencyminor($Currency)": {Value: "max=$currencyminor($Currency)"}}
+		assert.NoError(t, Decimals(12.34, struct{ Currency string }{Currency: "EUR"}, arguments))
+
+		err := Decimals(12.34, struct{ Currency string }{Currency: "JPY"}, arguments)
+		assert.EqualError(t, err, "decimals validation failed: 12.34 has 2 decimal places, more than 0")
+	})
+
+	t.Run("Invalid options", func(t *testing.T) {
+		err := Decimals(1.5, nil, map[string]args.Arg{"scale=2": {Value: "scale=2"}})
+		assert.EqualError(t, err, "decimals: unknown option scale=2")
+
+		err = Decimals(1.5, nil, map[string]args.Arg{"max": {Value: "max"}})
+		assert.EqualError(t, err, "decimals: missing value for max")
+
+		err = Decimals(1.5, nil, map[string]args.Arg{"2": {Value: 2}, "max=3": {Value: "max=3"}})
+		assert.EqualError(t, err, "decimals: max is given more than once")
+	})
+}
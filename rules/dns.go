@@ -2,20 +2,25 @@ package rules
 
 import (
 	"fmt"
-	"go-runtimevalidation/regex"
+	"go-runtimevalidation/domain"
 )
 
-// DNS validates if the input is a valid DNS label based on RFC 1035.
+// DNS checks if the input string is a single DNS label, such as "my-service" or "bücher".
 //
-// DNS labels are separated by dots (.) and consist of alphanumeric characters
-// and hyphens (-), but labels cannot start or end with a hyphen. Each label can
-// be up to 63 characters long.
+// ASCII labels may contain letters, digits and hyphens but must not start or end with a hyphen;
+// internationalized labels, in Unicode or punycode form, must be valid under IDNA2008.
+// The label is limited to 63 octets in ASCII form.
 //
 // Parameters:
-// - input (any): The value to be validated. It should be convertible to a string.
+// - input: the value to be validated (expected to be a string).
 //
 // Returns:
-// - error: If the DNS label is invalid, it returns an error with details.
+// - error: an error describing the problem if validation fails, or if the input type is incorrect. Returns nil if validation passes.
+//
+// Example:
+//
+//	err := DNS("my-domain") // err will be nil
+//	err := DNS("-example")  // err will be: `invalid dns: -example: label "-example" starts with a hyphen`
 func DNS(input any) error {
 	// Check if the input is a string
 	value, ok := input.(string)
@@ -23,9 +28,8 @@ func DNS(input any) error {
 		return fmt.Errorf("expected a string, got %T", input)
 	}
 
-	// Check if the string is a valid dns
-	if !regex.DnsRegex().MatchString(value) {
-		return fmt.Errorf("invalid dns: %s", value)
+	if _, err := domain.ParseLabel(value); err != nil {
+		return fmt.Errorf("invalid dns: %s: %w", value, err)
 	}
 
 	return nil
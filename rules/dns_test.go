@@ -25,30 +25,40 @@ func TestDNS(t *testing.T) {
 	t.Run("Invalid DNS - starts with hyphen", func(t *testing.T) {
 		err := DNS("-example")
 		assert.Error(t, err)
-		assert.Equal(t, "invalid dns: -example", err.Error())
+		assert.Equal(t, `invalid dns: -example: label "-example" starts with a hyphen`, err.Error())
 	})
 
 	t.Run("Invalid DNS - ends with hyphen", func(t *testing.T) {
 		err := DNS("example-")
 		assert.Error(t, err)
-		assert.Equal(t, "invalid dns: example-", err.Error())
+		assert.Equal(t, `invalid dns: example-: label "example-" ends with a hyphen`, err.Error())
 	})
 
 	t.Run("Invalid DNS - too long", func(t *testing.T) {
 		err := DNS("thisisaverylongdnsnamethatexceedstheallowablelimitof63characters")
 		assert.Error(t, err)
-		assert.Equal(t, "invalid dns: thisisaverylongdnsnamethatexceedstheallowablelimitof63characters", err.Error())
+		assert.Equal(t, `invalid dns: thisisaverylongdnsnamethatexceedstheallowablelimitof63characters: label "thisisaverylongdnsnamethatexceedstheallowablelimitof63characters" is longer than 63 octets`, err.Error())
 	})
 
 	t.Run("Invalid DNS - contains spaces", func(t *testing.T) {
 		err := DNS("example domain")
 		assert.Error(t, err)
-		assert.Equal(t, "invalid dns: example domain", err.Error())
+		assert.Equal(t, `invalid dns: example domain: label "example domain" contains invalid character ' '`, err.Error())
+	})
+
+	t.Run("Valid DNS - internationalized", func(t *testing.T) {
+		assert.NoError(t, DNS("bücher"))
+		assert.NoError(t, DNS("xn--bcher-kva"))
+	})
+
+	t.Run("Invalid DNS - more than one label", func(t *testing.T) {
+		err := DNS("example.com")
+		assert.EqualError(t, err, `invalid dns: example.com: label "example.com" contains invalid character '.'`)
 	})
 
 	t.Run("Invalid DNS - only hyphens", func(t *testing.T) {
 		err := DNS("---")
 		assert.Error(t, err)
-		assert.Equal(t, "invalid dns: ---", err.Error())
+		assert.Equal(t, `invalid dns: ---: label "---" starts with a hyphen`, err.Error())
 	})
 }
@@ -0,0 +1,26 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/functions"
+)
+
+// Duration validates that the input is a duration, either a time.Duration or a string
+// accepted by time.ParseDuration such as "90m", "1h30m" or "250ms".
+//
+// Parameters:
+// - input: The value being validated. It can be a time.Duration, an integer number of nanoseconds, or a string.
+//
+// Returns nil if the input is a valid duration, or an error if the input cannot be converted to a duration.
+//
+// Example:
+//
+//	err := Duration("1h30m") // err will be nil
+//	err := Duration("soon")  // err will be: "invalid duration: failed to parse \"soon\" of type string as duration"
+func Duration(input any) error {
+	if _, err := functions.GetDuration(input); err != nil {
+		return fmt.Errorf("invalid duration: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package rules
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDuration(t *testing.T) {
+	t.Run("Valid duration string", func(t *testing.T) {
+		assert.NoError(t, Duration("1h30m"))
+	})
+
+	t.Run("Valid time.Duration", func(t *testing.T) {
+		assert.NoError(t, Duration(90*time.Minute))
+	})
+
+	t.Run("Missing unit", func(t *testing.T) {
+		assert.EqualError(t, Duration("90"), "invalid duration: failed to parse \"90\" of type string as duration")
+	})
+
+	t.Run("Invalid input type", func(t *testing.T) {
+		assert.Error(t, Duration(1.5))
+	})
+}
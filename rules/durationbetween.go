@@ -0,0 +1,71 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/functions"
+	"time"
+)
+
+// DurationBetween validates that the input is a duration inclusively between two bounds.
+// It is expected to be used in validation rules such as `durationbetween:1m,1h` or `durationbetween:30s,$MaxTimeout`.
+// Like Between, the order of the bounds does not matter.
+//
+// Parameters:
+// - input: The value being validated. It can be a time.Duration, an integer number of nanoseconds, or a string like "90m".
+// - obj: The object containing additional data (can be used for Field references within the args).
+// - args: The list of arguments, where exactly two entries are expected to specify the bounds.
+//
+// Returns nil if the input is valid, or an error if:
+// - The number of arguments is not equal to 2
+// - The input or any of the arguments cannot be converted to a duration
+// - The input is not inclusively between the two bounds.
+//
+// Example:
+//
+//	input := "90m"
+//	obj := nil
+//	args := []Arg{
+//	    {Value: "1m"},
+//	    {Value: "1h"},
+//	}
+//	err := DurationBetween(input, obj, args)  // err will be: "durationbetween validation failed: 1h30m0s is not inclusively between 1m0s and 1h0m0s"
+func DurationBetween(input any, obj any, arguments []args.Arg) error {
+	if len(arguments) != 2 {
+		return fmt.Errorf("durationbetween expects exactly 2 arguments, got %d", len(arguments))
+	}
+
+	// Get the value of the input
+	value, err := functions.GetDuration(input)
+	if err != nil {
+		return fmt.Errorf("unsupported type for input field: %w", err)
+	}
+
+	// Evaluate both bounds
+	bounds := make([]time.Duration, 0, 2)
+	for _, v := range arguments {
+		eval, err := v.Evaluate(obj)
+		if err != nil {
+			return err
+		}
+
+		bound, err := functions.GetDuration(eval)
+		if err != nil {
+			return fmt.Errorf("unsupported type for durationbetween argument: %w", err)
+		}
+		bounds = append(bounds, bound)
+	}
+
+	lower, upper := bounds[0], bounds[1]
+	if lower > upper {
+		lower, upper = upper, lower
+	}
+
+	// Compare values to determine if input is between bounds
+	if value < lower || value > upper {
+		return fmt.Errorf("durationbetween validation failed: %s is not inclusively between %s and %s", value, lower, upper)
+	}
+
+	// Validation passed
+	return nil
+}
@@ -0,0 +1,46 @@
+package rules
+
+import (
+	"go-runtimevalidation/args"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDurationBetween(t *testing.T) {
+	bounds := []args.Arg{
+		{Value: "1m"},
+		{Value: "1h"},
+	}
+
+	t.Run("Duration within bounds", func(t *testing.T) {
+		assert.NoError(t, DurationBetween("30m", nil, bounds))
+	})
+
+	t.Run("Duration equal to bounds", func(t *testing.T) {
+		assert.NoError(t, DurationBetween(time.Minute, nil, bounds))
+		assert.NoError(t, DurationBetween(time.Hour, nil, bounds))
+	})
+
+	t.Run("Duration outside bounds", func(t *testing.T) {
+		err := DurationBetween("90m", nil, bounds)
+		assert.EqualError(t, err, "durationbetween validation failed: 1h30m0s is not inclusively between 1m0s and 1h0m0s")
+	})
+
+	t.Run("Equal bounds", func(t *testing.T) {
+		hour := []args.Arg{
+			{Value: "1h"},
+			{Value: "1h"},
+		}
+		assert.NoError(t, DurationBetween("60m", nil, hour))
+
+		err := DurationBetween("61m", nil, hour)
+		assert.EqualError(t, err, "durationbetween validation failed: 1h1m0s is not inclusively between 1h0m0s and 1h0m0s")
+	})
+
+	t.Run("Wrong number of arguments", func(t *testing.T) {
+		err := DurationBetween("30m", nil, []args.Arg{{Value: "1m"}})
+		assert.EqualError(t, err, "durationbetween expects exactly 2 arguments, got 1")
+	})
+}
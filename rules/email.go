@@ -2,38 +2,99 @@ package rules
 
 import (
 	"fmt"
-	"go-runtimevalidation/regex"
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/email"
 )
 
 // Email validates whether the input is a valid email address.
-// The function first checks if the input is of type string, and then verifies
-// whether it matches a regular expression designed to follow the standard email format.
+// The address is parsed following RFC 5322 and RFC 6531, so internationalized local parts and domains are accepted
+// and the domain is checked label by label with IDNA2008. The error explains why an address is invalid.
 //
 // Parameters:
 //   - input: The value to be checked. It should be a string representing an email address.
 //
 // Returns:
-//   - An error if the input is not a string or if it does not match the email format.
-//
-// The regular expression used for validation allows emails containing letters, digits,
-// certain special characters (like '.', '_', '%', '+', and '-'), and ensures there is a valid domain part.
+//   - An error if the input is not a string or is not a valid address.
 //
 // Example:
 //
 //	err := Email("test@example.com") // Returns: nil (valid email)
-//	err := Email("invalid-email")    // Returns: error ("invalid email: invalid-email")
+//	err := Email("invalid-email")    // Returns: error ("invalid email: invalid-email: missing @")
 //	err := Email(12345)              // Returns: error ("expected a string, got int")
 func Email(input any) error {
+	_, err := parseEmail(input, false)
+	return err
+}
+
+// EmailAddress validates an email address with options.
+// It is expected to be used in validation rules such as `email:name` or `email:name,nodisposable`.
+//
+// Options (in any order):
+//   - name: also accept an address with a display name, such as `"Jane Doe" <jane@example.com>`
+//   - nodisposable: reject addresses at disposable email providers on the embedded deny list
+//
+// Parameters:
+//   - input: The value being validated, expected to be a string.
+//   - obj: The object containing additional data (can be used for Field references within the args).
+//   - args: A map of options as described above.
+//
+// Returns nil if the input is valid, or an error if:
+//   - An option is unknown
+//   - The input is not a string or not a valid address
+//   - The domain is disposable and nodisposable is given.
+//
+// Example:
+//
+//	input := "Jane <jane@mailinator.com>"
+//	args := map[string]Arg{"name": {Value: "name"}, "nodisposable": {Value: "nodisposable"}}
+//	err := EmailAddress(input, nil, args)  // err will be: "email validation failed: mailinator.com is a disposable email domain"
+func EmailAddress(input any, obj any, arguments map[string]args.Arg) error {
+	withName, noDisposable := false, false
+	for key, v := range arguments {
+		eval, err := v.Evaluate(obj)
+		if err != nil {
+			return err
+		}
+
+		switch eval {
+		case "name":
+			withName = true
+		case "nodisposable":
+			noDisposable = true
+		default:
+			return fmt.Errorf("email: unknown option %s", key)
+		}
+	}
+
+	addr, err := parseEmail(input, withName)
+	if err != nil {
+		return err
+	}
+
+	if noDisposable && email.IsDisposable(addr.ASCIIDomain) {
+		return fmt.Errorf("email validation failed: %s is a disposable email domain", addr.UnicodeDomain)
+	}
+
+	return nil
+}
+
+// parseEmail parses the input as an email address, with an optional display name if withName is set.
+func parseEmail(input any, withName bool) (*email.Address, error) {
 	// Check if the input is a string
 	value, ok := input.(string)
 	if !ok {
-		return fmt.Errorf("expected a string, got %T", input)
+		return nil, fmt.Errorf("expected a string, got %T", input)
 	}
 
-	// Check if the email is valid
-	if !regex.EmailRegex().MatchString(value) {
-		return fmt.Errorf("invalid email: %s", value)
+	parse := email.Parse
+	if withName {
+		parse = email.ParseWithName
 	}
 
-	return nil
+	addr, err := parse(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid email: %s: %w", value, err)
+	}
+
+	return addr, nil
 }
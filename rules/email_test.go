@@ -1,6 +1,7 @@
 package rules
 
 import (
+	"go-runtimevalidation/args"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -30,17 +31,17 @@ func TestEmail(t *testing.T) {
 
 	t.Run("Missing '@' and domain", func(t *testing.T) {
 		err := Email("invalid-email")
-		assert.EqualError(t, err, "invalid email: invalid-email")
+		assert.EqualError(t, err, "invalid email: invalid-email: missing @")
 	})
 
 	t.Run("Missing local part", func(t *testing.T) {
 		err := Email("@missinglocal.com")
-		assert.EqualError(t, err, "invalid email: @missinglocal.com")
+		assert.EqualError(t, err, "invalid email: @missinglocal.com: local part is empty")
 	})
 
 	t.Run("Invalid domain part", func(t *testing.T) {
 		err := Email("missingdomain@.com")
-		assert.EqualError(t, err, "invalid email: missingdomain@.com")
+		assert.EqualError(t, err, "invalid email: missingdomain@.com: domain name has an empty label")
 	})
 
 	t.Run("Non-string input", func(t *testing.T) {
@@ -50,11 +51,59 @@ func TestEmail(t *testing.T) {
 
 	t.Run("Invalid multiple '@'", func(t *testing.T) {
 		err := Email("user@domain@extra.com")
-		assert.EqualError(t, err, "invalid email: user@domain@extra.com")
+		assert.EqualError(t, err, `invalid email: user@domain@extra.com: domain label "domain@extra" contains invalid character '@'`)
 	})
 
 	t.Run("Invalid domain with consecutive dots", func(t *testing.T) {
 		err := Email("user@domain..com")
-		assert.EqualError(t, err, "invalid email: user@domain..com")
+		assert.EqualError(t, err, "invalid email: user@domain..com: domain name has an empty label")
+	})
+
+	t.Run("Internationalized email", func(t *testing.T) {
+		assert.NoError(t, Email("jörg@bücher.example"))
+		assert.NoError(t, Email("info@xn--bcher-kva.example"))
+	})
+
+	t.Run("Invalid punycode label", func(t *testing.T) {
+		err := Email("user@xn--.example")
+		assert.EqualError(t, err, `invalid email: user@xn--.example: domain label "xn--" ends with a hyphen`)
+	})
+
+	t.Run("Display name is not accepted", func(t *testing.T) {
+		err := Email("Jane <jane@example.com>")
+		assert.EqualError(t, err, `invalid email: Jane <jane@example.com>: invalid character ' ' in local part`)
+	})
+}
+
+func TestEmailAddress(t *testing.T) {
+	options := func(names ...string) map[string]args.Arg {
+		arguments := map[string]args.Arg{}
+		for _, name := range names {
+			arguments[name] = args.Arg{Value: name}
+		}
+		return arguments
+	}
+
+	t.Run("Display name", func(t *testing.T) {
+		assert.NoError(t, EmailAddress(`"Jane" <j@x.io>`, nil, options("name")))
+		assert.NoError(t, EmailAddress("j@x.io", nil, options("name")))
+
+		err := EmailAddress("Doe, Jane <j@x.io>", nil, options("name"))
+		assert.EqualError(t, err, `invalid email: Doe, Jane <j@x.io>: invalid character ',' in display name, quote the name to use it`)
+	})
+
+	t.Run("Disposable domains", func(t *testing.T) {
+		assert.NoError(t, EmailAddress("jane@example.com", nil, options("nodisposable")))
+
+		err := EmailAddress("Jane <jane@mailinator.com>", nil, options("name", "nodisposable"))
+		assert.EqualError(t, err, "email validation failed: mailinator.com is a disposable email domain")
+
+		err = EmailAddress("jane@eu.YOPMAIL.com", nil, options("nodisposable"))
+		assert.EqualError(t, err, "email validation failed: eu.yopmail.com is a disposable email domain")
+	})
+
+	t.Run("Unknown option", func(t *testing.T) {
+		err := EmailAddress("jane@example.com", nil, options("strict"))
+		assert.EqualError(t, err, "email: unknown option strict")
 	})
 }
@@ -0,0 +1,87 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/email"
+	"go-runtimevalidation/functions"
+	"slices"
+	"strings"
+)
+
+// EmailDomain validates that the input is an email address at one of the given domains.
+// It is expected to be used in validation rules such as `emaildomain:corp.com,corp.io` or `emaildomain:*.corp.com,$Domain`.
+//
+// Domains are compared case-insensitively in their ASCII form, so `bücher.example` and `xn--bcher-kva.example` are the same domain.
+// A domain starting with `*.` matches its subdomains only, e.g. `*.corp.com` matches `eu.corp.com` but not `corp.com`.
+//
+// Parameters:
+// - input: The value being validated, expected to be an email address string.
+// - obj: The object containing additional data (can be used for Field references within the args).
+// - args: A map of arguments, each an allowed domain. At least one is required.
+//
+// Returns nil if the input is valid, or an error if:
+// - No domains are given, or a domain is not valid
+// - The input is not a valid email address
+// - The domain of the address is not one of the given domains.
+//
+// Example:
+//
+//	input := "jane@gmail.com"
+//	args := map[string]Arg{"corp.com": {Value: "corp.com"}, "corp.io": {Value: "corp.io"}}
+//	err := EmailDomain(input, nil, args)  // err will be: "emaildomain validation failed: gmail.com is not one of corp.com, corp.io"
+func EmailDomain(input any, obj any, arguments map[string]args.Arg) error {
+	if len(arguments) == 0 {
+		return fmt.Errorf("emaildomain expects at least one domain")
+	}
+
+	domains := make([]string, 0, len(arguments))
+	for _, v := range arguments {
+		eval, err := v.Evaluate(obj)
+		if err != nil {
+			return err
+		}
+		domain, err := emailDomainArg(eval)
+		if err != nil {
+			return err
+		}
+		domains = append(domains, domain)
+	}
+
+	addr, err := parseEmail(input, false)
+	if err != nil {
+		return err
+	}
+
+	for _, domain := range domains {
+		if suffix, ok := strings.CutPrefix(domain, "*"); ok {
+			if strings.HasSuffix(addr.ASCIIDomain, suffix) {
+				return nil
+			}
+		} else if addr.ASCIIDomain == domain {
+			return nil
+		}
+	}
+
+	slices.Sort(domains)
+	return fmt.Errorf("emaildomain validation failed: %s is not one of %s", addr.UnicodeDomain, strings.Join(domains, ", "))
+}
+
+// emailDomainArg normalizes a domain argument of EmailDomain to lower case ASCII, keeping a leading "*." wildcard.
+func emailDomainArg(value any) (string, error) {
+	domain, err := functions.GetString(value)
+	if err != nil {
+		return "", fmt.Errorf("unsupported type for emaildomain argument: %w", err)
+	}
+
+	// Parse a placeholder address to validate and convert the domain like the input's
+	prefix, rest := "", domain
+	if len(domain) > 2 && domain[:2] == "*." {
+		prefix, rest = "*.", domain[2:]
+	}
+	addr, err := email.Parse("x@" + rest)
+	if err != nil {
+		return "", fmt.Errorf("invalid emaildomain argument %s: %w", domain, err)
+	}
+	return prefix + addr.ASCIIDomain, nil
+}
@@ -0,0 +1,56 @@
+package rules
+
+import (
+	"go-runtimevalidation/args"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmailDomain(t *testing.T) {
+	corp := map[string]args.Arg{"corp.com": {Value: "corp.com"}, "corp.io": {Value: "corp.io"}}
+
+	t.Run("Allowed domains", func(t *testing.T) {
+		assert.NoError(t, EmailDomain("jane@corp.com", nil, corp))
+		assert.NoError(t, EmailDomain("jane@CORP.io", nil, corp))
+
+		err := EmailDomain("jane@gmail.com", nil, corp)
+		assert.EqualError(t, err, "emaildomain validation failed: gmail.com is not one of corp.com, corp.io")
+
+		err = EmailDomain("jane@eu.corp.com", nil, corp)
+		assert.EqualError(t, err, "emaildomain validation failed: eu.corp.com is not one of corp.com, corp.io")
+	})
+
+	t.Run("Subdomain wildcard", func(t *testing.T) {
+		arguments := map[string]args.Arg{"*.corp.com": {Value: "*.corp.com"}}
+		assert.NoError(t, EmailDomain("jane@eu.corp.com", nil, arguments))
+		assert.Error(t, EmailDomain("jane@corp.com", nil, arguments))
+		assert.Error(t, EmailDomain("jane@evilcorp.com", nil, arguments))
+	})
+
+	t.Run("Internationalized domains", func(t *testing.T) {
+		arguments := map[string]args.Arg{"bücher.example": {Value: "bücher.example"}}
+		assert.NoError(t, EmailDomain("info@xn--bcher-kva.example", nil, arguments))
+		assert.NoError(t, EmailDomain("info@BÜCHER.example", nil, arguments))
+	})
+
+	t.Run("Domain from field", func(t *testing.T) {
+		tenant := struct{ Domain string }{Domain: "corp.com"}
+		arguments := map[string]args.Arg{"$Domain": {Type: args.FieldArg, Field: "Domain"}}
+		assert.NoError(t, EmailDomain("jane@corp.com", tenant, arguments))
+		assert.Error(t, EmailDomain("jane@corp.io", tenant, arguments))
+	})
+
+	t.Run("Invalid address", func(t *testing.T) {
+		err := EmailDomain("jane@corp..com", nil, corp)
+		assert.EqualError(t, err, "invalid email: jane@corp..com: domain name has an empty label")
+	})
+
+	t.Run("Invalid arguments", func(t *testing.T) {
+		err := EmailDomain("jane@corp.com", nil, map[string]args.Arg{"corp_com": {Value: "corp_com"}})
+		assert.EqualError(t, err, `invalid emaildomain argument corp_com: domain label "corp_com" contains invalid character '_'`)
+
+		err = EmailDomain("jane@corp.com", nil, nil)
+		assert.EqualError(t, err, "emaildomain expects at least one domain")
+	})
+}
@@ -0,0 +1,84 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/functions"
+	"reflect"
+	"strings"
+)
+
+// Error codes reported by the length rules (length, minlen, maxlen and lenbetween).
+// Callers can retrieve them with errors.As on a *LengthError to map failures to their own messages.
+const (
+	CodeLengthMismatch   = "length_mismatch"
+	CodeLengthTooShort   = "length_too_short"
+	CodeLengthTooLong    = "length_too_long"
+	CodeLengthOutOfRange = "length_out_of_range"
+)
+
+// LengthError is returned by the length rules when the length of the input is not acceptable.
+// It carries the measured length, the bounds it was compared against and the unit of measurement,
+// which is the length mode for strings (e.g. "runes") and "elements" for slices, arrays and maps.
+type LengthError struct {
+	Code   string // one of the CodeLength* constants
+	Length int    // measured length of the input
+	Min    int64  // lower bound, or the expected length for CodeLengthMismatch
+	Max    int64  // upper bound, or the expected length for CodeLengthMismatch
+	Unit   string // unit the length was measured in
+}
+
+func (e *LengthError) Error() string {
+	switch e.Code {
+	case CodeLengthTooShort:
+		return fmt.Sprintf("minlen validation failed: length %d is less than %d (%s)", e.Length, e.Min, e.Unit)
+	case CodeLengthTooLong:
+		return fmt.Sprintf("maxlen validation failed: length %d is greater than %d (%s)", e.Length, e.Max, e.Unit)
+	case CodeLengthOutOfRange:
+		return fmt.Sprintf("lenbetween validation failed: length %d is not inclusively between %d and %d (%s)", e.Length, e.Min, e.Max, e.Unit)
+	default:
+		return fmt.Sprintf("length mismatch: %d != %d", e.Length, e.Min)
+	}
+}
+
+// lengthUnit describes the unit a value is measured in for the given length mode.
+func lengthUnit(input any, mode functions.LenMode) string {
+	if reflect.ValueOf(input).Kind() == reflect.String {
+		return string(mode)
+	}
+	return "elements"
+}
+
+// Error codes reported by the password rule, one per unmet requirement.
+const (
+	CodePasswordTooShort      = "password_too_short"
+	CodePasswordTooLong       = "password_too_long"
+	CodePasswordMissingUpper  = "password_missing_upper"
+	CodePasswordMissingLower  = "password_missing_lower"
+	CodePasswordMissingDigit  = "password_missing_digit"
+	CodePasswordMissingSymbol = "password_missing_special"
+	CodePasswordTooFewClasses = "password_too_few_classes"
+	CodePasswordRepeated      = "password_repeated_characters"
+	CodePasswordSequential    = "password_sequential_characters"
+	CodePasswordLowEntropy    = "password_low_entropy"
+	CodePasswordCommon        = "password_common"
+	CodePasswordContainsField = "password_contains_field"
+)
+
+// PasswordViolation is a single requirement of a password policy that a password does not meet.
+type PasswordViolation struct {
+	Code    string // one of the CodePassword* constants
+	Message string // human readable description, which never contains the password
+}
+
+// PasswordError is returned by the password rule with every unmet requirement, in the order they are checked.
+type PasswordError struct {
+	Violations []PasswordViolation
+}
+
+func (e *PasswordError) Error() string {
+	messages := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		messages[i] = v.Message
+	}
+	return "password validation failed: " + strings.Join(messages, "; ")
+}
@@ -0,0 +1,166 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/functions"
+	"io"
+	"mime/multipart"
+	"strconv"
+	"strings"
+)
+
+// FileSize validates the size of binary content in bytes.
+// It is expected to be used in validation rules such as `filesize:max=5MiB`, `filesize:min=1,max=$MaxUpload`
+// or `filesize:min=100KB`.
+//
+// Sizes are given in bytes, optionally with a unit: B, KB, MB and GB are powers of 1000, KiB, MiB and GiB are powers of 1024.
+// Units are case-insensitive.
+//
+// Parameters:
+//   - input (any): The content being validated, a byte slice, a string, an io.Reader or a *multipart.FileHeader.
+//     The size of a *multipart.FileHeader is taken from its header, readers that implement io.Seeker are measured
+//     without reading them, other readers are consumed, up to one byte past max when max is given.
+//   - obj (any): The object containing additional data (can be used for Field references within the args).
+//   - arguments: The bounds `min=SIZE` and `max=SIZE`, as sizes or Field references to numbers of bytes.
+//
+// Returns nil if the input is valid, or an error if:
+//   - No bound is given or a bound is invalid
+//   - The input cannot be read
+//   - The size is less than min or more than max.
+//
+// Example:
+//
+//	input := make([]byte, 2048)
+//	obj := nil
+//	args := map[string]Arg{
+//	    "max=1KiB": {Value: "max=1KiB"},
+//	}
+//	err := FileSize(input, obj, args)  // err will be: "filesize validation failed: size 2048 bytes is more than 1024 bytes"
+func FileSize(input any, obj any, arguments map[string]args.Arg) error {
+	if len(arguments) == 0 {
+		return fmt.Errorf("filesize expects at least one argument")
+	}
+
+	minSize, maxSize := int64(0), int64(-1)
+	for key, arg := range arguments {
+		option, err := fileSizeOptions.Parse(key, arg)
+		if err != nil {
+			return err
+		}
+
+		// Field references and function calls give a number of bytes, literals may have a unit
+		var size int64
+		if option.Value.Type == args.ValueArg {
+			size, err = parseByteSize(option.Text)
+		} else {
+			value, evalErr := option.Evaluate(obj)
+			if evalErr != nil {
+				return evalErr
+			}
+			size, err = functions.GetInt(value)
+		}
+		if err != nil || size < 0 {
+			return fmt.Errorf("filesize: invalid value for %s: %s", option.Name, option.Text)
+		}
+
+		if option.Name == "min" {
+			minSize = size
+		} else {
+			maxSize = size
+		}
+	}
+
+	size, truncated, err := contentSize(input, maxSize)
+	if err != nil {
+		return err
+	}
+
+	if truncated {
+		return fmt.Errorf("filesize validation failed: size is more than %d bytes", maxSize)
+	}
+	if size < minSize {
+		return fmt.Errorf("filesize validation failed: size %d bytes is less than %d bytes", size, minSize)
+	}
+	if maxSize >= 0 && size > maxSize {
+		return fmt.Errorf("filesize validation failed: size %d bytes is more than %d bytes", size, maxSize)
+	}
+
+	return nil
+}
+
+// fileSizeOptions are the options of the filesize rule.
+var fileSizeOptions = args.Options{Rule: "filesize", Values: []string{"min", "max"}}
+
+// byteUnits maps the lower case size units to their number of bytes.
+var byteUnits = map[string]int64{
+	"":    1,
+	"b":   1,
+	"kb":  1000,
+	"mb":  1000 * 1000,
+	"gb":  1000 * 1000 * 1000,
+	"kib": 1 << 10,
+	"mib": 1 << 20,
+	"gib": 1 << 30,
+}
+
+// parseByteSize parses a size such as 512, 100KB or 5MiB into a number of bytes.
+func parseByteSize(text string) (int64, error) {
+	digits := strings.TrimRight(text, "BbGgIiKkMm")
+	multiplier, ok := byteUnits[strings.ToLower(text[len(digits):])]
+	if !ok {
+		return 0, fmt.Errorf("unknown unit in size %q", text)
+	}
+
+	n, err := strconv.ParseInt(digits, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid size %q", text)
+	}
+	if n > 0 && multiplier > (1<<63-1)/n {
+		return 0, fmt.Errorf("size %q overflows int64", text)
+	}
+
+	return n * multiplier, nil
+}
+
+// contentSize returns the size of the content in bytes, see withContent for the supported inputs.
+// Readers that cannot seek are read to the end, or, if limit is not negative, to no more than limit bytes:
+// The boolean reports that such a reader has more than limit bytes, and its full size is then unknown.
+func contentSize(input any, limit int64) (int64, bool, error) {
+	switch value := input.(type) {
+	case *multipart.FileHeader:
+		return value.Size, false, nil
+	case io.ReadSeeker:
+		start, err := value.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return 0, false, fmt.Errorf("failed to read content: %w", err)
+		}
+		end, err := value.Seek(0, io.SeekEnd)
+		if err != nil {
+			return 0, false, fmt.Errorf("failed to read content: %w", err)
+		}
+		if _, err := value.Seek(start, io.SeekStart); err != nil {
+			return 0, false, fmt.Errorf("failed to read content: %w", err)
+		}
+		return end - start, false, nil
+	case io.Reader:
+		if limit >= 0 {
+			size, err := io.Copy(io.Discard, io.LimitReader(value, limit+1))
+			if err != nil {
+				return 0, false, fmt.Errorf("failed to read content: %w", err)
+			}
+			return size, size > limit, nil
+		}
+	}
+
+	var size int64
+	err := withContent(input, func(r io.Reader) error {
+		var err error
+		size, err = io.Copy(io.Discard, r)
+		if err != nil {
+			return fmt.Errorf("failed to read content: %w", err)
+		}
+		return nil
+	})
+	return size, false, err
+}
@@ -0,0 +1,90 @@
+package rules
+
+import (
+	"bytes"
+	"go-runtimevalidation/args"
+	"io"
+	"mime/multipart"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// multipartFile returns the header of a file uploaded in a multipart form.
+func multipartFile(t *testing.T, name string, content []byte) *multipart.FileHeader {
+	t.Helper()
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("file", name)
+	assert.NoError(t, err)
+	_, err = part.Write(content)
+	assert.NoError(t, err)
+	assert.NoError(t, writer.Close())
+
+	form, err := multipart.NewReader(&body, writer.Boundary()).ReadForm(1 << 20)
+	assert.NoError(t, err)
+	return form.File["file"][0]
+}
+
+func TestFileSize(t *testing.T) {
+	options := func(values ...string) map[string]args.Arg {
+		arguments := map[string]args.Arg{}
+		for _, v := range values {
+			arguments[v] = args.Arg{Value: v}
+		}
+		return arguments
+	}
+
+	t.Run("Within bounds", func(t *testing.T) {
+		content := make([]byte, 2048)
+		assert.NoError(t, FileSize(content, nil, options("min=1", "max=2KiB")))
+		assert.NoError(t, FileSize(bytes.NewReader(content), nil, options("max=2048")))
+		assert.NoError(t, FileSize(multipartFile(t, "a.bin", content), nil, options("min=2kb")))
+		assert.NoError(t, FileSize(io.LimitReader(strings.NewReader(strings.Repeat("a", 100)), 10), nil, options("max=10B")))
+	})
+
+	t.Run("Out of bounds", func(t *testing.T) {
+		content := make([]byte, 2048)
+
+		err := FileSize(content, nil, options("max=1KiB"))
+		assert.EqualError(t, err, "filesize validation failed: size 2048 bytes is more than 1024 bytes")
+
+		err = FileSize(multipartFile(t, "a.bin", content), nil, options("min=1MB"))
+		assert.EqualError(t, err, "filesize validation failed: size 2048 bytes is less than 1000000 bytes")
+
+		err = FileSize([]byte{}, nil, options("min=1"))
+		assert.EqualError(t, err, "filesize validation failed: size 0 bytes is less than 1 bytes")
+	})
+
+	t.Run("Bounds from fields", func(t *testing.T) {
+		limits := struct{ MaxUpload int64 }{MaxUpload: 100}
+		err := FileSize(make([]byte, 101), limits, options("max=$MaxUpload"))
+		assert.EqualError(t, err, "filesize validation failed: size 101 bytes is more than 100 bytes")
+	})
+
+	t.Run("Seekable readers are measured from their position", func(t *testing.T) {
+		reader := bytes.NewReader(make([]byte, 100))
+		_, _ = reader.Seek(40, io.SeekStart)
+		assert.NoError(t, FileSize(reader, nil, options("min=60", "max=60")))
+		assert.Equal(t, 60, reader.Len())
+	})
+
+	t.Run("Readers that cannot seek are read no further than the maximum", func(t *testing.T) {
+		content := strings.NewReader(strings.Repeat("a", 1<<20))
+		reader := struct{ io.Reader }{content}
+
+		err := FileSize(reader, nil, options("max=1KiB"))
+		assert.EqualError(t, err, "filesize validation failed: size is more than 1024 bytes")
+		assert.Equal(t, 1<<20-1025, content.Len())
+
+		assert.NoError(t, FileSize(struct{ io.Reader }{strings.NewReader("abc")}, nil, options("min=3", "max=3")))
+	})
+
+	t.Run("Invalid arguments", func(t *testing.T) {
+		assert.EqualError(t, FileSize([]byte{}, nil, nil), "filesize expects at least one argument")
+		assert.EqualError(t, FileSize([]byte{}, nil, options("max=5TB")), "filesize: invalid value for max: 5TB")
+		assert.EqualError(t, FileSize([]byte{}, nil, options("size=5")), "filesize: unknown option size=5")
+		assert.EqualError(t, FileSize([]byte{}, nil, options("max=99999999999GiB")), "filesize: invalid value for max: 99999999999GiB")
+	})
+}
@@ -0,0 +1,41 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/functions"
+	"math"
+	"math/big"
+)
+
+// Finite validates that the input is a finite number, rejecting NaN and positive or negative infinity.
+// Rules comparing floats, such as `betweenf`, cannot tell NaN apart, so `finite` is expected to guard them,
+// e.g. `finite && betweenf:0,1`.
+//
+// Parameters:
+// - input: The value being validated. It can be an integer, a float, a *big.Int, *big.Float or *big.Rat, or a numeric string,
+// including "NaN", "Inf" and "-Infinity".
+//
+// Returns an error if:
+// - The input is not a number
+// - The input is NaN or infinite.
+//
+// Example:
+//
+//	err := Finite(1.5)  // err will be nil
+//	err := Finite(math.Inf(1))  // err will be: "finite validation failed: +Inf is not a finite number"
+func Finite(input any) error {
+	if f, ok := input.(*big.Float); ok && f != nil && f.IsInf() {
+		return fmt.Errorf("finite validation failed: %s is not a finite number", f.String())
+	}
+
+	// Floats and numeric strings may be NaN or infinite, big numbers out of the float64 range fail to convert but are finite
+	if f, err := functions.GetFloat(input); err == nil && (math.IsNaN(f) || math.IsInf(f, 0)) {
+		return fmt.Errorf("finite validation failed: %v is not a finite number", f)
+	}
+
+	if _, err := functions.GetNumber(input); err != nil {
+		return fmt.Errorf("unsupported type for input field: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package rules
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFinite(t *testing.T) {
+	// Test case 1: Zero
+	t.Run("Zero", func(t *testing.T) {
+		err := Finite(0)
+		assert.NoError(t, err)
+	})
+
+	// Test case 2: Largest float64
+	t.Run("Largest float64", func(t *testing.T) {
+		err := Finite(math.MaxFloat64)
+		assert.NoError(t, err)
+	})
+
+	// Test case 3: Float32
+	t.Run("Float32", func(t *testing.T) {
+		err := Finite(float32(1.5))
+		assert.NoError(t, err)
+	})
+
+	// Test case 4: Decimal string
+	t.Run("Decimal string", func(t *testing.T) {
+		err := Finite("-12.5")
+		assert.NoError(t, err)
+	})
+
+	// Test case 5: Decimal string beyond the float64 range
+	t.Run("Large decimal string", func(t *testing.T) {
+		err := Finite("1e400")
+		assert.NoError(t, err)
+	})
+
+	// Test case 6: Big float
+	t.Run("Big float", func(t *testing.T) {
+		err := Finite(big.NewFloat(1e300))
+		assert.NoError(t, err)
+	})
+
+	// Test case 7: NaN
+	t.Run("NaN", func(t *testing.T) {
+		err := Finite(math.NaN())
+		assert.Error(t, err)
+		assert.EqualError(t, err, "finite validation failed: NaN is not a finite number")
+	})
+
+	// Test case 8: Positive infinity
+	t.Run("Positive infinity", func(t *testing.T) {
+		err := Finite(math.Inf(1))
+		assert.Error(t, err)
+		assert.EqualError(t, err, "finite validation failed: +Inf is not a finite number")
+	})
+
+	// Test case 9: Negative float32 infinity
+	t.Run("Negative float32 infinity", func(t *testing.T) {
+		err := Finite(float32(math.Inf(-1)))
+		assert.Error(t, err)
+		assert.EqualError(t, err, "finite validation failed: -Inf is not a finite number")
+	})
+
+	// Test case 10: NaN string
+	t.Run("NaN string", func(t *testing.T) {
+		err := Finite("NaN")
+		assert.Error(t, err)
+		assert.EqualError(t, err, "finite validation failed: NaN is not a finite number")
+	})
+
+	// Test case 11: Infinity string
+	t.Run("Infinity string", func(t *testing.T) {
+		err := Finite("-Infinity")
+		assert.Error(t, err)
+		assert.EqualError(t, err, "finite validation failed: -Inf is not a finite number")
+	})
+
+	// Test case 12: Infinite big.Float
+	t.Run("Infinite big.Float", func(t *testing.T) {
+		err := Finite(new(big.Float).SetInf(false))
+		assert.Error(t, err)
+		assert.EqualError(t, err, "finite validation failed: +Inf is not a finite number")
+	})
+
+	// Test case 13: Input is not a number
+	t.Run("Non-numeric string", func(t *testing.T) {
+		err := Finite("abc")
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unsupported type for input field: failed to parse \"abc\" of type string as a number")
+	})
+
+	// Test case 14: Input has an unsupported type
+	t.Run("Slice input", func(t *testing.T) {
+		err := Finite([]int{1})
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unsupported type for input field: failed to parse [1] of type []int as a number")
+	})
+}
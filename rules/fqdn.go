@@ -2,19 +2,25 @@ package rules
 
 import (
 	"fmt"
-	"go-runtimevalidation/regex"
+	"go-runtimevalidation/domain"
+	"strings"
 )
 
-// FQDN checks if the input string is a fully qualified domain name (FQDN).
+// FQDN checks if the input string is a fully qualified domain name, such as "www.example.com" or "bücher.example.".
 //
-// This function validates the input based on the same rules as RFC 1123 for hostnames,
-// but additionally requires a non-numeric top-level domain (TLD). The FQDN may end with a period ('.').
+// The name is checked like a hostname (see Hostname), may end with a dot, and must have at least two labels,
+// the last of which, the top-level domain, must not be numeric.
 //
 // Parameters:
 // - input: the value to be validated (expected to be a string).
 //
 // Returns:
-// - error: an error if validation fails or if the input type is incorrect. Returns nil if validation passes.
+// - error: an error naming the offending label if validation fails, or if the input type is incorrect. Returns nil if validation passes.
+//
+// Example:
+//
+//	err := FQDN("example.com.") // err will be nil
+//	err := FQDN("example.123")  // err will be: `invalid fqdn: example.123: top-level domain "123" is numeric`
 func FQDN(input any) error {
 	// Check if the input is a string
 	value, ok := input.(string)
@@ -22,9 +28,17 @@ func FQDN(input any) error {
 		return fmt.Errorf("expected a string, got %T", input)
 	}
 
-	// Check if the string is a valid fqdn code
-	if !regex.FqdnRegex().MatchString(value) {
-		return fmt.Errorf("invalid fqdn: %s", value)
+	name, err := domain.Parse(value)
+	if err != nil {
+		return fmt.Errorf("invalid fqdn: %s: %w", value, err)
+	}
+
+	if len(name.Labels) < 2 {
+		return fmt.Errorf("invalid fqdn: %s: name has no top-level domain", value)
+	}
+
+	if tld := name.TLD().ASCII; strings.Trim(tld, "0123456789") == "" {
+		return fmt.Errorf("invalid fqdn: %s: top-level domain %q is numeric", value, tld)
 	}
 
 	return nil
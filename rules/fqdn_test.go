@@ -1,6 +1,7 @@
 package rules
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -25,31 +26,48 @@ func TestFQDN(t *testing.T) {
 	t.Run("Invalid FQDN - numeric TLD", func(t *testing.T) {
 		err := FQDN("example.123")
 		assert.Error(t, err)
-		assert.EqualError(t, err, "invalid fqdn: example.123")
+		assert.EqualError(t, err, `invalid fqdn: example.123: top-level domain "123" is numeric`)
 	})
 
 	t.Run("Invalid FQDN - starts with hyphen", func(t *testing.T) {
 		err := FQDN("-example.com")
 		assert.Error(t, err)
-		assert.EqualError(t, err, "invalid fqdn: -example.com")
+		assert.EqualError(t, err, `invalid fqdn: -example.com: label "-example" starts with a hyphen`)
 	})
 
 	t.Run("Invalid FQDN - ends with hyphen", func(t *testing.T) {
 		err := FQDN("example-.com")
 		assert.Error(t, err)
-		assert.EqualError(t, err, "invalid fqdn: example-.com")
+		assert.EqualError(t, err, `invalid fqdn: example-.com: label "example-" ends with a hyphen`)
 	})
 
 	t.Run("Invalid FQDN - contains invalid characters", func(t *testing.T) {
 		err := FQDN("ex@mple.com")
 		assert.Error(t, err)
-		assert.EqualError(t, err, "invalid fqdn: ex@mple.com")
+		assert.EqualError(t, err, `invalid fqdn: ex@mple.com: label "ex@mple" contains invalid character '@'`)
 	})
 
 	t.Run("Invalid FQDN - empty string", func(t *testing.T) {
 		err := FQDN("")
 		assert.Error(t, err)
-		assert.EqualError(t, err, "invalid fqdn: ")
+		assert.EqualError(t, err, "invalid fqdn: : name is empty")
+	})
+
+	t.Run("Valid FQDN - internationalized", func(t *testing.T) {
+		assert.NoError(t, FQDN("bücher.example"))
+		assert.NoError(t, FQDN("xn--bcher-kva.example."))
+	})
+
+	t.Run("Invalid FQDN - single label", func(t *testing.T) {
+		err := FQDN("localhost")
+		assert.EqualError(t, err, "invalid fqdn: localhost: name has no top-level domain")
+	})
+
+	t.Run("Invalid FQDN - too long", func(t *testing.T) {
+		label := strings.Repeat("a", 63)
+		name := strings.Join([]string{label, label, label, label}, ".")
+		err := FQDN(name)
+		assert.EqualError(t, err, "invalid fqdn: "+name+": name is longer than 253 octets")
 	})
 
 	t.Run("Invalid FQDN - wrong type (integer)", func(t *testing.T) {
@@ -0,0 +1,30 @@
+package rules
+
+import (
+	"go-runtimevalidation/functions"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFuture(t *testing.T) {
+	restore := functions.SetClock(func() time.Time { return time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC) })
+	defer restore()
+
+	t.Run("Time in the future", func(t *testing.T) {
+		assert.NoError(t, Future("2024-06-01T12:00:01Z"))
+	})
+
+	t.Run("Current time", func(t *testing.T) {
+		assert.EqualError(t, Future(time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)), "future validation failed: 2024-06-01T12:00:00Z is not after 2024-06-01T12:00:00Z")
+	})
+
+	t.Run("Time in the past", func(t *testing.T) {
+		assert.Error(t, Future("2024-05-31"))
+	})
+
+	t.Run("Invalid input", func(t *testing.T) {
+		assert.Error(t, Future(true))
+	})
+}
@@ -2,20 +2,27 @@ package rules
 
 import (
 	"fmt"
-	"go-runtimevalidation/regex"
+	"go-runtimevalidation/domain"
 )
 
 // Hostname checks if the input string is a valid hostname.
 //
 // This function validates the input based on the hostname rules defined in RFC 1123,
-// allowing hostnames to start with a digit. The function checks the input using a regex
-// for format validity, but does not check whether the hostname actually resolves or is in use.
+// allowing hostnames to start with a digit. Each label is checked separately: ASCII labels may contain
+// letters, digits and hyphens, and internationalized labels, in Unicode or punycode form, must be valid under IDNA2008.
+// Labels are limited to 63 octets and the whole name to 253 octets, counted in ASCII form. A trailing dot is not allowed.
+// The function does not check whether the hostname actually resolves or is in use.
 //
 // Parameters:
 // - input: the value to be validated (expected to be a string).
 //
 // Returns:
-// - error: an error if validation fails or if the input type is incorrect. Returns nil if validation passes.
+// - error: an error naming the offending label if validation fails, or if the input type is incorrect. Returns nil if validation passes.
+//
+// Example:
+//
+//	err := Hostname("bücher.example") // err will be nil
+//	err := Hostname("exa_mple.com")   // err will be: `invalid hostname: exa_mple.com: label "exa_mple" contains invalid character '_'`
 func Hostname(input any) error {
 	// Check if the input is a string
 	value, ok := input.(string)
@@ -23,10 +30,21 @@ func Hostname(input any) error {
 		return fmt.Errorf("expected a string, got %T", input)
 	}
 
-	// Check if the string is a valid hostname code
-	if !regex.HostnameRegex().MatchString(value) {
-		return fmt.Errorf("invalid hostname: %s", value)
+	if _, err := parseHostname(value); err != nil {
+		return fmt.Errorf("invalid hostname: %s: %w", value, err)
 	}
 
 	return nil
 }
+
+// parseHostname parses a hostname, which unlike a fully qualified domain name cannot end with a dot.
+func parseHostname(value string) (domain.Name, error) {
+	name, err := domain.Parse(value)
+	if err != nil {
+		return domain.Name{}, err
+	}
+	if name.Rooted {
+		return domain.Name{}, fmt.Errorf("name ends with a dot")
+	}
+	return name, nil
+}
@@ -26,38 +26,54 @@ func TestHostname(t *testing.T) {
 	t.Run("Invalid hostname - starts with hyphen", func(t *testing.T) {
 		err := Hostname("-example.com")
 		assert.Error(t, err)
-		assert.EqualError(t, err, "invalid hostname: -example.com")
+		assert.EqualError(t, err, `invalid hostname: -example.com: label "-example" starts with a hyphen`)
 	})
 
 	t.Run("Invalid hostname - label ends with hyphen", func(t *testing.T) {
 		err := Hostname("example-.com")
 		assert.Error(t, err)
-		assert.EqualError(t, err, "invalid hostname: example-.com")
+		assert.EqualError(t, err, `invalid hostname: example-.com: label "example-" ends with a hyphen`)
 	})
 
 	t.Run("Invalid hostname - ends with hyphen", func(t *testing.T) {
 		err := Hostname("example.com-")
 		assert.Error(t, err)
-		assert.EqualError(t, err, "invalid hostname: example.com-")
+		assert.EqualError(t, err, `invalid hostname: example.com-: label "com-" ends with a hyphen`)
 	})
 
 	t.Run("Invalid hostname - contains invalid characters", func(t *testing.T) {
 		err := Hostname("ex@mple.com")
 		assert.Error(t, err)
-		assert.EqualError(t, err, "invalid hostname: ex@mple.com")
+		assert.EqualError(t, err, `invalid hostname: ex@mple.com: label "ex@mple" contains invalid character '@'`)
 	})
 
 	t.Run("Invalid hostname - too long", func(t *testing.T) {
 		longHostname := strings.Repeat("a", 64) + ".com"
 		err := Hostname(longHostname)
 		assert.Error(t, err)
-		assert.EqualError(t, err, "invalid hostname: "+longHostname)
+		assert.EqualError(t, err, "invalid hostname: "+longHostname+`: label "`+strings.Repeat("a", 64)+`" is longer than 63 octets`)
 	})
 
 	t.Run("Invalid hostname - empty string", func(t *testing.T) {
 		err := Hostname("")
 		assert.Error(t, err)
-		assert.EqualError(t, err, "invalid hostname: ")
+		assert.EqualError(t, err, "invalid hostname: : name is empty")
+	})
+
+	t.Run("Valid hostname - internationalized", func(t *testing.T) {
+		assert.NoError(t, Hostname("bücher.example"))
+		assert.NoError(t, Hostname("xn--bcher-kva.example"))
+		assert.NoError(t, Hostname("例え.テスト"))
+	})
+
+	t.Run("Invalid hostname - invalid punycode", func(t *testing.T) {
+		err := Hostname("xn--ab-c.example")
+		assert.EqualError(t, err, `invalid hostname: xn--ab-c.example: label "xn--ab-c" is not a valid punycode label`)
+	})
+
+	t.Run("Invalid hostname - trailing dot", func(t *testing.T) {
+		err := Hostname("example.com.")
+		assert.EqualError(t, err, "invalid hostname: example.com.: name ends with a dot")
 	})
 
 	t.Run("Invalid hostname - wrong type (integer)", func(t *testing.T) {
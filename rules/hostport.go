@@ -0,0 +1,55 @@
+package rules
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+)
+
+// HostPort validates that the input is a host and port pair such as "example.com:443",
+// "10.0.0.1:8080" or "[2001:db8::1]:53". The host must be a hostname or an IP address
+// (IPv6 addresses must be enclosed in brackets) and the port must be between 1 and 65535.
+//
+// Parameters:
+// - input: The value being validated, expected to be a string.
+//
+// Returns nil if the input is a valid host and port pair, or an error if:
+// - The input is not a string
+// - The input cannot be split into a host and a port
+// - The host is not a valid hostname or IP address
+// - The port is not a valid port number.
+//
+// Example:
+//
+//	err := HostPort("example.com:443") // err will be nil
+//	err := HostPort("example.com")     // err will be: "invalid hostport: example.com"
+func HostPort(input any) error {
+	// Check if the input is a string
+	value, ok := input.(string)
+	if !ok {
+		return fmt.Errorf("expected a string, got %T", input)
+	}
+
+	host, port, err := net.SplitHostPort(value)
+	if err != nil {
+		return fmt.Errorf("invalid hostport: %s", value)
+	}
+
+	if !isPort(port) {
+		return fmt.Errorf("invalid port in hostport: %s", value)
+	}
+
+	if addr, err := netip.ParseAddr(host); err == nil {
+		// Bare IPv6 addresses are rejected by SplitHostPort, so this only guards against zones
+		if addr.Zone() != "" {
+			return fmt.Errorf("invalid host in hostport: %s", value)
+		}
+		return nil
+	}
+
+	if _, err := parseHostname(host); err != nil {
+		return fmt.Errorf("invalid host in hostport: %s: %w", value, err)
+	}
+
+	return nil
+}
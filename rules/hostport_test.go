@@ -0,0 +1,41 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHostPort(t *testing.T) {
+	t.Run("Valid hostname and port", func(t *testing.T) {
+		assert.NoError(t, HostPort("example.com:443"))
+	})
+
+	t.Run("Valid IPv4 and port", func(t *testing.T) {
+		assert.NoError(t, HostPort("10.0.0.1:8080"))
+	})
+
+	t.Run("Valid IPv6 and port", func(t *testing.T) {
+		assert.NoError(t, HostPort("[2001:db8::1]:53"))
+	})
+
+	t.Run("Missing port", func(t *testing.T) {
+		assert.EqualError(t, HostPort("example.com"), "invalid hostport: example.com")
+	})
+
+	t.Run("Unbracketed IPv6", func(t *testing.T) {
+		assert.Error(t, HostPort("2001:db8::1:53"))
+	})
+
+	t.Run("Invalid port", func(t *testing.T) {
+		assert.EqualError(t, HostPort("example.com:99999"), "invalid port in hostport: example.com:99999")
+	})
+
+	t.Run("Invalid host", func(t *testing.T) {
+		assert.EqualError(t, HostPort("exa_mple.com:80"), `invalid host in hostport: exa_mple.com:80: label "exa_mple" contains invalid character '_'`)
+	})
+
+	t.Run("Invalid input type", func(t *testing.T) {
+		assert.EqualError(t, HostPort(443), "expected a string, got int")
+	})
+}
@@ -0,0 +1,68 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/functions"
+	"go-runtimevalidation/htmlpolicy"
+	"strings"
+)
+
+// HTMLPolicy validates that the input only uses the HTML elements, attributes and URL schemes allowed by a named policy.
+// It is expected to be used in validation rules such as `htmlpolicy:ugc`, `htmlpolicy:strict` or `htmlpolicy:$Policy`.
+// Event handler attributes and javascript: URLs are always rejected. Policies are registered with htmlpolicy.Register;
+// use htmlpolicy.Sanitize with the same name to clean a value instead of rejecting it.
+//
+// Parameters:
+// - input: The value being validated, expected to be convertible to string.
+// - obj: The object containing additional data (can be used for Field references within the args).
+// - args: A map with exactly one argument, the policy name.
+//
+// Returns nil if the input is valid, or an error if:
+// - The number of arguments is not 1, or the policy is not registered
+// - The input cannot be converted to a string or parsed as HTML
+// - The input contains anything the policy does not allow. Every disallowed construct is listed.
+//
+// Example:
+//
+//	input := `<p onclick="steal()">Hi <script>alert(1)</script></p>`
+//	obj := nil
+//	args := map[string]Arg{"ugc": {Value: "ugc"}}
+//	err := HTMLPolicy(input, obj, args)  // err will be: "htmlpolicy validation failed: event handler onclick on <p> is not allowed; element <script> is not allowed"
+func HTMLPolicy(input any, obj any, arguments map[string]args.Arg) error {
+	if len(arguments) != 1 {
+		return fmt.Errorf("htmlpolicy expects exactly one argument, got %d", len(arguments))
+	}
+
+	var name string
+	for _, v := range arguments {
+		eval, err := v.Evaluate(obj)
+		if err != nil {
+			return err
+		}
+		name, err = functions.GetString(eval)
+		if err != nil {
+			return fmt.Errorf("unsupported type for htmlpolicy argument: %w", err)
+		}
+	}
+
+	policy, ok := htmlpolicy.Lookup(name)
+	if !ok {
+		return fmt.Errorf("unknown html policy: %s", name)
+	}
+
+	value, err := functions.GetString(input)
+	if err != nil {
+		return fmt.Errorf("unsupported type for input field: %w", err)
+	}
+
+	violations, err := policy.Check(value)
+	if err != nil {
+		return err
+	}
+	if len(violations) > 0 {
+		return fmt.Errorf("htmlpolicy validation failed: %s", strings.Join(violations, "; "))
+	}
+
+	return nil
+}
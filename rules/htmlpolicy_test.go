@@ -0,0 +1,42 @@
+package rules
+
+import (
+	"go-runtimevalidation/args"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTMLPolicy(t *testing.T) {
+	ugc := map[string]args.Arg{"ugc": {Value: "ugc"}}
+
+	t.Run("Conforming HTML", func(t *testing.T) {
+		assert.NoError(t, HTMLPolicy(`<p>Hello <a href="https://example.com">world</a></p>`, nil, ugc))
+		assert.NoError(t, HTMLPolicy("plain text", nil, map[string]args.Arg{"strict": {Value: "strict"}}))
+	})
+
+	t.Run("Every violation is reported", func(t *testing.T) {
+		err := HTMLPolicy(`<p onclick="steal()">Hi <script>alert(1)</script></p>`, nil, ugc)
+		assert.Error(t, err)
+		assert.Equal(t, "htmlpolicy validation failed: event handler onclick on <p> is not allowed; element <script> is not allowed", err.Error())
+	})
+
+	t.Run("Policy from field", func(t *testing.T) {
+		obj := struct{ Policy string }{Policy: "strict"}
+		err := HTMLPolicy("<b>bold</b>", obj, map[string]args.Arg{"$Policy": {Type: args.FieldArg, Field: "Policy"}})
+		assert.Error(t, err)
+		assert.Equal(t, "htmlpolicy validation failed: element <b> is not allowed", err.Error())
+	})
+
+	t.Run("Unknown policy", func(t *testing.T) {
+		err := HTMLPolicy("text", nil, map[string]args.Arg{"relaxed": {Value: "relaxed"}})
+		assert.Error(t, err)
+		assert.Equal(t, "unknown html policy: relaxed", err.Error())
+	})
+
+	t.Run("Wrong number of arguments", func(t *testing.T) {
+		err := HTMLPolicy("text", nil, map[string]args.Arg{"ugc": {Value: "ugc"}, "strict": {Value: "strict"}})
+		assert.Error(t, err)
+		assert.Equal(t, "htmlpolicy expects exactly one argument, got 2", err.Error())
+	})
+}
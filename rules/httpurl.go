@@ -0,0 +1,33 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+)
+
+// HTTPURL validates that the input is an absolute http or https URL with a host, such as "https://example.com".
+//
+// Parameters:
+// - input: The value being validated. It can be a string, a url.URL or a *url.URL.
+//
+// Returns nil if the input is a valid HTTP URL, or an error if:
+// - The input is not a string, url.URL or *url.URL
+// - The input is not a valid URL, or its scheme is not http or https.
+//
+// Example:
+//
+//	err := HTTPURL("https://example.com") // err will be nil
+//	err := HTTPURL("ftp://example.com")   // err will be: "invalid http url: ftp://example.com"
+func HTTPURL(input any) error {
+	u, err := getURL(input)
+	if err != nil {
+		return err
+	}
+
+	scheme := strings.ToLower(u.Scheme)
+	if (scheme != "http" && scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid http url: %s", u)
+	}
+
+	return nil
+}
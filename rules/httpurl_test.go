@@ -0,0 +1,25 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPURL(t *testing.T) {
+	t.Run("Valid http URL", func(t *testing.T) {
+		assert.NoError(t, HTTPURL("http://example.com"))
+	})
+
+	t.Run("Valid https URL with uppercase scheme", func(t *testing.T) {
+		assert.NoError(t, HTTPURL("HTTPS://example.com/path"))
+	})
+
+	t.Run("Other scheme", func(t *testing.T) {
+		assert.EqualError(t, HTTPURL("ftp://example.com"), "invalid http url: ftp://example.com")
+	})
+
+	t.Run("Invalid URL", func(t *testing.T) {
+		assert.EqualError(t, HTTPURL("example.com"), "invalid url: example.com")
+	})
+}
@@ -0,0 +1,210 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/functions"
+	"go-runtimevalidation/regex"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ibanFormat describes the IBAN of one country: its total length and the structure of its
+// Basic Bank Account Number (BBAN), in the notation of the SWIFT IBAN registry where
+// "n" stands for digits, "a" for upper case letters and "c" for alphanumerics, e.g. "8n10n".
+type ibanFormat struct {
+	Length int
+	BBAN   string
+	regex  func() *regexp.Regexp
+}
+
+// ibanFormats is the per-country IBAN structure table, following the SWIFT IBAN registry.
+var ibanFormats = map[string]*ibanFormat{
+	"AD": {Length: 24, BBAN: "4n4n12c"},
+	"AE": {Length: 23, BBAN: "3n16n"},
+	"AL": {Length: 28, BBAN: "8n16c"},
+	"AT": {Length: 20, BBAN: "5n11n"},
+	"AZ": {Length: 28, BBAN: "4a20c"},
+	"BA": {Length: 20, BBAN: "3n3n8n2n"},
+	"BE": {Length: 16, BBAN: "3n7n2n"},
+	"BG": {Length: 22, BBAN: "4a4n2n8c"},
+	"BH": {Length: 22, BBAN: "4a14c"},
+	"BR": {Length: 29, BBAN: "8n5n10n1a1c"},
+	"BY": {Length: 28, BBAN: "4c4n16c"},
+	"CH": {Length: 21, BBAN: "5n12c"},
+	"CR": {Length: 22, BBAN: "4n14n"},
+	"CY": {Length: 28, BBAN: "3n5n16c"},
+	"CZ": {Length: 24, BBAN: "4n6n10n"},
+	"DE": {Length: 22, BBAN: "8n10n"},
+	"DK": {Length: 18, BBAN: "4n9n1n"},
+	"DO": {Length: 28, BBAN: "4c20n"},
+	"EE": {Length: 20, BBAN: "2n2n11n1n"},
+	"EG": {Length: 29, BBAN: "4n4n17n"},
+	"ES": {Length: 24, BBAN: "4n4n1n1n10n"},
+	"FI": {Length: 18, BBAN: "3n11n"},
+	"FO": {Length: 18, BBAN: "4n9n1n"},
+	"FR": {Length: 27, BBAN: "5n5n11c2n"},
+	"GB": {Length: 22, BBAN: "4a6n8n"},
+	"GE": {Length: 22, BBAN: "2a16n"},
+	"GI": {Length: 23, BBAN: "4a15c"},
+	"GL": {Length: 18, BBAN: "4n9n1n"},
+	"GR": {Length: 27, BBAN: "3n4n16c"},
+	"GT": {Length: 28, BBAN: "4c20c"},
+	"HR": {Length: 21, BBAN: "7n10n"},
+	"HU": {Length: 28, BBAN: "3n4n1n15n1n"},
+	"IE": {Length: 22, BBAN: "4a6n8n"},
+	"IL": {Length: 23, BBAN: "3n3n13n"},
+	"IQ": {Length: 23, BBAN: "4a3n12n"},
+	"IS": {Length: 26, BBAN: "4n2n6n10n"},
+	"IT": {Length: 27, BBAN: "1a5n5n12c"},
+	"JO": {Length: 30, BBAN: "4a4n18c"},
+	"KW": {Length: 30, BBAN: "4a22c"},
+	"KZ": {Length: 20, BBAN: "3n13c"},
+	"LB": {Length: 28, BBAN: "4n20c"},
+	"LC": {Length: 32, BBAN: "4a24c"},
+	"LI": {Length: 21, BBAN: "5n12c"},
+	"LT": {Length: 20, BBAN: "5n11n"},
+	"LU": {Length: 20, BBAN: "3n13c"},
+	"LV": {Length: 21, BBAN: "4a13c"},
+	"MC": {Length: 27, BBAN: "5n5n11c2n"},
+	"MD": {Length: 24, BBAN: "2c18c"},
+	"ME": {Length: 22, BBAN: "3n13n2n"},
+	"MK": {Length: 19, BBAN: "3n10c2n"},
+	"MR": {Length: 27, BBAN: "5n5n11n2n"},
+	"MT": {Length: 31, BBAN: "4a5n18c"},
+	"MU": {Length: 30, BBAN: "4a2n2n12n3n3a"},
+	"NL": {Length: 18, BBAN: "4a10n"},
+	"NO": {Length: 15, BBAN: "4n6n1n"},
+	"PK": {Length: 24, BBAN: "4a16c"},
+	"PL": {Length: 28, BBAN: "8n16n"},
+	"PS": {Length: 29, BBAN: "4a21c"},
+	"PT": {Length: 25, BBAN: "4n4n11n2n"},
+	"QA": {Length: 29, BBAN: "4a21c"},
+	"RO": {Length: 24, BBAN: "4a16c"},
+	"RS": {Length: 22, BBAN: "3n13n2n"},
+	"SA": {Length: 24, BBAN: "2n18c"},
+	"SC": {Length: 31, BBAN: "4a2n2n16n3a"},
+	"SE": {Length: 24, BBAN: "3n16n1n"},
+	"SI": {Length: 19, BBAN: "5n8n2n"},
+	"SK": {Length: 24, BBAN: "4n6n10n"},
+	"SM": {Length: 27, BBAN: "1a5n5n12c"},
+	"ST": {Length: 25, BBAN: "4n4n11n2n"},
+	"SV": {Length: 28, BBAN: "4a20n"},
+	"TL": {Length: 23, BBAN: "3n14n2n"},
+	"TN": {Length: 24, BBAN: "2n3n13n2n"},
+	"TR": {Length: 26, BBAN: "5n1n16c"},
+	"UA": {Length: 29, BBAN: "6n19c"},
+	"VA": {Length: 22, BBAN: "3n15n"},
+	"VG": {Length: 24, BBAN: "4a16n"},
+	"XK": {Length: 20, BBAN: "4n10n2n"},
+}
+
+// bbanClasses maps the character classes of the IBAN registry notation to regex classes.
+var bbanClasses = map[byte]string{
+	'n': "[0-9]",
+	'a': "[A-Z]",
+	'c': "[A-Z0-9]",
+}
+
+func init() {
+	// Compile each BBAN structure into a regex on first use
+	for _, format := range ibanFormats {
+		format.regex = regex.CompileOnce(bbanRegexString(format.BBAN))
+	}
+}
+
+// bbanRegexString converts a BBAN structure such as "4a6n8n" into a regex such as "^[A-Z]{4}[0-9]{6}[0-9]{8}$".
+func bbanRegexString(spec string) string {
+	var b strings.Builder
+	b.WriteString("^")
+	count := 0
+	for i := 0; i < len(spec); i++ {
+		c := spec[i]
+		if c >= '0' && c <= '9' {
+			count = count*10 + int(c-'0')
+			continue
+		}
+		b.WriteString(bbanClasses[c] + "{" + strconv.Itoa(count) + "}")
+		count = 0
+	}
+	b.WriteString("$")
+	return b.String()
+}
+
+// IBAN validates that the input is an International Bank Account Number.
+// The input may be in electronic form ("DE89370400440532013000") or printed in groups of four
+// ("DE89 3704 0044 0532 0130 00"), and is compared case-insensitively.
+//
+// The country code must be in the IBAN registry, the length and the BBAN structure must match the
+// country's format, and the ISO 7064 mod-97-10 check digits must be correct.
+//
+// Parameters:
+// - input: The value being validated, expected to be a string.
+//
+// Returns nil if the input is a valid IBAN, or an error if:
+// - The input is not a string
+// - The country is not known, or the length or structure do not match the country's format
+// - The check digits are wrong.
+//
+// Example:
+//
+//	err := IBAN("DE89 3704 0044 0532 0130 00") // err will be nil
+//	err := IBAN("DE89 3704 0044 0532 0130 01") // err will be: "invalid iban: DE89370400440532013001 fails checksum"
+func IBAN(input any) error {
+	_, err := normalizeIBAN(input)
+	return err
+}
+
+// normalizeIBAN validates the input as an IBAN and returns it in electronic form,
+// upper case and without spaces.
+func normalizeIBAN(input any) (string, error) {
+	// Check if the input is a string
+	value, err := functions.GetString(input)
+	if err != nil {
+		return "", fmt.Errorf("expected a string, got %T", input)
+	}
+
+	iban := strings.ToUpper(strings.ReplaceAll(value, " ", ""))
+	if len(iban) < 5 {
+		return "", fmt.Errorf("invalid iban: %s", value)
+	}
+
+	country := iban[:2]
+	format, ok := ibanFormats[country]
+	if !ok {
+		return "", fmt.Errorf("invalid iban: unknown country %s", country)
+	}
+
+	if len(iban) != format.Length {
+		return "", fmt.Errorf("invalid iban: %s must have %d characters for %s, got %d", iban, format.Length, country, len(iban))
+	}
+
+	if iban[2] < '0' || iban[2] > '9' || iban[3] < '0' || iban[3] > '9' {
+		return "", fmt.Errorf("invalid iban: %s has non-numeric check digits", iban)
+	}
+
+	if !format.regex().MatchString(iban[4:]) {
+		return "", fmt.Errorf("invalid iban: %s does not match the %s account format %s", iban, country, format.BBAN)
+	}
+
+	if mod97(iban[4:]+iban[:4]) != 1 {
+		return "", fmt.Errorf("invalid iban: %s fails checksum", iban)
+	}
+
+	return iban, nil
+}
+
+// mod97 computes the ISO 7064 mod-97-10 remainder of an alphanumeric string,
+// where letters are replaced by two digit numbers (A = 10, ..., Z = 35).
+func mod97(value string) int {
+	remainder := 0
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if c >= 'A' && c <= 'Z' {
+			remainder = (remainder*100 + int(c-'A') + 10) % 97
+		} else {
+			remainder = (remainder*10 + int(c-'0')) % 97
+		}
+	}
+	return remainder
+}
@@ -0,0 +1,100 @@
+package rules
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIBAN(t *testing.T) {
+	t.Run("Valid IBANs", func(t *testing.T) {
+		valid := []string{
+			"DE89370400440532013000",
+			"GB82WEST12345698765432",
+			"FR1420041010050500013M02606",
+			"NL91ABNA0417164300",
+			"BE68539007547034",
+			"CH9300762011623852957",
+			"NO9386011117947",
+			"MT84MALT011000012345MTLCAST001S",
+		}
+		for _, iban := range valid {
+			assert.NoError(t, IBAN(iban), iban)
+		}
+	})
+
+	t.Run("Valid IBAN (printed form)", func(t *testing.T) {
+		err := IBAN("DE89 3704 0044 0532 0130 00")
+		assert.NoError(t, err)
+	})
+
+	t.Run("Valid IBAN (lower case)", func(t *testing.T) {
+		err := IBAN("gb82 west 1234 5698 7654 32")
+		assert.NoError(t, err)
+	})
+
+	t.Run("Invalid IBAN (checksum)", func(t *testing.T) {
+		err := IBAN("DE89 3704 0044 0532 0130 01")
+		assert.Error(t, err)
+		assert.Equal(t, "invalid iban: DE89370400440532013001 fails checksum", err.Error())
+	})
+
+	t.Run("Invalid IBAN (length)", func(t *testing.T) {
+		err := IBAN("DE8937040044053201300")
+		assert.Error(t, err)
+		assert.Equal(t, "invalid iban: DE8937040044053201300 must have 22 characters for DE, got 21", err.Error())
+	})
+
+	t.Run("Invalid IBAN (account format)", func(t *testing.T) {
+		err := IBAN("DE89370400440532O13000")
+		assert.Error(t, err)
+		assert.Equal(t, "invalid iban: DE89370400440532O13000 does not match the DE account format 8n10n", err.Error())
+	})
+
+	t.Run("Invalid IBAN (unknown country)", func(t *testing.T) {
+		err := IBAN("US64SVBKUS6S3300958879")
+		assert.Error(t, err)
+		assert.Equal(t, "invalid iban: unknown country US", err.Error())
+	})
+
+	t.Run("Invalid IBAN (check digits)", func(t *testing.T) {
+		err := IBAN("DEXX370400440532013000")
+		assert.Error(t, err)
+		assert.Equal(t, "invalid iban: DEXX370400440532013000 has non-numeric check digits", err.Error())
+	})
+
+	t.Run("Invalid IBAN (too short)", func(t *testing.T) {
+		err := IBAN("DE8")
+		assert.Error(t, err)
+		assert.Equal(t, "invalid iban: DE8", err.Error())
+	})
+
+	t.Run("Invalid input type", func(t *testing.T) {
+		err := IBAN([]int{1})
+		assert.Error(t, err)
+		assert.Equal(t, "expected a string, got []int", err.Error())
+	})
+
+	t.Run("Format table is consistent", func(t *testing.T) {
+		spec := regexp.MustCompile(`^(?:[0-9]+[nac])+$`)
+		for country, format := range ibanFormats {
+			assert.Regexp(t, spec, format.BBAN, country)
+
+			length := 4
+			for _, m := range regexp.MustCompile(`([0-9]+)[nac]`).FindAllStringSubmatch(format.BBAN, -1) {
+				n := 0
+				for _, c := range m[1] {
+					n = n*10 + int(c-'0')
+				}
+				length += n
+			}
+			assert.Equal(t, format.Length, length, country)
+		}
+	})
+}
+
+func TestBBANRegexString(t *testing.T) {
+	assert.Equal(t, "^[A-Z]{4}[0-9]{6}[0-9]{8}$", bbanRegexString("4a6n8n"))
+	assert.Equal(t, "^[0-9]{5}[A-Z0-9]{12}$", bbanRegexString("5n12c"))
+}
@@ -0,0 +1,64 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/functions"
+	"sort"
+	"strings"
+)
+
+// IBANCountry validates that the input is a valid IBAN issued in one of the given countries.
+// It is expected to be used in validation rules such as `ibancountry:DE,FR` or `ibancountry:$Country`.
+// Country codes are ISO 3166-1 alpha-2 codes, compared case-insensitively.
+//
+// Parameters:
+// - input: The value being validated, expected to be a string.
+// - obj: The object containing additional data (can be used for Field references within the args).
+// - args: A map of arguments, each naming an accepted country.
+//
+// Returns nil if the input is valid, or an error if:
+// - No arguments are provided
+// - The input is not a valid IBAN (see IBAN)
+// - The country of the IBAN is not one of the accepted countries.
+//
+// Example:
+//
+//	input := "GB82 WEST 1234 5698 7654 32"
+//	obj := nil
+//	args := map[string]Arg{
+//	    "DE": {Value: "DE"},
+//	    "FR": {Value: "FR"},
+//	}
+//	err := IBANCountry(input, obj, args)  // err will be: "ibancountry validation failed: GB is not one of DE, FR"
+func IBANCountry(input any, obj any, arguments map[string]args.Arg) error {
+	if len(arguments) == 0 {
+		return fmt.Errorf("ibancountry expects atleast 1 argument, got %d", len(arguments))
+	}
+
+	iban, err := normalizeIBAN(input)
+	if err != nil {
+		return err
+	}
+
+	countries := make([]string, 0, len(arguments))
+	for _, v := range arguments {
+		eval, err := v.Evaluate(obj)
+		if err != nil {
+			return err
+		}
+
+		country, err := functions.GetString(eval)
+		if err != nil {
+			return fmt.Errorf("unsupported type for ibancountry argument: %w", err)
+		}
+
+		if strings.EqualFold(iban[:2], country) {
+			return nil
+		}
+		countries = append(countries, country)
+	}
+
+	sort.Strings(countries)
+	return fmt.Errorf("ibancountry validation failed: %s is not one of %s", iban[:2], strings.Join(countries, ", "))
+}
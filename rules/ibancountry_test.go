@@ -0,0 +1,59 @@
+package rules
+
+import (
+	"go-runtimevalidation/args"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIBANCountry(t *testing.T) {
+	arguments := map[string]args.Arg{
+		"DE": {Value: "DE"},
+		"FR": {Value: "FR"},
+	}
+
+	t.Run("Valid IBAN in accepted country", func(t *testing.T) {
+		err := IBANCountry("DE89 3704 0044 0532 0130 00", nil, arguments)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Country is compared case-insensitively", func(t *testing.T) {
+		err := IBANCountry("FR1420041010050500013M02606", nil, map[string]args.Arg{"fr": {Value: "fr"}})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Valid IBAN in other country", func(t *testing.T) {
+		err := IBANCountry("GB82 WEST 1234 5698 7654 32", nil, map[string]args.Arg{"DE": {Value: "DE"}})
+		assert.Error(t, err)
+		assert.Equal(t, "ibancountry validation failed: GB is not one of DE", err.Error())
+	})
+
+	t.Run("Other country lists the accepted countries in order", func(t *testing.T) {
+		err := IBANCountry("GB82 WEST 1234 5698 7654 32", nil, map[string]args.Arg{
+			"FR": {Value: "FR"},
+			"DE": {Value: "DE"},
+			"NL": {Value: "NL"},
+		})
+		assert.Error(t, err)
+		assert.Equal(t, "ibancountry validation failed: GB is not one of DE, FR, NL", err.Error())
+	})
+
+	t.Run("Invalid IBAN", func(t *testing.T) {
+		err := IBANCountry("DE89370400440532013001", nil, arguments)
+		assert.Error(t, err)
+		assert.Equal(t, "invalid iban: DE89370400440532013001 fails checksum", err.Error())
+	})
+
+	t.Run("Field reference", func(t *testing.T) {
+		obj := struct{ Country string }{Country: "GB"}
+		err := IBANCountry("GB82WEST12345698765432", obj, map[string]args.Arg{"$Country": {Type: args.FieldArg, Field: "Country"}})
+		assert.NoError(t, err)
+	})
+
+	t.Run("No arguments", func(t *testing.T) {
+		err := IBANCountry("DE89370400440532013000", nil, map[string]args.Arg{})
+		assert.Error(t, err)
+		assert.Equal(t, "ibancountry expects atleast 1 argument, got 0", err.Error())
+	})
+}
@@ -0,0 +1,105 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/functions"
+	"image"
+	_ "image/gif"  // Register the GIF decoder for image.DecodeConfig
+	_ "image/jpeg" // Register the JPEG decoder for image.DecodeConfig
+	_ "image/png"  // Register the PNG decoder for image.DecodeConfig
+	"io"
+)
+
+// imageDimsOptions are the options of the imagedims rule.
+var imageDimsOptions = args.Options{Rule: "imagedims", Values: []string{"width", "height", "minwidth", "maxwidth", "minheight", "maxheight"}}
+
+// ImageDims validates the width and height of a PNG, JPEG or GIF image, read from its header.
+// It is expected to be used in validation rules such as `imagedims:minwidth=200,minheight=200,maxwidth=4096,maxheight=4096`,
+// `imagedims:width=64,height=64` or `imagedims:maxwidth=$MaxWidth`.
+//
+// Only the image header is decoded, with image.DecodeConfig, so that large images can be validated cheaply.
+//
+// Parameters:
+//   - input (any): The image being validated, a byte slice, a string, an io.Reader or a *multipart.FileHeader.
+//     Readers that implement io.Seeker are rewound after reading, other readers are consumed.
+//   - obj (any): The object containing additional data (can be used for Field references within the args).
+//   - arguments: The bounds in pixels `width`, `height`, `minwidth`, `maxwidth`, `minheight` and `maxheight`,
+//     given as `name=N` with numbers or Field references.
+//
+// Returns nil if the input is valid, or an error if:
+//   - No bound is given or a bound is invalid
+//   - The input cannot be read or is not a PNG, JPEG or GIF image
+//   - The width or height is out of bounds.
+//
+// Example:
+//
+//	input := pngBytes  // a 100x50 PNG image
+//	obj := nil
+//	args := map[string]Arg{
+//	    "minheight=64": {Value: "minheight=64"},
+//	}
+//	err := ImageDims(input, obj, args)  // err will be: "imagedims validation failed: height 50 is less than 64 pixels"
+func ImageDims(input any, obj any, arguments map[string]args.Arg) error {
+	if len(arguments) == 0 {
+		return fmt.Errorf("imagedims expects at least one argument")
+	}
+
+	bounds := map[string]int64{}
+	for key, arg := range arguments {
+		option, err := imageDimsOptions.Parse(key, arg)
+		if err != nil {
+			return err
+		}
+
+		eval, err := option.Evaluate(obj)
+		if err != nil {
+			return err
+		}
+		pixels, err := functions.GetInt(eval)
+		if err != nil || pixels < 0 {
+			return fmt.Errorf("imagedims: invalid value for %s: %s", option.Name, option.Text)
+		}
+		bounds[option.Name] = pixels
+	}
+
+	config, err := decodeImageConfig(input)
+	if err != nil {
+		return err
+	}
+
+	for _, dim := range []struct {
+		name string
+		size int64
+	}{{"width", int64(config.Width)}, {"height", int64(config.Height)}} {
+		if exact, ok := bounds[dim.name]; ok && dim.size != exact {
+			return fmt.Errorf("imagedims validation failed: %s %d is not %d pixels", dim.name, dim.size, exact)
+		}
+		if minimum, ok := bounds["min"+dim.name]; ok && dim.size < minimum {
+			return fmt.Errorf("imagedims validation failed: %s %d is less than %d pixels", dim.name, dim.size, minimum)
+		}
+		if maximum, ok := bounds["max"+dim.name]; ok && dim.size > maximum {
+			return fmt.Errorf("imagedims validation failed: %s %d is more than %d pixels", dim.name, dim.size, maximum)
+		}
+	}
+
+	return nil
+}
+
+// decodeImageConfig decodes the header of a PNG, JPEG or GIF image, see withContent for the supported inputs.
+func decodeImageConfig(input any) (image.Config, error) {
+	var config image.Config
+	err := withContent(input, func(r io.Reader) error {
+		var err error
+		config, _, err = image.DecodeConfig(r)
+		if errors.Is(err, image.ErrFormat) {
+			return fmt.Errorf("invalid image: unsupported format, expected PNG, JPEG or GIF")
+		}
+		if err != nil {
+			return fmt.Errorf("invalid image: %w", err)
+		}
+		return nil
+	})
+	return config, err
+}
@@ -0,0 +1,93 @@
+package rules
+
+import (
+	"bytes"
+	"go-runtimevalidation/args"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// encodeImage returns a blank image of the given format and dimensions.
+func encodeImage(t *testing.T, format string, width, height int) []byte {
+	t.Helper()
+	img := image.NewGray(image.Rect(0, 0, width, height))
+	var buf bytes.Buffer
+	var err error
+	switch format {
+	case "png":
+		err = png.Encode(&buf, img)
+	case "jpeg":
+		err = jpeg.Encode(&buf, img, nil)
+	case "gif":
+		err = gif.Encode(&buf, img, nil)
+	}
+	assert.NoError(t, err)
+	return buf.Bytes()
+}
+
+func TestImageDims(t *testing.T) {
+	options := func(values ...string) map[string]args.Arg {
+		arguments := map[string]args.Arg{}
+		for _, v := range values {
+			arguments[v] = args.Arg{Value: v}
+		}
+		return arguments
+	}
+
+	t.Run("Within bounds", func(t *testing.T) {
+		for _, format := range []string{"png", "jpeg", "gif"} {
+			img := encodeImage(t, format, 100, 50)
+			assert.NoError(t, ImageDims(img, nil, options("minwidth=100", "maxwidth=200", "minheight=10", "maxheight=50")), format)
+			assert.NoError(t, ImageDims(bytes.NewReader(img), nil, options("width=100", "height=50")), format)
+		}
+	})
+
+	t.Run("Out of bounds", func(t *testing.T) {
+		img := encodeImage(t, "png", 100, 50)
+
+		err := ImageDims(img, nil, options("minheight=64"))
+		assert.EqualError(t, err, "imagedims validation failed: height 50 is less than 64 pixels")
+
+		err = ImageDims(img, nil, options("maxwidth=64"))
+		assert.EqualError(t, err, "imagedims validation failed: width 100 is more than 64 pixels")
+
+		err = ImageDims(img, nil, options("width=64", "height=64"))
+		assert.EqualError(t, err, "imagedims validation failed: width 100 is not 64 pixels")
+	})
+
+	t.Run("Bounds from fields", func(t *testing.T) {
+		limits := struct{ MaxWidth int }{MaxWidth: 80}
+		err := ImageDims(encodeImage(t, "gif", 100, 50), limits, options("maxwidth=$MaxWidth"))
+		assert.EqualError(t, err, "imagedims validation failed: width 100 is more than 80 pixels")
+	})
+
+	t.Run("Reader is rewound", func(t *testing.T) {
+		reader := bytes.NewReader(encodeImage(t, "png", 100, 50))
+		assert.NoError(t, ImageDims(reader, nil, options("width=100")))
+		assert.NoError(t, ImageDims(reader, nil, options("height=50")))
+	})
+
+	t.Run("Invalid images", func(t *testing.T) {
+		err := ImageDims([]byte("RIFF\x00\x00\x00\x00WEBPVP8 "), nil, options("width=1"))
+		assert.EqualError(t, err, "invalid image: unsupported format, expected PNG, JPEG or GIF")
+
+		err = ImageDims(encodeImage(t, "png", 10, 10)[:20], nil, options("width=1"))
+		assert.EqualError(t, err, "invalid image: unexpected EOF")
+
+		err = ImageDims(42, nil, options("width=1"))
+		assert.EqualError(t, err, "expected a byte slice, io.Reader or *multipart.FileHeader, got int")
+	})
+
+	t.Run("Invalid arguments", func(t *testing.T) {
+		img := encodeImage(t, "png", 1, 1)
+		assert.EqualError(t, ImageDims(img, nil, nil), "imagedims expects at least one argument")
+		assert.EqualError(t, ImageDims(img, nil, options("depth=8")), "imagedims: unknown option depth=8")
+		assert.EqualError(t, ImageDims(img, nil, options("width")), "imagedims: missing value for width")
+		assert.EqualError(t, ImageDims(img, nil, options("width=wide")), "imagedims: invalid value for width: wide")
+	})
+}
@@ -0,0 +1,126 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/functions"
+	"go-runtimevalidation/geo"
+	"reflect"
+)
+
+// InBBox validates that coordinates are inside a bounding box, edges included.
+// It is expected to be used in validation rules such as `inbbox:minlat=47.27,maxlat=55.06,minlng=5.87,maxlng=15.04`,
+// `inbbox:[5.87,47.27,15.04,55.06]` or `inbbox:$Region`.
+//
+// The box is given either with the four options `minlat`, `maxlat`, `minlng` and `maxlng`, as numbers or Field references,
+// or as a single value in the order of a GeoJSON bbox, [west, south, east, north]. A box whose west edge is greater than
+// its east edge crosses the antimeridian, so that `minlng=170,maxlng=-170` contains the longitudes 175 and -175.
+//
+// Parameters:
+// - input: The coordinates being validated, see LatLng for the supported types.
+// - obj: The object containing additional data (can be used for Field references within the args).
+// - args: The four options, or a single array, Field or function reference to four numbers or a geo.BBox.
+//
+// Returns nil if the input is valid, or an error if:
+// - The box is missing, incomplete or invalid
+// - The input is not valid coordinates
+// - The input is outside the box.
+//
+// Example:
+//
+//	input := "48.8566,2.3522"
+//	obj := nil
+//	args := map[string]Arg{
+//	    "[5.87,47.27,15.04,55.06]": {Value: []any{5.87, 47.27, 15.04, 55.06}},
+//	}
+//	err := InBBox(input, obj, args)  // err will be: "inbbox validation failed: 48.8566,2.3522 is outside the bounding box 47.27,5.87 to 55.06,15.04"
+func InBBox(input any, obj any, arguments map[string]args.Arg) error {
+	box, err := parseBBox(obj, arguments)
+	if err != nil {
+		return err
+	}
+
+	point, err := getPoint(input)
+	if err != nil {
+		return err
+	}
+
+	if !box.Contains(point) {
+		return fmt.Errorf("inbbox validation failed: %s is outside the bounding box %s", point, box)
+	}
+
+	return nil
+}
+
+// bboxOptions are the options of the inbbox rule.
+var bboxOptions = args.Options{Rule: "inbbox", Values: []string{"minlat", "maxlat", "minlng", "maxlng"}}
+
+// parseBBox returns the bounding box given by the arguments of InBBox.
+func parseBBox(obj any, arguments map[string]args.Arg) (geo.BBox, error) {
+	if len(arguments) == 1 {
+		for key, arg := range arguments {
+			if bboxOptions.IsOption(arg) {
+				break
+			}
+
+			eval, err := arg.Evaluate(obj)
+			if err != nil {
+				return geo.BBox{}, err
+			}
+			if box, ok := eval.(geo.BBox); ok {
+				if _, err := geo.NewBBox(box.West, box.South, box.East, box.North); err != nil {
+					return geo.BBox{}, fmt.Errorf("inbbox: invalid bounding box %s: %w", key, err)
+				}
+				return box, nil
+			}
+
+			v := reflect.ValueOf(eval)
+			if (v.Kind() != reflect.Array && v.Kind() != reflect.Slice) || v.Len() != 4 {
+				return geo.BBox{}, fmt.Errorf("inbbox: expected [west, south, east, north], got %s", key)
+			}
+			var edges [4]float64
+			for i := range edges {
+				edge, err := functions.GetFloat(v.Index(i).Interface())
+				if err != nil {
+					return geo.BBox{}, fmt.Errorf("inbbox: invalid bounding box %s: %w", key, err)
+				}
+				edges[i] = edge
+			}
+			box, err := geo.NewBBox(edges[0], edges[1], edges[2], edges[3])
+			if err != nil {
+				return geo.BBox{}, fmt.Errorf("inbbox: invalid bounding box %s: %w", key, err)
+			}
+			return box, nil
+		}
+	}
+
+	edges := map[string]float64{}
+	for key, arg := range arguments {
+		option, err := bboxOptions.Parse(key, arg)
+		if err != nil {
+			return geo.BBox{}, err
+		}
+
+		eval, err := option.Evaluate(obj)
+		if err != nil {
+			return geo.BBox{}, err
+		}
+		edge, err := functions.GetFloat(eval)
+		if err != nil {
+			return geo.BBox{}, fmt.Errorf("inbbox: invalid value for %s: %s", option.Name, option.Text)
+		}
+		edges[option.Name] = edge
+	}
+
+	for _, name := range []string{"minlat", "maxlat", "minlng", "maxlng"} {
+		if _, ok := edges[name]; !ok {
+			return geo.BBox{}, fmt.Errorf("inbbox: missing option %s", name)
+		}
+	}
+
+	box, err := geo.NewBBox(edges["minlng"], edges["minlat"], edges["maxlng"], edges["maxlat"])
+	if err != nil {
+		return geo.BBox{}, fmt.Errorf("inbbox: invalid bounding box: %w", err)
+	}
+	return box, nil
+}
@@ -0,0 +1,79 @@
+package rules
+
+import (
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/geo"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInBBox(t *testing.T) {
+	germany := map[string]args.Arg{
+		"minlat=47.27": {Value: "minlat=47.27"},
+		"maxlat=55.06": {Value: "maxlat=55.06"},
+		"minlng=5.87":  {Value: "minlng=5.87"},
+		"maxlng=15.04": {Value: "maxlng=15.04"},
+	}
+
+	t.Run("Options", func(t *testing.T) {
+		assert.NoError(t, InBBox("52.52,13.405", nil, germany))
+		assert.NoError(t, InBBox([2]float64{47.27, 5.87}, nil, germany))
+
+		err := InBBox("48.8566,2.3522", nil, germany)
+		assert.EqualError(t, err, "inbbox validation failed: 48.8566,2.3522 is outside the bounding box 47.27,5.87 to 55.06,15.04")
+	})
+
+	t.Run("GeoJSON bbox", func(t *testing.T) {
+		arguments := map[string]args.Arg{"[5.87,47.27,15.04,55.06]": {Value: []any{5.87, 47.27, 15.04, 55.06}}}
+		assert.NoError(t, InBBox("52.52,13.405", nil, arguments))
+		assert.Error(t, InBBox("48.8566,2.3522", nil, arguments))
+	})
+
+	t.Run("Field references", func(t *testing.T) {
+		obj := struct {
+			Region geo.BBox
+			Box    []float64
+			South  float64
+		}{Region: geo.BBox{West: 5.87, South: 47.27, East: 15.04, North: 55.06}, Box: []float64{-10, -10, 10, 10}, South: 47.27}
+
+		assert.NoError(t, InBBox("52.52,13.405", obj, map[string]args.Arg{"$Region": {Type: args.FieldArg, Field: "Region"}}))
+		assert.NoError(t, InBBox("0,0", obj, map[string]args.Arg{"$Box": {Type: args.FieldArg, Field: "Box"}}))
+
+		arguments := map[string]args.Arg{
+			"minlat=$South": {Value: "minlat=$South"},
+			"maxlat=55.06":  {Value: "maxlat=55.06"},
+			"minlng=5.87":   {Value: "minlng=5.87"},
+			"maxlng=15.04":  {Value: "maxlng=15.04"},
+		}
+		assert.NoError(t, InBBox("52.52,13.405", obj, arguments))
+	})
+
+	t.Run("Antimeridian", func(t *testing.T) {
+		arguments := map[string]args.Arg{"[177,-21,-178,-12]": {Value: []any{177, -21, -178, -12}}}
+		assert.NoError(t, InBBox("-18,179", nil, arguments))
+		assert.NoError(t, InBBox("-18,-179", nil, arguments))
+		assert.Error(t, InBBox("-18,0", nil, arguments))
+	})
+
+	t.Run("Invalid arguments", func(t *testing.T) {
+		err := InBBox("0,0", nil, map[string]args.Arg{"minlat=1": {Value: "minlat=1"}})
+		assert.EqualError(t, err, "inbbox: missing option maxlat")
+
+		err = InBBox("0,0", nil, map[string]args.Arg{"[1,2,3]": {Value: []any{1, 2, 3}}})
+		assert.EqualError(t, err, "inbbox: expected [west, south, east, north], got [1,2,3]")
+
+		err = InBBox("0,0", nil, map[string]args.Arg{"[0,10,1,5]": {Value: []any{0, 10, 1, 5}}})
+		assert.EqualError(t, err, "inbbox: invalid bounding box [0,10,1,5]: south 10 is greater than north 5")
+
+		err = InBBox("0,0", nil, map[string]args.Arg{"minlat=1": {Value: "minlat=1"}, "top=2": {Value: "top=2"}})
+		assert.EqualError(t, err, "inbbox: unknown option top=2")
+
+		err = InBBox("0,0", nil, map[string]args.Arg{"minlat=x": {Value: "minlat=x"}, "maxlat=2": {Value: "maxlat=2"}})
+		assert.EqualError(t, err, "inbbox: invalid value for minlat: x")
+	})
+
+	t.Run("Invalid input", func(t *testing.T) {
+		assert.Error(t, InBBox("north", nil, germany))
+	})
+}
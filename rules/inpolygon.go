@@ -0,0 +1,95 @@
+package rules
+
+import (
+	"encoding/json"
+	"fmt"
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/geo"
+)
+
+// InPolygon validates that coordinates are inside a GeoJSON polygon, such as a delivery zone, boundary included.
+// It is expected to be used in validation rules such as `inpolygon:$DeliveryZone` or
+// `inpolygon:{"type":"Polygon","coordinates":[[[13.3,52.4],[13.5,52.4],[13.5,52.6],[13.3,52.6],[13.3,52.4]]]}`.
+//
+// The argument is a GeoJSON Polygon or MultiPolygon, or a Feature or FeatureCollection of them, whose positions are
+// [longitude, latitude] as required by RFC 7946. Points inside a hole of a polygon are outside the polygon.
+//
+// Parameters:
+// - input: The coordinates being validated, see LatLng for the supported types.
+// - obj: The object containing additional data (can be used for Field references within the args).
+// - args: A map containing exactly one argument, the GeoJSON as a map, a string, a byte slice or json.RawMessage,
+// or a geo.Polygon or geo.Area.
+//
+// Returns nil if the input is valid, or an error if:
+// - The number of arguments is not equal to 1
+// - The argument is not a valid GeoJSON polygon
+// - The input is not valid coordinates
+// - The input is outside the polygon.
+//
+// Example:
+//
+//	input := "52.52,13.405"
+//	obj := struct{ Zone string }{Zone: `{"type":"Polygon","coordinates":[[[0,0],[10,0],[10,10],[0,10],[0,0]]]}`}
+//	args := map[string]Arg{
+//	    "$Zone": {Type: FieldArg, Field: "Zone"},
+//	}
+//	err := InPolygon(input, obj, args)  // err will be: "inpolygon validation failed: 52.52,13.405 is outside the polygon"
+func InPolygon(input any, obj any, arguments map[string]args.Arg) error {
+	if len(arguments) != 1 {
+		return fmt.Errorf("inpolygon expects exactly 1 argument, got %d", len(arguments))
+	}
+
+	// Get the first (and only) argument
+	var arg args.Arg
+	for _, v := range arguments {
+		arg = v
+		break
+	}
+
+	// Evaluate the argument
+	eval, err := arg.Evaluate(obj)
+	if err != nil {
+		return err
+	}
+
+	area, err := getArea(eval)
+	if err != nil {
+		return fmt.Errorf("inpolygon: %w", err)
+	}
+
+	point, err := getPoint(input)
+	if err != nil {
+		return err
+	}
+
+	if !area.Contains(point) {
+		return fmt.Errorf("inpolygon validation failed: %s is outside the polygon", point)
+	}
+
+	return nil
+}
+
+// getArea converts a GeoJSON value into a geo.Area.
+func getArea(value any) (geo.Area, error) {
+	switch v := value.(type) {
+	case geo.Area:
+		return v, nil
+	case geo.Polygon:
+		return geo.Area{v}, nil
+	case string:
+		return geo.ParseArea([]byte(v))
+	case []byte:
+		return geo.ParseArea(v)
+	case json.RawMessage:
+		return geo.ParseArea(v)
+	case map[string]any:
+		// Inline GeoJSON is parsed into a map by the arguments parser, so encode it back
+		data, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid GeoJSON: %w", err)
+		}
+		return geo.ParseArea(data)
+	default:
+		return nil, fmt.Errorf("expected GeoJSON, got %T", value)
+	}
+}
@@ -0,0 +1,65 @@
+package rules
+
+import (
+	"encoding/json"
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/geo"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInPolygon(t *testing.T) {
+	// A rough polygon around central Berlin, positions are [longitude, latitude]
+	berlin := `{"type":"Polygon","coordinates":[[[13.3,52.45],[13.5,52.45],[13.5,52.58],[13.3,52.58],[13.3,52.45]]]}`
+
+	t.Run("Field reference to GeoJSON", func(t *testing.T) {
+		obj := struct {
+			Zone    string
+			RawZone json.RawMessage
+		}{Zone: berlin, RawZone: json.RawMessage(berlin)}
+
+		arguments := map[string]args.Arg{"$Zone": {Type: args.FieldArg, Field: "Zone"}}
+		assert.NoError(t, InPolygon("52.52,13.405", obj, arguments))
+
+		err := InPolygon("48.8566,2.3522", obj, arguments)
+		assert.EqualError(t, err, "inpolygon validation failed: 48.8566,2.3522 is outside the polygon")
+
+		assert.NoError(t, InPolygon(struct{ Lat, Lng float64 }{52.52, 13.405}, obj, map[string]args.Arg{"$RawZone": {Type: args.FieldArg, Field: "RawZone"}}))
+	})
+
+	t.Run("Inline GeoJSON", func(t *testing.T) {
+		arguments, err := args.ParseArgs(berlin)
+		assert.NoError(t, err)
+		assert.NoError(t, InPolygon("52.52,13.405", nil, arguments))
+		assert.Error(t, InPolygon("52.6,13.405", nil, arguments))
+	})
+
+	t.Run("Parsed areas", func(t *testing.T) {
+		area, err := geo.ParseArea([]byte(berlin))
+		assert.NoError(t, err)
+
+		obj := struct {
+			Area    geo.Area
+			Polygon geo.Polygon
+		}{Area: area, Polygon: area[0]}
+		assert.NoError(t, InPolygon("52.52,13.405", obj, map[string]args.Arg{"$Area": {Type: args.FieldArg, Field: "Area"}}))
+		assert.NoError(t, InPolygon("52.52,13.405", obj, map[string]args.Arg{"$Polygon": {Type: args.FieldArg, Field: "Polygon"}}))
+	})
+
+	t.Run("Invalid arguments", func(t *testing.T) {
+		err := InPolygon("0,0", nil, map[string]args.Arg{})
+		assert.EqualError(t, err, "inpolygon expects exactly 1 argument, got 0")
+
+		err = InPolygon("0,0", nil, map[string]args.Arg{"1": {Value: 1}})
+		assert.EqualError(t, err, "inpolygon: expected GeoJSON, got int")
+
+		err = InPolygon("0,0", struct{ Zone string }{Zone: `{"type":"Point","coordinates":[0,0]}`}, map[string]args.Arg{"$Zone": {Type: args.FieldArg, Field: "Zone"}})
+		assert.EqualError(t, err, `inpolygon: unsupported GeoJSON type "Point", expected Polygon, MultiPolygon, Feature or FeatureCollection`)
+	})
+
+	t.Run("Invalid input", func(t *testing.T) {
+		obj := struct{ Zone string }{Zone: berlin}
+		assert.Error(t, InPolygon("91,0", obj, map[string]args.Arg{"$Zone": {Type: args.FieldArg, Field: "Zone"}}))
+	})
+}
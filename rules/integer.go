@@ -0,0 +1,33 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/functions"
+)
+
+// Integer validates that the input is a whole number, i.e. that a float or decimal string has no fractional part.
+// Integer kinds and *big.Int values are always valid, so that 3, 3.0 and "3.00" are integers while 3.5 is not.
+//
+// Parameters:
+// - input: The value being validated. It can be an integer, a float, a *big.Int, *big.Float or *big.Rat, or a decimal string.
+//
+// Returns an error if:
+// - The input is not a finite number
+// - The input has a fractional part.
+//
+// Example:
+//
+//	err := Integer(42.0)  // err will be nil
+//	err := Integer("2.5")  // err will be: "integer validation failed: 2.5 is not an integer"
+func Integer(input any) error {
+	value, err := functions.GetNumber(input)
+	if err != nil {
+		return fmt.Errorf("unsupported type for input field: %w", err)
+	}
+
+	if !value.IsInt() {
+		return fmt.Errorf("integer validation failed: %s is not an integer", functions.FormatNumber(value))
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package rules
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInteger(t *testing.T) {
+	// Test case 1: Integer
+	t.Run("Integer", func(t *testing.T) {
+		err := Integer(42)
+		assert.NoError(t, err)
+	})
+
+	// Test case 2: Unsigned integer above math.MaxInt64
+	t.Run("Large unsigned integer", func(t *testing.T) {
+		err := Integer(uint64(math.MaxUint64))
+		assert.NoError(t, err)
+	})
+
+	// Test case 3: Whole float
+	t.Run("Whole float", func(t *testing.T) {
+		err := Integer(3.0)
+		assert.NoError(t, err)
+	})
+
+	// Test case 4: Large negative whole float
+	t.Run("Large negative float", func(t *testing.T) {
+		err := Integer(-1e20)
+		assert.NoError(t, err)
+	})
+
+	// Test case 5: Decimal string with zero decimals
+	t.Run("Whole decimal string", func(t *testing.T) {
+		err := Integer("3.00")
+		assert.NoError(t, err)
+	})
+
+	// Test case 6: Whole big.Rat
+	t.Run("Whole big.Rat", func(t *testing.T) {
+		err := Integer(big.NewRat(10, 5))
+		assert.NoError(t, err)
+	})
+
+	// Test case 7: Fractional float
+	t.Run("Fractional float", func(t *testing.T) {
+		err := Integer(2.5)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "integer validation failed: 2.5 is not an integer")
+	})
+
+	// Test case 8: Fractional decimal string
+	t.Run("Fractional decimal string", func(t *testing.T) {
+		err := Integer("-0.1")
+		assert.Error(t, err)
+		assert.EqualError(t, err, "integer validation failed: -0.1 is not an integer")
+	})
+
+	// Test case 9: Fraction without a finite decimal representation
+	t.Run("Fractional big.Rat", func(t *testing.T) {
+		err := Integer(big.NewRat(1, 3))
+		assert.Error(t, err)
+		assert.EqualError(t, err, "integer validation failed: 1/3 is not an integer")
+	})
+
+	// Test case 10: Infinity is not a finite number
+	t.Run("Infinity", func(t *testing.T) {
+		err := Integer(math.Inf(1))
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unsupported type for input field: +Inf is not a finite number")
+	})
+
+	// Test case 11: Input is not a number
+	t.Run("Non-numeric string", func(t *testing.T) {
+		err := Integer("three")
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unsupported type for input field: failed to parse \"three\" of type string as a number")
+	})
+}
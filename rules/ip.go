@@ -0,0 +1,57 @@
+package rules
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+)
+
+// IP validates that the input is a valid IPv4 or IPv6 address.
+// The check is purely syntactic, no network access is performed.
+//
+// Parameters:
+// - input: The value being validated. It can be a string, a netip.Addr or a net.IP.
+//
+// Returns nil if the input is a valid IP address, or an error if:
+// - The input is not a string, netip.Addr or net.IP
+// - The input is not a valid IP address, or carries an IPv6 zone (e.g. "fe80::1%eth0").
+//
+// Example:
+//
+//	err := IP("192.168.1.1") // err will be nil
+//	err := IP("2001:db8::1") // err will be nil
+//	err := IP("256.1.1.1")   // err will be: "invalid ip: 256.1.1.1"
+func IP(input any) error {
+	_, err := getAddr(input)
+	return err
+}
+
+// getAddr converts the input into a netip.Addr.
+// Strings are parsed with netip.ParseAddr, which rejects IPv4 octets with leading zeros.
+func getAddr(input any) (netip.Addr, error) {
+	switch v := input.(type) {
+	case string:
+		addr, err := netip.ParseAddr(v)
+		if err != nil || addr.Zone() != "" {
+			return netip.Addr{}, fmt.Errorf("invalid ip: %s", v)
+		}
+		return addr, nil
+	case netip.Addr:
+		if !v.IsValid() || v.Zone() != "" {
+			return netip.Addr{}, fmt.Errorf("invalid ip: %s", v)
+		}
+		return v, nil
+	case net.IP:
+		addr, ok := netip.AddrFromSlice(v)
+		if !ok {
+			return netip.Addr{}, fmt.Errorf("invalid ip: %s", v)
+		}
+		// net.IP stores IPv4 addresses in their 16 byte form
+		if v.To4() != nil {
+			addr = addr.Unmap()
+		}
+		return addr, nil
+	default:
+		return netip.Addr{}, fmt.Errorf("expected a string or ip address, got %T", input)
+	}
+}
@@ -0,0 +1,51 @@
+package rules
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIP(t *testing.T) {
+	t.Run("Valid IPv4", func(t *testing.T) {
+		assert.NoError(t, IP("192.168.1.1"))
+	})
+
+	t.Run("Valid IPv6", func(t *testing.T) {
+		assert.NoError(t, IP("2001:db8::1"))
+	})
+
+	t.Run("Valid netip.Addr", func(t *testing.T) {
+		assert.NoError(t, IP(netip.MustParseAddr("10.0.0.1")))
+	})
+
+	t.Run("Valid net.IP", func(t *testing.T) {
+		assert.NoError(t, IP(net.ParseIP("10.0.0.1")))
+	})
+
+	t.Run("Octet out of range", func(t *testing.T) {
+		assert.EqualError(t, IP("256.1.1.1"), "invalid ip: 256.1.1.1")
+	})
+
+	t.Run("Leading zeros", func(t *testing.T) {
+		assert.EqualError(t, IP("010.0.0.1"), "invalid ip: 010.0.0.1")
+	})
+
+	t.Run("IPv6 zone", func(t *testing.T) {
+		assert.EqualError(t, IP("fe80::1%eth0"), "invalid ip: fe80::1%eth0")
+	})
+
+	t.Run("Zero netip.Addr", func(t *testing.T) {
+		assert.Error(t, IP(netip.Addr{}))
+	})
+
+	t.Run("Invalid net.IP length", func(t *testing.T) {
+		assert.Error(t, IP(net.IP{1, 2, 3}))
+	})
+
+	t.Run("Invalid input type", func(t *testing.T) {
+		assert.EqualError(t, IP(12345), "expected a string or ip address, got int")
+	})
+}
@@ -0,0 +1,62 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/args"
+	"sort"
+	"strings"
+)
+
+// IPIn validates that the input is an IP address contained in at least one of the given networks.
+// It is expected to be used in validation rules such as `ipin:10.0.0.0/8,192.168.0.0/16` or `ipin:$AllowedNetwork`.
+//
+// Parameters:
+// - input: The value being validated. It can be a string, a netip.Addr or a net.IP.
+// - obj: The object containing additional data (can be used for Field references within the args).
+// - args: A map of arguments, each of which must evaluate to a CIDR string, a netip.Prefix or a *net.IPNet.
+//
+// Returns nil if the input is valid, or an error if:
+// - No arguments are provided
+// - The input is not a valid IP address
+// - Any argument is not a valid CIDR
+// - The input is not contained in any of the networks.
+//
+// Example:
+//
+//	input := "10.1.2.3"
+//	obj := nil
+//	args := map[string]Arg{
+//	    "10.0.0.0/8": {Value: "10.0.0.0/8"},
+//	}
+//	err := IPIn(input, obj, args)  // err will be nil
+func IPIn(input any, obj any, arguments map[string]args.Arg) error {
+	if len(arguments) == 0 {
+		return fmt.Errorf("ipin expects atleast 1 argument, got %d", len(arguments))
+	}
+
+	addr, err := getAddr(input)
+	if err != nil {
+		return err
+	}
+
+	networks := make([]string, 0, len(arguments))
+	for _, v := range arguments {
+		eval, err := v.Evaluate(obj)
+		if err != nil {
+			return err
+		}
+
+		prefix, err := getPrefix(eval)
+		if err != nil {
+			return fmt.Errorf("unsupported type for ipin argument: %w", err)
+		}
+
+		if prefix.Contains(addr) {
+			return nil
+		}
+		networks = append(networks, prefix.String())
+	}
+
+	sort.Strings(networks)
+	return fmt.Errorf("ipin validation failed: %s is not in %s", addr, strings.Join(networks, ", "))
+}
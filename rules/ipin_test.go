@@ -0,0 +1,62 @@
+package rules
+
+import (
+	"go-runtimevalidation/args"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIPIn(t *testing.T) {
+	private := map[string]args.Arg{
+		"10.0.0.0/8":     {Value: "10.0.0.0/8"},
+		"192.168.0.0/16": {Value: "192.168.0.0/16"},
+	}
+
+	t.Run("Address in first network", func(t *testing.T) {
+		assert.NoError(t, IPIn("10.1.2.3", nil, private))
+	})
+
+	t.Run("Address in second network", func(t *testing.T) {
+		assert.NoError(t, IPIn(netip.MustParseAddr("192.168.1.1"), nil, private))
+	})
+
+	t.Run("Address outside networks", func(t *testing.T) {
+		err := IPIn("8.8.8.8", nil, private)
+		assert.EqualError(t, err, "ipin validation failed: 8.8.8.8 is not in 10.0.0.0/8, 192.168.0.0/16")
+	})
+
+	t.Run("IPv6 network", func(t *testing.T) {
+		err := IPIn("fd00::1", nil, map[string]args.Arg{
+			"fd00::/8": {Value: "fd00::/8"},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Network from field", func(t *testing.T) {
+		obj := struct {
+			Network netip.Prefix
+		}{Network: netip.MustParsePrefix("172.16.0.0/12")}
+
+		err := IPIn("172.20.0.1", obj, map[string]args.Arg{
+			"$Network": {Type: args.FieldArg, Field: "Network"},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Invalid network argument", func(t *testing.T) {
+		err := IPIn("10.0.0.1", nil, map[string]args.Arg{
+			"10.0.0.0": {Value: "10.0.0.0"},
+		})
+		assert.EqualError(t, err, "unsupported type for ipin argument: invalid cidr: 10.0.0.0")
+	})
+
+	t.Run("Invalid address", func(t *testing.T) {
+		assert.EqualError(t, IPIn("10.0.0", nil, private), "invalid ip: 10.0.0")
+	})
+
+	t.Run("No arguments", func(t *testing.T) {
+		assert.EqualError(t, IPIn("10.0.0.1", nil, map[string]args.Arg{}), "ipin expects atleast 1 argument, got 0")
+	})
+}
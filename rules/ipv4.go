@@ -0,0 +1,32 @@
+package rules
+
+import (
+	"fmt"
+)
+
+// IPv4 validates that the input is a valid IPv4 address in dotted decimal notation.
+// IPv4-mapped IPv6 addresses such as "::ffff:192.0.2.1" are not accepted.
+//
+// Parameters:
+// - input: The value being validated. It can be a string, a netip.Addr or a net.IP.
+//
+// Returns nil if the input is a valid IPv4 address, or an error if:
+// - The input is not a string, netip.Addr or net.IP
+// - The input is not a valid IPv4 address.
+//
+// Example:
+//
+//	err := IPv4("10.0.0.1")    // err will be nil
+//	err := IPv4("2001:db8::1") // err will be: "invalid ipv4: 2001:db8::1"
+func IPv4(input any) error {
+	addr, err := getAddr(input)
+	if err != nil {
+		return err
+	}
+
+	if !addr.Is4() {
+		return fmt.Errorf("invalid ipv4: %s", addr)
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package rules
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIPv4(t *testing.T) {
+	t.Run("Valid IPv4", func(t *testing.T) {
+		assert.NoError(t, IPv4("10.0.0.1"))
+	})
+
+	t.Run("Valid net.IP in 16 byte form", func(t *testing.T) {
+		assert.NoError(t, IPv4(net.ParseIP("10.0.0.1")))
+	})
+
+	t.Run("IPv6 address", func(t *testing.T) {
+		assert.EqualError(t, IPv4("2001:db8::1"), "invalid ipv4: 2001:db8::1")
+	})
+
+	t.Run("IPv4-mapped IPv6 address", func(t *testing.T) {
+		assert.Error(t, IPv4("::ffff:192.0.2.1"))
+	})
+
+	t.Run("Incomplete address", func(t *testing.T) {
+		assert.EqualError(t, IPv4("10.0.0"), "invalid ip: 10.0.0")
+	})
+}
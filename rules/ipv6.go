@@ -0,0 +1,31 @@
+package rules
+
+import (
+	"fmt"
+)
+
+// IPv6 validates that the input is a valid IPv6 address, including IPv4-mapped forms such as "::ffff:192.0.2.1".
+//
+// Parameters:
+// - input: The value being validated. It can be a string, a netip.Addr or a net.IP.
+//
+// Returns nil if the input is a valid IPv6 address, or an error if:
+// - The input is not a string, netip.Addr or net.IP
+// - The input is not a valid IPv6 address.
+//
+// Example:
+//
+//	err := IPv6("2001:db8::1") // err will be nil
+//	err := IPv6("10.0.0.1")    // err will be: "invalid ipv6: 10.0.0.1"
+func IPv6(input any) error {
+	addr, err := getAddr(input)
+	if err != nil {
+		return err
+	}
+
+	if !addr.Is6() {
+		return fmt.Errorf("invalid ipv6: %s", addr)
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package rules
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIPv6(t *testing.T) {
+	t.Run("Valid IPv6", func(t *testing.T) {
+		assert.NoError(t, IPv6("2001:db8::1"))
+	})
+
+	t.Run("Valid IPv4-mapped IPv6 address", func(t *testing.T) {
+		assert.NoError(t, IPv6("::ffff:192.0.2.1"))
+	})
+
+	t.Run("Valid netip.Addr", func(t *testing.T) {
+		assert.NoError(t, IPv6(netip.IPv6Loopback()))
+	})
+
+	t.Run("IPv4 address", func(t *testing.T) {
+		assert.EqualError(t, IPv6("10.0.0.1"), "invalid ipv6: 10.0.0.1")
+	})
+
+	t.Run("Too many groups", func(t *testing.T) {
+		assert.Error(t, IPv6("1:2:3:4:5:6:7:8:9"))
+	})
+}
@@ -0,0 +1,105 @@
+package rules
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go-runtimevalidation/functions"
+)
+
+// JSON validates that the input is a single well-formed JSON value.
+// The input can be a string or a byte slice, such as json.RawMessage.
+//
+// Example
+//   - `{"name":"webhook","retries":3}`
+//   - `[1, 2, 3]`
+//   - `"text"`
+//
+// Parameters:
+//   - input (any): The value to validate.
+//
+// Returns:
+//   - error: If the input is empty, is not a string or byte slice, or is not valid JSON.
+func JSON(input any) error {
+	_, err := jsonDocument(input, "json")
+	return err
+}
+
+// JSONObject validates that the input is a well-formed JSON object, e.g. `{"retries":3}`.
+//
+// Parameters:
+//   - input (any): The value to validate, a string or a byte slice.
+//
+// Returns:
+//   - error: If the input is not valid JSON, or is valid JSON but not an object.
+func JSONObject(input any) error {
+	data, err := jsonDocument(input, "jsonobject")
+	if err != nil {
+		return err
+	}
+
+	if data[0] != '{' {
+		return fmt.Errorf("invalid jsonobject: document is a JSON %s, not an object", jsonKind(data[0]))
+	}
+
+	return nil
+}
+
+// JSONArray validates that the input is a well-formed JSON array, e.g. `[1, 2, 3]`.
+//
+// Parameters:
+//   - input (any): The value to validate, a string or a byte slice.
+//
+// Returns:
+//   - error: If the input is not valid JSON, or is valid JSON but not an array.
+func JSONArray(input any) error {
+	data, err := jsonDocument(input, "jsonarray")
+	if err != nil {
+		return err
+	}
+
+	if data[0] != '[' {
+		return fmt.Errorf("invalid jsonarray: document is a JSON %s, not an array", jsonKind(data[0]))
+	}
+
+	return nil
+}
+
+// jsonDocument returns the input with surrounding whitespace removed if it is valid JSON.
+// name is the rule name used in error messages.
+func jsonDocument(input any, name string) ([]byte, error) {
+	data, err := functions.GetBytes(input)
+	if err != nil {
+		return nil, fmt.Errorf("unsupported type for input field: %w", err)
+	}
+
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return nil, fmt.Errorf("invalid %s: empty document", name)
+	}
+
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", name, err)
+	}
+
+	return data, nil
+}
+
+// jsonKind names the kind of a JSON value from its first character.
+func jsonKind(first byte) string {
+	switch first {
+	case '{':
+		return "object"
+	case '[':
+		return "array"
+	case '"':
+		return "string"
+	case 't', 'f':
+		return "boolean"
+	case 'n':
+		return "null"
+	default:
+		return "number"
+	}
+}
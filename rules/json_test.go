@@ -0,0 +1,64 @@
+package rules
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSON(t *testing.T) {
+	t.Run("Valid documents", func(t *testing.T) {
+		assert.NoError(t, JSON(`{"name":"webhook","retries":3}`))
+		assert.NoError(t, JSON(" [1, 2, 3]\n"))
+		assert.NoError(t, JSON(`"text"`))
+		assert.NoError(t, JSON([]byte(`null`)))
+		assert.NoError(t, JSON(json.RawMessage(`{"a":{"b":[true]}}`)))
+	})
+
+	t.Run("Invalid documents", func(t *testing.T) {
+		err := JSON(`{"name":}`)
+		assert.Error(t, err)
+		assert.Equal(t, "invalid json: invalid character '}' looking for beginning of value", err.Error())
+
+		err = JSON(`{"a":1} {"b":2}`)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid json")
+	})
+
+	t.Run("Empty document", func(t *testing.T) {
+		err := JSON("  ")
+		assert.Error(t, err)
+		assert.Equal(t, "invalid json: empty document", err.Error())
+	})
+
+	t.Run("Unsupported type", func(t *testing.T) {
+		err := JSON(42)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported type for input field")
+	})
+}
+
+func TestJSONObject(t *testing.T) {
+	assert.NoError(t, JSONObject(` {"retries":3} `))
+
+	err := JSONObject(`[1, 2]`)
+	assert.Error(t, err)
+	assert.Equal(t, "invalid jsonobject: document is a JSON array, not an object", err.Error())
+
+	err = JSONObject(`{"retries":`)
+	assert.Error(t, err)
+	assert.Equal(t, "invalid jsonobject: unexpected end of JSON input", err.Error())
+}
+
+func TestJSONArray(t *testing.T) {
+	assert.NoError(t, JSONArray(`[]`))
+
+	err := JSONArray(`-1.5`)
+	assert.Error(t, err)
+	assert.Equal(t, "invalid jsonarray: document is a JSON number, not an array", err.Error())
+
+	err = JSONArray(`{}`)
+	assert.Error(t, err)
+	assert.Equal(t, "invalid jsonarray: document is a JSON object, not an array", err.Error())
+}
@@ -0,0 +1,141 @@
+package rules
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// JSONField applies a validation to a value inside an embedded JSON document.
+// It is expected to be used in validation rules such as `jsonfield:version,semver` or
+// `jsonfield:$.targets[0].port,between:1,65535`, where the nested rule is parsed by the validation package.
+//
+// The path selects the value with dot separated object keys and array indexes, written either as
+// `items.0.name` or `items[0].name`. A leading `$` or `$.` is optional. Numbers are passed to the
+// validation as int64 when they are integers and as float64 otherwise, strings as string, booleans as bool,
+// objects as map[string]any and arrays as []any.
+//
+// Parameters:
+//   - input (any): The document being validated, a string or a byte slice.
+//   - path (string): The path of the value to validate.
+//   - validate: The validation to apply to the selected value.
+//
+// Returns nil if the input is valid, or an error if:
+//   - The input is not a valid JSON document
+//   - The path is malformed or does not exist in the document
+//   - The validation fails for the selected value.
+//
+// Example:
+//
+//	input := `{"version":"1.2"}`
+//	err := JSONField(input, "version", SemVer)  // err will be: "jsonfield validation failed: version: invalid semver: 1.2"
+func JSONField(input any, path string, validate func(value any) error) error {
+	segments, err := parseJSONPath(path)
+	if err != nil {
+		return err
+	}
+
+	data, err := jsonDocument(input, "json")
+	if err != nil {
+		return err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var document any
+	if err := decoder.Decode(&document); err != nil {
+		return fmt.Errorf("invalid json: %w", err)
+	}
+
+	value, err := lookupJSONPath(document, segments)
+	if err != nil {
+		return fmt.Errorf("jsonfield validation failed: %s %w", path, err)
+	}
+
+	if err := validate(jsonValue(value)); err != nil {
+		return fmt.Errorf("jsonfield validation failed: %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// parseJSONPath splits a path such as `$.items[0].name` into its segments.
+func parseJSONPath(path string) ([]string, error) {
+	trimmed := strings.TrimPrefix(strings.TrimPrefix(path, "$"), ".")
+	if trimmed == "" {
+		return nil, fmt.Errorf("invalid json path: %q", path)
+	}
+
+	var segments []string
+	for _, part := range strings.Split(trimmed, ".") {
+		// Split trailing indexes, e.g. "items[0][1]" into "items", "0", "1"
+		key, indexes, _ := strings.Cut(part, "[")
+		if key == "" && indexes == "" {
+			return nil, fmt.Errorf("invalid json path: %q", path)
+		}
+		if key != "" {
+			segments = append(segments, key)
+		}
+		if indexes == "" {
+			continue
+		}
+		for _, index := range strings.Split(indexes, "[") {
+			index, ok := strings.CutSuffix(index, "]")
+			if _, err := strconv.Atoi(index); !ok || err != nil {
+				return nil, fmt.Errorf("invalid json path: %q", path)
+			}
+			segments = append(segments, index)
+		}
+	}
+
+	return segments, nil
+}
+
+// lookupJSONPath walks the decoded document along the path segments.
+func lookupJSONPath(document any, segments []string) (any, error) {
+	current := document
+	for _, segment := range segments {
+		switch node := current.(type) {
+		case map[string]any:
+			value, ok := node[segment]
+			if !ok {
+				return nil, fmt.Errorf("not found")
+			}
+			current = value
+		case []any:
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 || index >= len(node) {
+				return nil, fmt.Errorf("not found")
+			}
+			current = node[index]
+		default:
+			return nil, fmt.Errorf("not found")
+		}
+	}
+	return current, nil
+}
+
+// jsonValue converts numbers decoded as json.Number into int64 or float64, including those inside objects and arrays.
+func jsonValue(value any) any {
+	switch v := value.(type) {
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return n
+		}
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+		return v.String()
+	case map[string]any:
+		for key, item := range v {
+			v[key] = jsonValue(item)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = jsonValue(item)
+		}
+	}
+	return value
+}
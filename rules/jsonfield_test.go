@@ -0,0 +1,76 @@
+package rules
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONField(t *testing.T) {
+	document := `{"version":"1.2.3","targets":[{"url":"https://a.example","port":8443},{"url":"https://b.example","weight":0.5}],"enabled":true}`
+
+	// capture returns a validation that records the value it receives.
+	capture := func(got *any) func(value any) error {
+		return func(value any) error {
+			*got = value
+			return nil
+		}
+	}
+
+	t.Run("Object keys", func(t *testing.T) {
+		assert.NoError(t, JSONField(document, "version", SemVer))
+
+		err := JSONField(`{"version":"1.2"}`, "version", SemVer)
+		assert.Error(t, err)
+		assert.Equal(t, "jsonfield validation failed: version: invalid semver: 1.2", err.Error())
+	})
+
+	t.Run("Array indexes", func(t *testing.T) {
+		var got any
+		assert.NoError(t, JSONField(document, "targets.1.url", capture(&got)))
+		assert.Equal(t, "https://b.example", got)
+
+		assert.NoError(t, JSONField(document, "$.targets[0].url", capture(&got)))
+		assert.Equal(t, "https://a.example", got)
+	})
+
+	t.Run("Value types", func(t *testing.T) {
+		var got any
+		assert.NoError(t, JSONField(document, "targets[0].port", capture(&got)))
+		assert.Equal(t, int64(8443), got)
+
+		assert.NoError(t, JSONField(document, "targets[1].weight", capture(&got)))
+		assert.Equal(t, 0.5, got)
+
+		assert.NoError(t, JSONField(document, "enabled", capture(&got)))
+		assert.Equal(t, true, got)
+
+		assert.NoError(t, JSONField(document, "targets[0]", capture(&got)))
+		assert.Equal(t, map[string]any{"url": "https://a.example", "port": int64(8443)}, got)
+	})
+
+	t.Run("Missing values", func(t *testing.T) {
+		never := func(value any) error { return fmt.Errorf("should not be called") }
+
+		for _, path := range []string{"name", "targets[2]", "targets.url", "version.major"} {
+			err := JSONField(document, path, never)
+			assert.Error(t, err)
+			assert.Equal(t, "jsonfield validation failed: "+path+" not found", err.Error())
+		}
+	})
+
+	t.Run("Invalid paths", func(t *testing.T) {
+		for _, path := range []string{"", "$", "targets[x]", "targets[0", "a..b"} {
+			err := JSONField(document, path, SemVer)
+			assert.Error(t, err, path)
+			assert.Contains(t, err.Error(), "invalid json path")
+		}
+	})
+
+	t.Run("Invalid document", func(t *testing.T) {
+		err := JSONField(`{"version":`, "version", SemVer)
+		assert.Error(t, err)
+		assert.Equal(t, "invalid json: unexpected end of JSON input", err.Error())
+	})
+}
@@ -0,0 +1,60 @@
+package rules
+
+import (
+	"fmt"
+	"sync"
+)
+
+// JWTKeyProvider supplies the secret used to verify the signature of a JWT token.
+// kid is the `kid` header of the token (empty if absent) and alg its `alg` header, e.g. "HS256".
+type JWTKeyProvider interface {
+	JWTKey(kid string, alg string) ([]byte, error)
+}
+
+// JWTKeyFunc adapts an ordinary function to the JWTKeyProvider interface.
+type JWTKeyFunc func(kid string, alg string) ([]byte, error)
+
+// JWTKey calls f(kid, alg).
+func (f JWTKeyFunc) JWTKey(kid string, alg string) ([]byte, error) {
+	return f(kid, alg)
+}
+
+// JWTStaticKey returns a provider that supplies the same key for every token.
+func JWTStaticKey(key []byte) JWTKeyProvider {
+	return JWTKeyFunc(func(string, string) ([]byte, error) {
+		return key, nil
+	})
+}
+
+var (
+	jwtKeysMu sync.RWMutex
+	jwtKeys   = map[string]JWTKeyProvider{}
+)
+
+// RegisterJWTKeys adds a named key provider so that it can be referenced from rule text as `jwt:key=name`.
+// Registering a name twice replaces the previous provider.
+//
+// Example:
+//
+//	err := rules.RegisterJWTKeys("auth", rules.JWTStaticKey([]byte(os.Getenv("JWT_SECRET"))))
+func RegisterJWTKeys(name string, provider JWTKeyProvider) error {
+	if name == "" {
+		return fmt.Errorf("jwt key provider name cannot be empty")
+	}
+	if provider == nil {
+		return fmt.Errorf("jwt key provider %s cannot be nil", name)
+	}
+
+	jwtKeysMu.Lock()
+	defer jwtKeysMu.Unlock()
+	jwtKeys[name] = provider
+	return nil
+}
+
+// lookupJWTKeys returns the key provider registered under name.
+func lookupJWTKeys(name string) (JWTKeyProvider, bool) {
+	jwtKeysMu.RLock()
+	defer jwtKeysMu.RUnlock()
+	provider, ok := jwtKeys[name]
+	return provider, ok
+}
@@ -0,0 +1,34 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/functions"
+	"go-runtimevalidation/iso"
+)
+
+// Language validates that the input is an ISO 639 language code: an ISO 639-1 code such as "de",
+// or an ISO 639-2 terminology ("deu") or bibliographic ("ger") code. Codes are case-sensitive, so "DE" is rejected.
+//
+// Parameters:
+// - input (any): The value to be validated. It should be convertible to a string.
+//
+// Returns:
+// - error: If the input is not a known code, it returns an error with details.
+//
+// Example:
+//
+//	err := Language("de") // err will be nil
+//	err := Language("xx") // err will be: "invalid language: xx"
+func Language(input any) error {
+	// Check if the input is a string
+	value, err := functions.GetString(input)
+	if err != nil {
+		return fmt.Errorf("expected a string, got %T", input)
+	}
+
+	if _, ok := iso.LanguageByCode(value); !ok {
+		return fmt.Errorf("invalid language: %s", value)
+	}
+
+	return nil
+}
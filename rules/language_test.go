@@ -0,0 +1,33 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLanguage(t *testing.T) {
+	t.Run("Valid ISO 639-1 code", func(t *testing.T) {
+		assert.NoError(t, Language("de"))
+		assert.NoError(t, Language("en"))
+	})
+
+	t.Run("Valid ISO 639-2 codes", func(t *testing.T) {
+		assert.NoError(t, Language("deu"))
+		assert.NoError(t, Language("ger"))
+	})
+
+	t.Run("Unknown language code", func(t *testing.T) {
+		err := Language("xx")
+		assert.Error(t, err)
+		assert.Equal(t, "invalid language: xx", err.Error())
+	})
+
+	t.Run("Upper case code", func(t *testing.T) {
+		assert.Error(t, Language("DE"))
+	})
+
+	t.Run("Language tag", func(t *testing.T) {
+		assert.Error(t, Language("de-CH"))
+	})
+}
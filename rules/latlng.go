@@ -0,0 +1,126 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"go-runtimevalidation/functions"
+	"go-runtimevalidation/geo"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// LatLng validates that the input is a pair of coordinates, a latitude in [-90, 90] and a longitude in [-180, 180] degrees.
+// Unlike `latitude` and `longitude`, which validate standalone strings, it validates combined values.
+//
+// Parameters:
+// - input: The value being validated. It can be:
+//   - a "lat,lng" string, such as "52.5200,13.4050"
+//   - a [2]float64 array or a slice of two numbers, latitude first
+//   - a struct or a map with a latitude field named Lat or Latitude and a longitude field named Lng, Lon, Long or Longitude
+//     (case-insensitive), such as struct{ Lat, Lng float64 }
+//   - a geo.Point.
+//
+// Returns an error if:
+// - The input is none of the above
+// - The latitude or longitude is not a finite number in range.
+//
+// Example:
+//
+//	err := LatLng("52.5200,13.4050")  // err will be nil
+//	err := LatLng([2]float64{91, 0})  // err will be: "invalid coordinates: latitude 91 is out of range [-90, 90]"
+func LatLng(input any) error {
+	_, err := getPoint(input)
+	return err
+}
+
+// errNotCoordinates is returned by getPoint when the input has an unsupported type.
+var errNotCoordinates = errors.New("expected coordinates as a \"lat,lng\" string, a [2]float64 or a struct with latitude and longitude fields")
+
+// latitudeNames and longitudeNames are the lower case field and key names of coordinates in structs and maps.
+var (
+	latitudeNames  = []string{"lat", "latitude"}
+	longitudeNames = []string{"lng", "lon", "long", "longitude"}
+)
+
+// getPoint converts coordinates into a geo.Point, see LatLng for the supported inputs.
+func getPoint(input any) (geo.Point, error) {
+	switch value := input.(type) {
+	case geo.Point:
+		point, err := geo.NewPoint(value.Lat, value.Lng)
+		if err != nil {
+			return geo.Point{}, fmt.Errorf("invalid coordinates: %w", err)
+		}
+		return point, nil
+	case string:
+		point, err := geo.ParsePoint(value)
+		if err != nil {
+			return geo.Point{}, fmt.Errorf("invalid coordinates: %w", err)
+		}
+		return point, nil
+	}
+
+	var lat, lng any
+	var hasLat, hasLng bool
+	v := reflect.ValueOf(input)
+	if v.Kind() == reflect.Pointer && !v.IsNil() {
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Array, reflect.Slice:
+		if v.Len() != 2 {
+			return geo.Point{}, fmt.Errorf("invalid coordinates: expected a latitude and a longitude, got %d values", v.Len())
+		}
+		lat, lng, hasLat, hasLng = v.Index(0).Interface(), v.Index(1).Interface(), true, true
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			name := strings.ToLower(field.Name)
+			switch {
+			case slices.Contains(latitudeNames, name):
+				lat, hasLat = v.Field(i).Interface(), true
+			case slices.Contains(longitudeNames, name):
+				lng, hasLng = v.Field(i).Interface(), true
+			}
+		}
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return geo.Point{}, fmt.Errorf("%w, got %T", errNotCoordinates, input)
+		}
+		iter := v.MapRange()
+		for iter.Next() {
+			name := strings.ToLower(iter.Key().String())
+			switch {
+			case slices.Contains(latitudeNames, name):
+				lat, hasLat = iter.Value().Interface(), true
+			case slices.Contains(longitudeNames, name):
+				lng, hasLng = iter.Value().Interface(), true
+			}
+		}
+	default:
+		return geo.Point{}, fmt.Errorf("%w, got %T", errNotCoordinates, input)
+	}
+
+	if !hasLat || !hasLng {
+		return geo.Point{}, fmt.Errorf("%w, got %T", errNotCoordinates, input)
+	}
+
+	latValue, err := functions.GetFloat(lat)
+	if err != nil {
+		return geo.Point{}, fmt.Errorf("invalid coordinates: latitude: %w", err)
+	}
+	lngValue, err := functions.GetFloat(lng)
+	if err != nil {
+		return geo.Point{}, fmt.Errorf("invalid coordinates: longitude: %w", err)
+	}
+
+	point, err := geo.NewPoint(latValue, lngValue)
+	if err != nil {
+		return geo.Point{}, fmt.Errorf("invalid coordinates: %w", err)
+	}
+	return point, nil
+}
@@ -0,0 +1,50 @@
+package rules
+
+import (
+	"go-runtimevalidation/geo"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLatLng(t *testing.T) {
+	t.Run("Valid coordinates", func(t *testing.T) {
+		assert.NoError(t, LatLng("52.5200,13.4050"))
+		assert.NoError(t, LatLng("-33.87, 151.21"))
+		assert.NoError(t, LatLng([2]float64{52.52, 13.405}))
+		assert.NoError(t, LatLng([]any{"52.52", 13}))
+		assert.NoError(t, LatLng(struct{ Lat, Lng float64 }{Lat: 52.52, Lng: 13.405}))
+		assert.NoError(t, LatLng(&struct{ Latitude, Longitude float32 }{Latitude: 52.52, Longitude: 13.405}))
+		assert.NoError(t, LatLng(map[string]any{"lat": 90, "lon": -180}))
+		assert.NoError(t, LatLng(geo.Point{Lat: 0, Lng: 0}))
+	})
+
+	t.Run("Out of range", func(t *testing.T) {
+		err := LatLng([2]float64{91, 0})
+		assert.EqualError(t, err, "invalid coordinates: latitude 91 is out of range [-90, 90]")
+
+		err = LatLng("0,181")
+		assert.EqualError(t, err, "invalid coordinates: longitude 181 is out of range [-180, 180]")
+
+		assert.Error(t, LatLng([2]float64{math.NaN(), 0}))
+		assert.Error(t, LatLng(struct{ Lat, Lng float64 }{Lat: 0, Lng: math.Inf(1)}))
+	})
+
+	t.Run("Invalid values", func(t *testing.T) {
+		err := LatLng("52.52")
+		assert.EqualError(t, err, `invalid coordinates: expected coordinates such as "52.52,13.405", got "52.52"`)
+
+		err = LatLng([]float64{1, 2, 3})
+		assert.EqualError(t, err, "invalid coordinates: expected a latitude and a longitude, got 3 values")
+
+		err = LatLng(struct{ Lat string }{Lat: "1"})
+		assert.EqualError(t, err, `expected coordinates as a "lat,lng" string, a [2]float64 or a struct with latitude and longitude fields, got struct { Lat string }`)
+
+		err = LatLng(map[string]any{"lat": "north", "lng": 1})
+		assert.EqualError(t, err, `invalid coordinates: latitude: failed to parse "north" of type string as float64`)
+
+		assert.Error(t, LatLng(42))
+		assert.Error(t, LatLng(nil))
+	})
+}
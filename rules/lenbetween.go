@@ -0,0 +1,82 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/functions"
+)
+
+// LenBetween validates that the length of the input is inclusively between two bounds.
+// It is expected to be used in validation rules such as `lenbetween:3,20`, `lenbetween:runes,3,20`
+// or `lenbetween:1,$MaxItems`. It works on strings, slices, arrays and maps.
+//
+// An optional length mode (bytes, runes, graphemes or utf16) may be passed alongside the bounds
+// to choose how strings are measured. Without a mode, strings are measured in bytes.
+// Like Between, the order of the bounds does not matter.
+//
+// Parameters:
+// - input: The value whose length will be validated. This can be a string, array, map or slice.
+// - obj: The object containing additional data (can be used for Field references within the args).
+// - args: The list of arguments: two `Arg`s that specify the bounds, and optionally an `Arg` naming the length mode.
+// The mode is recognized by its name, so it may be given in any position.
+//
+// Returns nil if the input is valid, or an error if:
+// - The number of arguments (besides the mode) is not equal to 2
+// - The input does not support a length
+// - Any of the arguments cannot be converted to an integer
+// - The length of the input is outside the bounds, in which case the error is a *LengthError with code CodeLengthOutOfRange.
+//
+// Example:
+//
+//	input := []string{"a", "b", "c", "d"}
+//	obj := nil
+//	args := []Arg{
+//	    {Value: 1},
+//	    {Value: 3},
+//	}
+//	err := LenBetween(input, obj, args)  // err will be: "lenbetween validation failed: length 4 is not inclusively between 1 and 3 (elements)"
+func LenBetween(input any, obj any, arguments []args.Arg) error {
+	// Extract the optional length mode
+	mode, arguments, err := lenModeArgList(arguments)
+	if err != nil {
+		return err
+	}
+
+	if len(arguments) != 2 {
+		return fmt.Errorf("lenbetween expects exactly 2 arguments, got %d", len(arguments))
+	}
+
+	// Evaluate both bounds
+	bounds := make([]int64, 0, 2)
+	for _, v := range arguments {
+		eval, err := v.Evaluate(obj)
+		if err != nil {
+			return err
+		}
+
+		bound, err := functions.GetInt(eval)
+		if err != nil {
+			return fmt.Errorf("unsupported type for lenbetween argument: %w", err)
+		}
+		bounds = append(bounds, bound)
+	}
+
+	lower, upper := bounds[0], bounds[1]
+	if lower > upper {
+		lower, upper = upper, lower
+	}
+
+	// Get the length of the input
+	length, err := functions.GetLenMode(input, mode)
+	if err != nil {
+		return err
+	}
+
+	// Compare the length against the bounds
+	if int64(length) < lower || int64(length) > upper {
+		return &LengthError{Code: CodeLengthOutOfRange, Length: length, Min: lower, Max: upper, Unit: lengthUnit(input, mode)}
+	}
+
+	// Validation passed
+	return nil
+}
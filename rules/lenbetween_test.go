@@ -0,0 +1,115 @@
+package rules
+
+import (
+	"errors"
+	"go-runtimevalidation/args"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLenBetween(t *testing.T) {
+	t.Run("String within bounds", func(t *testing.T) {
+		err := LenBetween("hello", nil, []args.Arg{
+			{Value: 3},
+			{Value: 10},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("String equal to bounds", func(t *testing.T) {
+		err := LenBetween("abc", nil, []args.Arg{
+			{Value: 3},
+			{Value: 5},
+		})
+		assert.NoError(t, err)
+
+		err = LenBetween("abcde", nil, []args.Arg{
+			{Value: 3},
+			{Value: 5},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("String too long in bytes but not in runes", func(t *testing.T) {
+		err := LenBetween("héllo", nil, []args.Arg{
+			{Value: 1},
+			{Value: 5},
+		})
+		assert.EqualError(t, err, "lenbetween validation failed: length 6 is not inclusively between 1 and 5 (bytes)")
+
+		err = LenBetween("héllo", nil, []args.Arg{
+			{Value: "runes"},
+			{Value: 1},
+			{Value: 5},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Slice outside bounds", func(t *testing.T) {
+		err := LenBetween([]string{"a", "b", "c", "d"}, nil, []args.Arg{
+			{Value: 1},
+			{Value: 3},
+		})
+		assert.EqualError(t, err, "lenbetween validation failed: length 4 is not inclusively between 1 and 3 (elements)")
+
+		var lengthErr *LengthError
+		assert.True(t, errors.As(err, &lengthErr))
+		assert.Equal(t, CodeLengthOutOfRange, lengthErr.Code)
+		assert.Equal(t, 4, lengthErr.Length)
+	})
+
+	t.Run("Map within bounds", func(t *testing.T) {
+		err := LenBetween(map[string]int{"a": 1}, nil, []args.Arg{
+			{Value: 1},
+			{Value: 2},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Field and function bounds", func(t *testing.T) {
+		obj := struct {
+			Name     string
+			MaxItems int
+		}{Name: "ab", MaxItems: 4}
+
+		err := LenBetween([3]int{1, 2, 3}, obj, []args.Arg{
+			{Type: args.FunctionArg, Function: args.Function{
+				Name: "len",
+				Args: []args.Arg{{Type: args.FieldArg, Field: "Name"}},
+			}},
+			{Type: args.FieldArg, Field: "MaxItems"},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Equal bounds", func(t *testing.T) {
+		err := LenBetween("hello", nil, []args.Arg{
+			{Value: 5},
+			{Value: 5},
+		})
+		assert.NoError(t, err)
+
+		err = LenBetween("hello!", nil, []args.Arg{
+			{Value: 5},
+			{Value: 5},
+		})
+		assert.EqualError(t, err, "lenbetween validation failed: length 6 is not inclusively between 5 and 5 (bytes)")
+	})
+
+	t.Run("Wrong number of arguments", func(t *testing.T) {
+		err := LenBetween("hello", nil, []args.Arg{
+			{Value: 3},
+		})
+		assert.EqualError(t, err, "lenbetween expects exactly 2 arguments, got 1")
+	})
+
+	t.Run("Non-integer bound", func(t *testing.T) {
+		err := LenBetween("hello", nil, []args.Arg{
+			{Value: 3},
+			{Value: "ten"},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported type for lenbetween argument")
+	})
+}
@@ -7,18 +7,28 @@ import (
 )
 
 // Length validates that the length of the input matches the length specified in the argument.
-// It is expected to be used in validation rules such as `length:10` or `length:$len($Password)`.
+// It is expected to be used in validation rules such as `length:10`, `length:runes,5` or `length:$len($Password)`.
+//
+// An optional length mode (bytes, runes, graphemes or utf16) may be passed alongside the expected length
+// to choose how strings are measured. Without a mode, strings are measured in bytes.
 //
 // Parameters:
 // - input: The value whose length will be validated. This can be a string, array, map, slice, or any type that supports length.
 // - obj: The object containing the data for field or function evaluations. This is required when using dynamic evaluations like `$len($Password)`.
-// - args: A map containing a single `Arg` that specifies the expected length. This can either be a constant or a dynamic value evaluated from obj.
+// - args: A map containing an `Arg` that specifies the expected length, and optionally an `Arg` naming the length mode.
 //
 // Returns:
-// - An error if the length of the input does not match the expected length or if any other error occurs during evaluation.
+// - A *LengthError with code CodeLengthMismatch if the length of the input does not match.
+// - An error if any other error occurs during evaluation.
 // - `nil` if the length of the input matches the expected length.
 func Length(input any, obj any, arguments map[string]args.Arg) error {
-	// Ensure only one argument is passed
+	// Extract the optional length mode
+	mode, arguments, err := lenModeArg(arguments)
+	if err != nil {
+		return err
+	}
+
+	// Ensure only one argument is passed besides the mode
 	if len(arguments) != 1 {
 		return fmt.Errorf("length expects exactly 1 argument, got %d", len(arguments))
 	}
@@ -37,7 +47,7 @@ func Length(input any, obj any, arguments map[string]args.Arg) error {
 	}
 
 	// Get the length of the input
-	lhs, err := functions.GetLen(input)
+	lhs, err := functions.GetLenMode(input, mode)
 	if err != nil {
 		return err
 	}
@@ -50,8 +60,65 @@ func Length(input any, obj any, arguments map[string]args.Arg) error {
 
 	// Compare lengths
 	if int64(lhs) != rhs {
-		return fmt.Errorf("length mismatch: %d != %d", lhs, rhs)
+		return &LengthError{Code: CodeLengthMismatch, Length: lhs, Min: rhs, Max: rhs, Unit: lengthUnit(input, mode)}
 	}
 
 	return nil
 }
+
+// lenModeArg looks for a value argument naming a length mode (e.g. `runes` in `length:runes,5`).
+// It returns the mode, defaulting to bytes, and the remaining arguments without the mode.
+// An error is returned if more than one mode is given.
+func lenModeArg(arguments map[string]args.Arg) (functions.LenMode, map[string]args.Arg, error) {
+	mode := functions.LenBytes
+	found := false
+	remaining := make(map[string]args.Arg, len(arguments))
+
+	for k, v := range arguments {
+		if m, ok := lenModeOf(v); ok {
+			if found {
+				return "", nil, fmt.Errorf("only one length mode may be given, got %s and %s", mode, m)
+			}
+			mode = m
+			found = true
+			continue
+		}
+		remaining[k] = v
+	}
+
+	return mode, remaining, nil
+}
+
+// lenModeArgList is lenModeArg for positional arguments, such as those of `lenbetween:runes,3,20`.
+// The remaining arguments keep their order.
+func lenModeArgList(arguments []args.Arg) (functions.LenMode, []args.Arg, error) {
+	mode := functions.LenBytes
+	found := false
+	remaining := make([]args.Arg, 0, len(arguments))
+
+	for _, v := range arguments {
+		if m, ok := lenModeOf(v); ok {
+			if found {
+				return "", nil, fmt.Errorf("only one length mode may be given, got %s and %s", mode, m)
+			}
+			mode = m
+			found = true
+			continue
+		}
+		remaining = append(remaining, v)
+	}
+
+	return mode, remaining, nil
+}
+
+// lenModeOf returns the length mode named by a value argument, if any.
+func lenModeOf(arg args.Arg) (functions.LenMode, bool) {
+	if arg.Type != args.ValueArg {
+		return "", false
+	}
+	name, ok := arg.Value.(string)
+	if !ok {
+		return "", false
+	}
+	return functions.ParseLenMode(name)
+}
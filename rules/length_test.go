@@ -97,4 +97,34 @@ func TestLength(t *testing.T) {
 		err := Length(input, nil, args)
 		assert.NoError(t, err, "Expected no error for empty input with length 0")
 	})
+
+	// Test case: rune length mode accepts multibyte characters
+	t.Run("Rune length mode", func(t *testing.T) {
+		args := map[string]args.Arg{
+			"runes": {Type: args.ValueArg, Value: "runes"},
+			"5":     {Type: args.ValueArg, Value: 5},
+		}
+		assert.NoError(t, Length("héllo", nil, args))
+		assert.EqualError(t, Length("hello!", nil, args), "length mismatch: 6 != 5")
+	})
+
+	// Test case: byte length of a multibyte string without a mode
+	t.Run("Default byte length mode", func(t *testing.T) {
+		args := map[string]args.Arg{
+			"5": {Type: args.ValueArg, Value: 5},
+		}
+		assert.EqualError(t, Length("héllo", nil, args), "length mismatch: 6 != 5")
+	})
+
+	// Test case: more than one length mode
+	t.Run("Multiple length modes", func(t *testing.T) {
+		args := map[string]args.Arg{
+			"runes": {Type: args.ValueArg, Value: "runes"},
+			"bytes": {Type: args.ValueArg, Value: "bytes"},
+			"5":     {Type: args.ValueArg, Value: 5},
+		}
+		err := Length("hello", nil, args)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "only one length mode may be given")
+	})
 }
@@ -0,0 +1,38 @@
+package rules
+
+import (
+	"fmt"
+	"net"
+)
+
+// MAC validates that the input is a valid IEEE 802 MAC-48, EUI-48, EUI-64 or 20-octet IP over InfiniBand
+// link-layer address, in any of the notations accepted by net.ParseMAC, e.g. "00:1a:2b:3c:4d:5e",
+// "00-1A-2B-3C-4D-5E" or "001a.2b3c.4d5e".
+//
+// Parameters:
+// - input: The value being validated. It can be a string or a net.HardwareAddr.
+//
+// Returns nil if the input is a valid MAC address, or an error if:
+// - The input is not a string or net.HardwareAddr
+// - The input is not a valid MAC address.
+//
+// Example:
+//
+//	err := MAC("00:1a:2b:3c:4d:5e") // err will be nil
+//	err := MAC("00:1a:2b:3c:4d")    // err will be: "invalid mac: 00:1a:2b:3c:4d"
+func MAC(input any) error {
+	switch v := input.(type) {
+	case string:
+		if _, err := net.ParseMAC(v); err != nil {
+			return fmt.Errorf("invalid mac: %s", v)
+		}
+	case net.HardwareAddr:
+		if len(v) != 6 && len(v) != 8 && len(v) != 20 {
+			return fmt.Errorf("invalid mac: %s", v)
+		}
+	default:
+		return fmt.Errorf("expected a string or hardware address, got %T", input)
+	}
+
+	return nil
+}
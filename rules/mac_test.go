@@ -0,0 +1,42 @@
+package rules
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMAC(t *testing.T) {
+	t.Run("Valid colon separated MAC", func(t *testing.T) {
+		assert.NoError(t, MAC("00:1a:2b:3c:4d:5e"))
+	})
+
+	t.Run("Valid hyphen separated MAC", func(t *testing.T) {
+		assert.NoError(t, MAC("00-1A-2B-3C-4D-5E"))
+	})
+
+	t.Run("Valid dot separated MAC", func(t *testing.T) {
+		assert.NoError(t, MAC("001a.2b3c.4d5e"))
+	})
+
+	t.Run("Valid EUI-64", func(t *testing.T) {
+		assert.NoError(t, MAC("00:1a:2b:3c:4d:5e:6f:70"))
+	})
+
+	t.Run("Valid net.HardwareAddr", func(t *testing.T) {
+		assert.NoError(t, MAC(net.HardwareAddr{0, 0x1a, 0x2b, 0x3c, 0x4d, 0x5e}))
+	})
+
+	t.Run("Too few octets", func(t *testing.T) {
+		assert.EqualError(t, MAC("00:1a:2b:3c:4d"), "invalid mac: 00:1a:2b:3c:4d")
+	})
+
+	t.Run("Invalid hex digit", func(t *testing.T) {
+		assert.Error(t, MAC("00:1a:2b:3c:4d:5g"))
+	})
+
+	t.Run("Invalid input type", func(t *testing.T) {
+		assert.EqualError(t, MAC(12345), "expected a string or hardware address, got int")
+	})
+}
@@ -9,16 +9,17 @@ import (
 // Max validates that the input is less than or equal to the specified maximum value.
 // The function takes an input of any type, an object of any type for evaluation,
 // and a map of arguments that must contain exactly one argument specifying the maximum value.
+// Numbers are compared exactly, as in Min, so the input can also be a float, a *big.Int, *big.Float or *big.Rat, or a decimal string.
 //
 // Parameters:
-// - input: The value being validated, expected to be convertible to an integer.
+// - input: The value being validated, expected to be convertible to a number.
 // - obj: The object containing additional data (can be used for Field references within the args).
 // - args: A map of arguments where exactly one entry is expected to specify the maximum value.
 //
 // Returns nil if the input is valid, or an error if:
 // - The number of arguments is not equal to 1
-// - The input cannot be converted to an integer
-// - The argument cannot be converted to an integer
+// - The input cannot be converted to a number
+// - The argument cannot be converted to a number
 // - The input is greater than the specified maximum value.
 //
 // Example:
@@ -48,20 +49,20 @@ func Max(input any, obj any, arguments map[string]args.Arg) error {
 	}
 
 	// Get the value of the input
-	lhs, err := functions.GetInt(input)
+	lhs, err := getComparedNumber(input)
 	if err != nil {
 		return fmt.Errorf("unsupported type for input field: %w", err)
 	}
 
 	// Get the value to compare against
-	rhs, err := functions.GetInt(eval)
+	rhs, err := getComparedNumber(eval)
 	if err != nil {
 		return fmt.Errorf("unsupported type for max argument: %w", err)
 	}
 
-	// Compare values
-	if rhs < lhs {
-		return fmt.Errorf("max validation failed: %d > %d", lhs, rhs)
+	// Compare values exactly
+	if lhs.Cmp(rhs) > 0 {
+		return fmt.Errorf("max validation failed: %s > %s", functions.FormatNumber(lhs), functions.FormatNumber(rhs))
 	}
 
 	// Validation passed
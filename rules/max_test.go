@@ -2,6 +2,8 @@ package rules
 
 import (
 	"go-runtimevalidation/args"
+	"math"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -67,4 +69,28 @@ func TestMax(t *testing.T) {
 		assert.Error(t, err)
 		assert.EqualError(t, err, "unsupported type for max argument: failed to parse \"test\" of type string as int64")
 	})
+
+	// Test case 7: Big numbers and decimal strings are compared exactly
+	t.Run("Arbitrary precision", func(t *testing.T) {
+		limit, _ := new(big.Int).SetString("99999999999999999999", 10)
+		err := Max("99999999999999999999.5", nil, map[string]args.Arg{
+			"limit": {Value: limit},
+		})
+		assert.EqualError(t, err, "max validation failed: 99999999999999999999.5 > 99999999999999999999")
+
+		err = Max(uint64(math.MaxUint64), nil, map[string]args.Arg{
+			"9223372036854775807": {Value: int64(math.MaxInt64)},
+		})
+		assert.EqualError(t, err, "max validation failed: 18446744073709551615 > 9223372036854775807")
+
+		err = Max(0.3, nil, map[string]args.Arg{
+			"0.3": {Value: "0.3"},
+		})
+		assert.NoError(t, err)
+
+		err = Max("1e400", nil, map[string]args.Arg{
+			"10": {Value: 10},
+		})
+		assert.EqualError(t, err, "max validation failed: 1e+400 > 10")
+	})
 }
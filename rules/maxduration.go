@@ -0,0 +1,67 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/functions"
+)
+
+// MaxDuration validates that the input is a duration less than or equal to a maximum duration.
+// It is expected to be used in validation rules such as `maxduration:90m` or `maxduration:$MaxTimeout`.
+//
+// Parameters:
+// - input: The value being validated. It can be a time.Duration, an integer number of nanoseconds, or a string like "90m".
+// - obj: The object containing additional data (can be used for Field references within the args).
+// - args: A map containing exactly one argument, the maximum duration.
+//
+// Returns nil if the input is valid, or an error if:
+// - The number of arguments is not equal to 1
+// - The input or the argument cannot be converted to a duration
+// - The input is longer than the maximum.
+//
+// Example:
+//
+//	input := "2h"
+//	obj := nil
+//	args := map[string]Arg{
+//	    "1h": {Value: "1h"},
+//	}
+//	err := MaxDuration(input, obj, args)  // err will be: "maxduration validation failed: 2h0m0s > 1h0m0s"
+func MaxDuration(input any, obj any, arguments map[string]args.Arg) error {
+	if len(arguments) != 1 {
+		return fmt.Errorf("maxduration expects exactly 1 argument, got %d", len(arguments))
+	}
+
+	// Get the first (and only) argument
+	var arg args.Arg
+	for _, v := range arguments {
+		arg = v
+		break
+	}
+
+	// Evaluate the argument
+	eval, err := arg.Evaluate(obj)
+	if err != nil {
+		return err
+	}
+
+	// Get the value of the input
+	lhs, err := functions.GetDuration(input)
+	if err != nil {
+		return fmt.Errorf("unsupported type for input field: %w", err)
+	}
+
+	// Get the value to compare against
+	rhs, err := functions.GetDuration(eval)
+	if err != nil {
+		return fmt.Errorf("unsupported type for maxduration argument: %w", err)
+	}
+
+	// Compare values
+	if rhs < lhs {
+		return fmt.Errorf("maxduration validation failed: %s > %s", lhs, rhs)
+	}
+
+	// Validation passed
+	return nil
+}
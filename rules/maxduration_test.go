@@ -0,0 +1,33 @@
+package rules
+
+import (
+	"go-runtimevalidation/args"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaxDuration(t *testing.T) {
+	t.Run("Duration below maximum", func(t *testing.T) {
+		err := MaxDuration("45m", nil, map[string]args.Arg{
+			"1h": {Value: "1h"},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Duration above maximum", func(t *testing.T) {
+		err := MaxDuration(2*time.Hour, nil, map[string]args.Arg{
+			"90m": {Value: "90m"},
+		})
+		assert.EqualError(t, err, "maxduration validation failed: 2h0m0s > 1h30m0s")
+	})
+
+	t.Run("Invalid input", func(t *testing.T) {
+		err := MaxDuration("forever", nil, map[string]args.Arg{
+			"1h": {Value: "1h"},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported type for input field")
+	})
+}
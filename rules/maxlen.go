@@ -0,0 +1,78 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/functions"
+)
+
+// MaxLen validates that the length of the input is less than or equal to a maximum length.
+// It is expected to be used in validation rules such as `maxlen:3`, `maxlen:runes,3` or `maxlen:$len($Other)`.
+//
+// An optional length mode (bytes, runes, graphemes or utf16) may be passed alongside the maximum
+// to choose how strings are measured. Without a mode, strings are measured in bytes.
+//
+// Parameters:
+// - input: The value whose length will be validated. This can be a string, array, map or slice.
+// - obj: The object containing additional data (can be used for Field references within the args).
+// - args: A map containing an `Arg` that specifies the maximum length, and optionally an `Arg` naming the length mode.
+//
+// Returns nil if the input is valid, or an error if:
+// - The number of arguments (besides the mode) is not equal to 1
+// - The input does not support a length
+// - The argument cannot be converted to an integer
+// - The length of the input is greater than the specified maximum, in which case the error is a *LengthError with code CodeLengthTooLong.
+//
+// Example:
+//
+//	input := "héllo"
+//	obj := nil
+//	args := map[string]Arg{
+//	    "runes": Arg{Value: "runes"},
+//	    "4":     Arg{Value: 4},
+//	}
+//	err := MaxLen(input, obj, args)  // err will be: "maxlen validation failed: length 5 is greater than 4 (runes)"
+func MaxLen(input any, obj any, arguments map[string]args.Arg) error {
+	// Extract the optional length mode
+	mode, arguments, err := lenModeArg(arguments)
+	if err != nil {
+		return err
+	}
+
+	if len(arguments) != 1 {
+		return fmt.Errorf("maxlen expects exactly 1 argument, got %d", len(arguments))
+	}
+
+	// Get the first (and only) argument
+	var arg args.Arg
+	for _, v := range arguments {
+		arg = v
+		break
+	}
+
+	// Evaluate the argument
+	eval, err := arg.Evaluate(obj)
+	if err != nil {
+		return err
+	}
+
+	// Get the length of the input
+	lhs, err := functions.GetLenMode(input, mode)
+	if err != nil {
+		return err
+	}
+
+	// Get the length to compare against
+	rhs, err := functions.GetInt(eval)
+	if err != nil {
+		return fmt.Errorf("unsupported type for maxlen argument: %w", err)
+	}
+
+	// Compare lengths
+	if rhs < int64(lhs) {
+		return &LengthError{Code: CodeLengthTooLong, Length: lhs, Max: rhs, Unit: lengthUnit(input, mode)}
+	}
+
+	// Validation passed
+	return nil
+}
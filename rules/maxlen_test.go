@@ -0,0 +1,48 @@
+package rules
+
+import (
+	"go-runtimevalidation/args"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaxLen(t *testing.T) {
+	t.Run("String shorter than maximum", func(t *testing.T) {
+		err := MaxLen("hi", nil, map[string]args.Arg{
+			"5": {Value: 5},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Multibyte string exceeds byte maximum", func(t *testing.T) {
+		err := MaxLen("héllo", nil, map[string]args.Arg{
+			"5": {Value: 5},
+		})
+		assert.EqualError(t, err, "maxlen validation failed: length 6 is greater than 5 (bytes)")
+	})
+
+	t.Run("Multibyte string within rune maximum", func(t *testing.T) {
+		err := MaxLen("héllo", nil, map[string]args.Arg{
+			"runes": {Value: "runes"},
+			"5":     {Value: 5},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("UTF-16 mode counts surrogate pairs", func(t *testing.T) {
+		err := MaxLen("a👍", nil, map[string]args.Arg{
+			"utf16": {Value: "utf16"},
+			"2":     {Value: 2},
+		})
+		assert.EqualError(t, err, "maxlen validation failed: length 3 is greater than 2 (utf16)")
+	})
+
+	t.Run("Unsupported input type", func(t *testing.T) {
+		err := MaxLen(12345, nil, map[string]args.Arg{
+			"5": {Value: 5},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported type for len")
+	})
+}
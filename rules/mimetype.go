@@ -0,0 +1,159 @@
+package rules
+
+import (
+	"bytes"
+	"fmt"
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/functions"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// MimeType validates the media type of binary content, detected from its first bytes.
+// It is expected to be used in validation rules such as `mimetype:image/png,image/jpeg`, `mimetype:image/*`
+// or `mimetype:$AllowedTypes`.
+//
+// The media type is detected with an extended table of magic numbers, covering formats such as TIFF, HEIC, AVIF,
+// 7z and ELF, and then with http.DetectContentType, which implements the WHATWG MIME sniffing algorithm.
+// Media types are compared without their parameters, so that text/plain matches "text/plain; charset=utf-8",
+// and `type/*` allows every subtype of a type.
+//
+// Parameters:
+//   - input (any): The content being validated, a byte slice, a string, an io.Reader or a *multipart.FileHeader.
+//     Readers that implement io.Seeker are rewound after reading, other readers are consumed.
+//   - obj (any): The object containing additional data (can be used for Field references within the args).
+//   - arguments: The allowed media types, as values or Field references to strings or string slices.
+//
+// Returns nil if the input is valid, or an error if:
+//   - No media type is given
+//   - The input cannot be read
+//   - The detected media type is not allowed.
+//
+// Example:
+//
+//	input := []byte("GIF89a...")
+//	obj := nil
+//	args := map[string]Arg{
+//	    "image/png": {Value: "image/png"},
+//	}
+//	err := MimeType(input, obj, args)  // err will be: "mimetype validation failed: media type image/gif is not one of image/png"
+func MimeType(input any, obj any, arguments map[string]args.Arg) error {
+	var allowed []string
+	for key, arg := range arguments {
+		eval, err := arg.Evaluate(obj)
+		if err != nil {
+			return err
+		}
+
+		switch value := eval.(type) {
+		case string:
+			allowed = append(allowed, strings.ToLower(value))
+		case []string:
+			for _, v := range value {
+				allowed = append(allowed, strings.ToLower(v))
+			}
+		default:
+			return fmt.Errorf("unsupported type for mimetype argument %s: %T", key, eval)
+		}
+	}
+
+	if len(allowed) == 0 {
+		return fmt.Errorf("mimetype expects at least one media type")
+	}
+
+	head, err := readContent(input, 512)
+	if err != nil {
+		return err
+	}
+
+	mediaType := detectMediaType(head)
+	if !mediaTypeAllowed(mediaType, allowed) {
+		sort.Strings(allowed)
+		return fmt.Errorf("mimetype validation failed: media type %s is not one of %s", mediaType, strings.Join(allowed, ", "))
+	}
+
+	return nil
+}
+
+// magicNumbers lists the signatures of formats that http.DetectContentType does not recognize.
+var magicNumbers = []struct {
+	offset    int
+	magic     string
+	mediaType string
+}{
+	{0, "II*\x00", "image/tiff"},
+	{0, "MM\x00*", "image/tiff"},
+	{4, "ftypavif", "image/avif"},
+	{4, "ftypavis", "image/avif"},
+	{4, "ftypheic", "image/heic"},
+	{4, "ftypheix", "image/heic"},
+	{4, "ftypmif1", "image/heif"},
+	{0, "8BPS", "image/vnd.adobe.photoshop"},
+	{0, "fLaC", "audio/flac"},
+	{0, "BZh", "application/x-bzip2"},
+	{0, "7z\xbc\xaf\x27\x1c", "application/x-7z-compressed"},
+	{0, "\xfd7zXZ\x00", "application/x-xz"},
+	{0, "\x28\xb5\x2f\xfd", "application/zstd"},
+	{257, "ustar", "application/x-tar"},
+	{0, "SQLite format 3\x00", "application/vnd.sqlite3"},
+	{0, "\x7fELF", "application/x-elf"},
+	{0, "MZ", "application/vnd.microsoft.portable-executable"},
+}
+
+// detectMediaType returns the lower case media type of the content, without parameters.
+func detectMediaType(head []byte) string {
+	for _, m := range magicNumbers {
+		if len(head) >= m.offset+len(m.magic) && string(head[m.offset:m.offset+len(m.magic)]) == m.magic {
+			return m.mediaType
+		}
+	}
+
+	mediaType, _, _ := strings.Cut(http.DetectContentType(head), ";")
+	return strings.ToLower(strings.TrimSpace(mediaType))
+}
+
+// withContent calls read with a reader for the content of a byte slice, a string, an io.Reader or a *multipart.FileHeader.
+// Readers that implement io.Seeker are rewound afterwards, so that other rules can read them again.
+func withContent(input any, read func(r io.Reader) error) error {
+	switch value := input.(type) {
+	case *multipart.FileHeader:
+		file, err := value.Open()
+		if err != nil {
+			return fmt.Errorf("failed to open %s: %w", value.Filename, err)
+		}
+		defer file.Close()
+		return read(file)
+	case io.ReadSeeker:
+		start, err := value.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return fmt.Errorf("failed to read content: %w", err)
+		}
+		defer value.Seek(start, io.SeekStart)
+		return read(value)
+	case io.Reader:
+		return read(value)
+	}
+
+	content, err := functions.GetBytes(input)
+	if err != nil {
+		return fmt.Errorf("expected a byte slice, io.Reader or *multipart.FileHeader, got %T", input)
+	}
+	return read(bytes.NewReader(content))
+}
+
+// readContent returns the first limit bytes of the content, see withContent for the supported inputs.
+func readContent(input any, limit int64) ([]byte, error) {
+	var head []byte
+	err := withContent(input, func(r io.Reader) error {
+		var err error
+		head, err = io.ReadAll(io.LimitReader(r, limit))
+		if err != nil {
+			return fmt.Errorf("failed to read content: %w", err)
+		}
+		return nil
+	})
+	return head, err
+}
@@ -0,0 +1,68 @@
+package rules
+
+import (
+	"bytes"
+	"go-runtimevalidation/args"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMimeType(t *testing.T) {
+	images := map[string]args.Arg{"image/png": {Value: "image/png"}, "image/jpeg": {Value: "image/jpeg"}}
+
+	t.Run("Allowed media types", func(t *testing.T) {
+		assert.NoError(t, MimeType(encodeImage(t, "png", 1, 1), nil, images))
+		assert.NoError(t, MimeType(bytes.NewReader(encodeImage(t, "jpeg", 1, 1)), nil, images))
+
+		err := MimeType(encodeImage(t, "gif", 1, 1), nil, images)
+		assert.EqualError(t, err, "mimetype validation failed: media type image/gif is not one of image/jpeg, image/png")
+	})
+
+	t.Run("Wildcards and parameters", func(t *testing.T) {
+		assert.NoError(t, MimeType(encodeImage(t, "gif", 1, 1), nil, map[string]args.Arg{"image/*": {Value: "image/*"}}))
+		assert.NoError(t, MimeType("plain text", nil, map[string]args.Arg{"text/plain": {Value: "text/plain"}}))
+		assert.NoError(t, MimeType([]byte("%PDF-1.7\n"), nil, map[string]args.Arg{"application/PDF": {Value: "application/PDF"}}))
+	})
+
+	t.Run("Extended magic numbers", func(t *testing.T) {
+		for content, mediaType := range map[string]string{
+			"II*\x00\x08\x00\x00\x00":          "image/tiff",
+			"\x00\x00\x00\x1cftypheic\x00\x00": "image/heic",
+			"\x00\x00\x00\x1cftypavif\x00\x00": "image/avif",
+			"7z\xbc\xaf\x27\x1c\x00\x04":       "application/x-7z-compressed",
+			"\x7fELF\x02\x01\x01\x00":          "application/x-elf",
+			"MZ\x90\x00\x03\x00\x00\x00":       "application/vnd.microsoft.portable-executable",
+		} {
+			assert.NoError(t, MimeType([]byte(content), nil, map[string]args.Arg{mediaType: {Value: mediaType}}), mediaType)
+		}
+
+		tar := make([]byte, 512)
+		copy(tar[257:], "ustar\x0000")
+		assert.NoError(t, MimeType(tar, nil, map[string]args.Arg{"application/x-tar": {Value: "application/x-tar"}}))
+	})
+
+	t.Run("Allowed types from field", func(t *testing.T) {
+		upload := struct{ Allowed []string }{Allowed: []string{"application/pdf", "image/png"}}
+		arguments := map[string]args.Arg{"$Allowed": {Type: args.FieldArg, Field: "Allowed"}}
+		assert.NoError(t, MimeType(encodeImage(t, "png", 1, 1), upload, arguments))
+		assert.Error(t, MimeType(encodeImage(t, "gif", 1, 1), upload, arguments))
+	})
+
+	t.Run("Readers", func(t *testing.T) {
+		// Seekable readers are rewound, so other rules can read the content again
+		reader := bytes.NewReader(encodeImage(t, "png", 1, 1))
+		assert.NoError(t, MimeType(reader, nil, images))
+		assert.Equal(t, int64(reader.Size()), int64(reader.Len()))
+
+		// Other readers are consumed
+		assert.NoError(t, MimeType(io.MultiReader(strings.NewReader("GIF89a")), nil, map[string]args.Arg{"image/gif": {Value: "image/gif"}}))
+	})
+
+	t.Run("Invalid arguments", func(t *testing.T) {
+		assert.EqualError(t, MimeType([]byte{}, nil, nil), "mimetype expects at least one media type")
+		assert.EqualError(t, MimeType(1.5, nil, images), "expected a byte slice, io.Reader or *multipart.FileHeader, got float64")
+	})
+}
@@ -4,21 +4,24 @@ import (
 	"fmt"
 	"go-runtimevalidation/args"
 	"go-runtimevalidation/functions"
+	"math/big"
+	"reflect"
 )
 
 // Min validates that the input is greater than or equal to a minimum value.
-// The input must be an integer or a type that can be converted into an integer.
+// The input must be a number: an integer, a float, a *big.Int, *big.Float or *big.Rat, or a decimal string.
+// Numbers are compared exactly, so that amounts such as "1000000000000000000000.01" and uint64 values above math.MaxInt64 keep their precision.
 // It checks against exactly one argument provided in the args map.
 //
 // Parameters:
-// - input: The value being validated, expected to be convertible to an integer.
+// - input: The value being validated, expected to be convertible to a number.
 // - obj: The object containing additional data (can be used for Field references within the args).
 // - args: A map of arguments where exactly one entry is expected to specify the minimum value.
 //
 // Returns nil if the input is valid, or an error if:
 // - The number of arguments is not equal to 1
-// - The input cannot be converted to an integer
-// - The argument cannot be converted to an integer
+// - The input cannot be converted to a number
+// - The argument cannot be converted to a number
 // - The input is less than the specified minimum value.
 //
 // Example:
@@ -48,22 +51,34 @@ func Min(input any, obj any, arguments map[string]args.Arg) error {
 	}
 
 	// Get the value of the input
-	lhs, err := functions.GetInt(input)
+	lhs, err := getComparedNumber(input)
 	if err != nil {
 		return fmt.Errorf("unsupported type for input field: %w", err)
 	}
 
 	// Get the value to compare against
-	rhs, err := functions.GetInt(eval)
+	rhs, err := getComparedNumber(eval)
 	if err != nil {
 		return fmt.Errorf("unsupported type for min argument: %w", err)
 	}
 
-	// Compare values
-	if lhs < rhs {
-		return fmt.Errorf("min validation failed: %d < %d", lhs, rhs)
+	// Compare values exactly
+	if lhs.Cmp(rhs) < 0 {
+		return fmt.Errorf("min validation failed: %s < %s", functions.FormatNumber(lhs), functions.FormatNumber(rhs))
 	}
 
 	// Validation passed
 	return nil
 }
+
+// getComparedNumber converts the input or an argument of min, max and between with functions.GetNumber.
+// These rules compared int64 values before, so a string that is not a number keeps the error of functions.GetInt.
+func getComparedNumber(value any) (*big.Rat, error) {
+	number, err := functions.GetNumber(value)
+	if err != nil && reflect.ValueOf(value).Kind() == reflect.String {
+		if _, intErr := functions.GetInt(value); intErr != nil {
+			return nil, intErr
+		}
+	}
+	return number, err
+}
@@ -2,6 +2,8 @@ package rules
 
 import (
 	"go-runtimevalidation/args"
+	"math"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -67,4 +69,40 @@ func TestMin(t *testing.T) {
 		assert.Error(t, err)
 		assert.EqualError(t, err, "unsupported type for min argument: failed to parse \"test\" of type string as int64")
 	})
+
+	// Test case 7: Big numbers and decimal strings are compared exactly
+	t.Run("Arbitrary precision", func(t *testing.T) {
+		err := Min("1000000000000000000000.01", nil, map[string]args.Arg{
+			"1000000000000000000000.02": {Value: "1000000000000000000000.02"},
+		})
+		assert.EqualError(t, err, "min validation failed: 1000000000000000000000.01 < 1000000000000000000000.02")
+
+		err = Min(uint64(math.MaxUint64), nil, map[string]args.Arg{
+			"0": {Value: 0},
+		})
+		assert.NoError(t, err)
+
+		err = Min(big.NewRat(1, 3), nil, map[string]args.Arg{
+			"0.34": {Value: 0.34},
+		})
+		assert.EqualError(t, err, "min validation failed: 1/3 < 0.34")
+
+		err = Min(big.NewFloat(0.5), nil, map[string]args.Arg{
+			"0.5": {Value: "0.5"},
+		})
+		assert.NoError(t, err)
+	})
+
+	// Test case 8: Integer strings keep their base prefix, as in `min:0x10`
+	t.Run("Base-prefixed bound", func(t *testing.T) {
+		err := Min(16, nil, map[string]args.Arg{
+			"0x10": {Value: "0x10"},
+		})
+		assert.NoError(t, err)
+
+		err = Min(15, nil, map[string]args.Arg{
+			"0x10": {Value: "0x10"},
+		})
+		assert.EqualError(t, err, "min validation failed: 15 < 16")
+	})
 }
@@ -0,0 +1,67 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/functions"
+)
+
+// MinDuration validates that the input is a duration greater than or equal to a minimum duration.
+// It is expected to be used in validation rules such as `minduration:90m` or `minduration:$MinTimeout`.
+//
+// Parameters:
+// - input: The value being validated. It can be a time.Duration, an integer number of nanoseconds, or a string like "90m".
+// - obj: The object containing additional data (can be used for Field references within the args).
+// - args: A map containing exactly one argument, the minimum duration.
+//
+// Returns nil if the input is valid, or an error if:
+// - The number of arguments is not equal to 1
+// - The input or the argument cannot be converted to a duration
+// - The input is shorter than the minimum.
+//
+// Example:
+//
+//	input := "30m"
+//	obj := nil
+//	args := map[string]Arg{
+//	    "1h": {Value: "1h"},
+//	}
+//	err := MinDuration(input, obj, args)  // err will be: "minduration validation failed: 30m0s < 1h0m0s"
+func MinDuration(input any, obj any, arguments map[string]args.Arg) error {
+	if len(arguments) != 1 {
+		return fmt.Errorf("minduration expects exactly 1 argument, got %d", len(arguments))
+	}
+
+	// Get the first (and only) argument
+	var arg args.Arg
+	for _, v := range arguments {
+		arg = v
+		break
+	}
+
+	// Evaluate the argument
+	eval, err := arg.Evaluate(obj)
+	if err != nil {
+		return err
+	}
+
+	// Get the value of the input
+	lhs, err := functions.GetDuration(input)
+	if err != nil {
+		return fmt.Errorf("unsupported type for input field: %w", err)
+	}
+
+	// Get the value to compare against
+	rhs, err := functions.GetDuration(eval)
+	if err != nil {
+		return fmt.Errorf("unsupported type for minduration argument: %w", err)
+	}
+
+	// Compare values
+	if lhs < rhs {
+		return fmt.Errorf("minduration validation failed: %s < %s", lhs, rhs)
+	}
+
+	// Validation passed
+	return nil
+}
@@ -0,0 +1,44 @@
+package rules
+
+import (
+	"go-runtimevalidation/args"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMinDuration(t *testing.T) {
+	t.Run("Duration above minimum", func(t *testing.T) {
+		err := MinDuration("90m", nil, map[string]args.Arg{
+			"1h": {Value: "1h"},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Duration below minimum", func(t *testing.T) {
+		err := MinDuration(30*time.Minute, nil, map[string]args.Arg{
+			"1h": {Value: "1h"},
+		})
+		assert.EqualError(t, err, "minduration validation failed: 30m0s < 1h0m0s")
+	})
+
+	t.Run("Minimum from field", func(t *testing.T) {
+		obj := struct {
+			MinTimeout time.Duration
+		}{MinTimeout: time.Second}
+
+		err := MinDuration("1s", obj, map[string]args.Arg{
+			"$MinTimeout": {Type: args.FieldArg, Field: "MinTimeout"},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Invalid argument", func(t *testing.T) {
+		err := MinDuration("1s", nil, map[string]args.Arg{
+			"soon": {Value: "soon"},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported type for minduration argument")
+	})
+}
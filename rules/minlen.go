@@ -0,0 +1,78 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/functions"
+)
+
+// MinLen validates that the length of the input is greater than or equal to a minimum length.
+// It is expected to be used in validation rules such as `minlen:3`, `minlen:runes,3` or `minlen:$len($Other)`.
+//
+// An optional length mode (bytes, runes, graphemes or utf16) may be passed alongside the minimum
+// to choose how strings are measured. Without a mode, strings are measured in bytes.
+//
+// Parameters:
+// - input: The value whose length will be validated. This can be a string, array, map or slice.
+// - obj: The object containing additional data (can be used for Field references within the args).
+// - args: A map containing an `Arg` that specifies the minimum length, and optionally an `Arg` naming the length mode.
+//
+// Returns nil if the input is valid, or an error if:
+// - The number of arguments (besides the mode) is not equal to 1
+// - The input does not support a length
+// - The argument cannot be converted to an integer
+// - The length of the input is less than the specified minimum, in which case the error is a *LengthError with code CodeLengthTooShort.
+//
+// Example:
+//
+//	input := "héllo"
+//	obj := nil
+//	args := map[string]Arg{
+//	    "runes": Arg{Value: "runes"},
+//	    "6":     Arg{Value: 6},
+//	}
+//	err := MinLen(input, obj, args)  // err will be: "minlen validation failed: length 5 is less than 6 (runes)"
+func MinLen(input any, obj any, arguments map[string]args.Arg) error {
+	// Extract the optional length mode
+	mode, arguments, err := lenModeArg(arguments)
+	if err != nil {
+		return err
+	}
+
+	if len(arguments) != 1 {
+		return fmt.Errorf("minlen expects exactly 1 argument, got %d", len(arguments))
+	}
+
+	// Get the first (and only) argument
+	var arg args.Arg
+	for _, v := range arguments {
+		arg = v
+		break
+	}
+
+	// Evaluate the argument
+	eval, err := arg.Evaluate(obj)
+	if err != nil {
+		return err
+	}
+
+	// Get the length of the input
+	lhs, err := functions.GetLenMode(input, mode)
+	if err != nil {
+		return err
+	}
+
+	// Get the length to compare against
+	rhs, err := functions.GetInt(eval)
+	if err != nil {
+		return fmt.Errorf("unsupported type for minlen argument: %w", err)
+	}
+
+	// Compare lengths
+	if int64(lhs) < rhs {
+		return &LengthError{Code: CodeLengthTooShort, Length: lhs, Min: rhs, Unit: lengthUnit(input, mode)}
+	}
+
+	// Validation passed
+	return nil
+}
@@ -0,0 +1,50 @@
+package rules
+
+import (
+	"go-runtimevalidation/args"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMinLen(t *testing.T) {
+	t.Run("String longer than minimum", func(t *testing.T) {
+		err := MinLen("hello", nil, map[string]args.Arg{
+			"3": {Value: 3},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("String shorter than minimum", func(t *testing.T) {
+		err := MinLen("hi", nil, map[string]args.Arg{
+			"3": {Value: 3},
+		})
+		assert.EqualError(t, err, "minlen validation failed: length 2 is less than 3 (bytes)")
+	})
+
+	t.Run("Grapheme mode counts emoji as one character", func(t *testing.T) {
+		err := MinLen("👍🏽", nil, map[string]args.Arg{
+			"graphemes": {Value: "graphemes"},
+			"2":         {Value: 2},
+		})
+		assert.EqualError(t, err, "minlen validation failed: length 1 is less than 2 (graphemes)")
+	})
+
+	t.Run("Field reference", func(t *testing.T) {
+		obj := struct {
+			Min int
+		}{Min: 2}
+
+		err := MinLen([]int{1, 2}, obj, map[string]args.Arg{
+			"$Min": {Type: args.FieldArg, Field: "Min"},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Missing argument", func(t *testing.T) {
+		err := MinLen("hello", nil, map[string]args.Arg{
+			"runes": {Value: "runes"},
+		})
+		assert.EqualError(t, err, "minlen expects exactly 1 argument, got 0")
+	})
+}
@@ -0,0 +1,72 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/functions"
+	"math/big"
+)
+
+// MultipleOf validates that the input is an exact multiple of a number.
+// It is expected to be used in validation rules such as `multipleof:5`, `multipleof:0.05` to check that an amount
+// is rounded to the nearest 5 cents, or `multipleof:$LotSize`.
+//
+// The input and the argument are converted with functions.GetNumber and divided exactly, so that 0.3 is a multiple of 0.1
+// even though neither has an exact float64 representation.
+//
+// Parameters:
+// - input: The value being validated. It can be an integer, a float, a *big.Int, *big.Float or *big.Rat, or a decimal string.
+// - obj: The object containing additional data (can be used for Field references within the args).
+// - args: A map containing exactly one argument, the non-zero number the input must be a multiple of.
+//
+// Returns nil if the input is valid, or an error if:
+// - The number of arguments is not equal to 1
+// - The input or the argument cannot be converted to a number, or the argument is zero
+// - The input is not a multiple of the argument.
+//
+// Example:
+//
+//	input := 10.03
+//	obj := nil
+//	args := map[string]Arg{
+//	    "0.05": {Value: 0.05},
+//	}
+//	err := MultipleOf(input, obj, args)  // err will be: "multipleof validation failed: 10.03 is not a multiple of 0.05"
+func MultipleOf(input any, obj any, arguments map[string]args.Arg) error {
+	if len(arguments) != 1 {
+		return fmt.Errorf("multipleof expects exactly 1 argument, got %d", len(arguments))
+	}
+
+	// Get the first (and only) argument
+	var arg args.Arg
+	for _, v := range arguments {
+		arg = v
+		break
+	}
+
+	// Evaluate the argument
+	eval, err := arg.Evaluate(obj)
+	if err != nil {
+		return err
+	}
+
+	divisor, err := functions.GetNumber(eval)
+	if err != nil {
+		return fmt.Errorf("unsupported type for multipleof argument: %w", err)
+	}
+	if divisor.Sign() == 0 {
+		return fmt.Errorf("multipleof argument must not be zero")
+	}
+
+	// Get the value of the input
+	value, err := functions.GetNumber(input)
+	if err != nil {
+		return fmt.Errorf("unsupported type for input field: %w", err)
+	}
+
+	if !new(big.Rat).Quo(value, divisor).IsInt() {
+		return fmt.Errorf("multipleof validation failed: %s is not a multiple of %s", functions.FormatNumber(value), functions.FormatNumber(divisor))
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package rules
+
+import (
+	"go-runtimevalidation/args"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultipleOf(t *testing.T) {
+	t.Run("Multiples", func(t *testing.T) {
+		assert.NoError(t, MultipleOf(15, nil, map[string]args.Arg{"5": {Value: 5}}))
+		assert.NoError(t, MultipleOf(-15, nil, map[string]args.Arg{"5": {Value: 5}}))
+		assert.NoError(t, MultipleOf(0, nil, map[string]args.Arg{"5": {Value: 5}}))
+		assert.NoError(t, MultipleOf(0.3, nil, map[string]args.Arg{"0.1": {Value: 0.1}}))
+		assert.NoError(t, MultipleOf("10.05", nil, map[string]args.Arg{"0.05": {Value: 0.05}}))
+	})
+
+	t.Run("Not multiples", func(t *testing.T) {
+		err := MultipleOf(10.03, nil, map[string]args.Arg{"0.05": {Value: 0.05}})
+		assert.EqualError(t, err, "multipleof validation failed: 10.03 is not a multiple of 0.05")
+
+		err = MultipleOf(7, nil, map[string]args.Arg{"2": {Value: 2}})
+		assert.EqualError(t, err, "multipleof validation failed: 7 is not a multiple of 2")
+	})
+
+	t.Run("Big numbers", func(t *testing.T) {
+		n, _ := new(big.Int).SetString("100000000000000000000000000001", 10)
+		err := MultipleOf(n, nil, map[string]args.Arg{"10": {Value: 10}})
+		assert.EqualError(t, err, "multipleof validation failed: 100000000000000000000000000001 is not a multiple of 10")
+
+		thirds := struct{ Third *big.Rat }{Third: big.NewRat(1, 3)}
+		assert.NoError(t, MultipleOf(big.NewRat(2, 3), thirds, map[string]args.Arg{"$Third": {Type: args.FieldArg, Field: "Third"}}))
+	})
+
+	t.Run("Field reference", func(t *testing.T) {
+		lot := struct{ LotSize string }{LotSize: "0.001"}
+		arguments := map[string]args.Arg{"$LotSize": {Type: args.FieldArg, Field: "LotSize"}}
+		assert.NoError(t, MultipleOf("12.345", lot, arguments))
+		assert.Error(t, MultipleOf("12.3455", lot, arguments))
+	})
+
+	t.Run("Invalid arguments", func(t *testing.T) {
+		err := MultipleOf(10, nil, map[string]args.Arg{"0": {Value: 0}})
+		assert.EqualError(t, err, "multipleof argument must not be zero")
+
+		err = MultipleOf(10, nil, map[string]args.Arg{"five": {Value: "five"}})
+		assert.EqualError(t, err, `unsupported type for multipleof argument: failed to parse "five" of type string as a number`)
+
+		err = MultipleOf("ten", nil, map[string]args.Arg{"5": {Value: 5}})
+		assert.EqualError(t, err, `unsupported type for input field: failed to parse "ten" of type string as a number`)
+
+		err = MultipleOf(10, nil, map[string]args.Arg{})
+		assert.EqualError(t, err, "multipleof expects exactly 1 argument, got 0")
+	})
+}
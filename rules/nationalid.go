@@ -0,0 +1,375 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/functions"
+	"go-runtimevalidation/regex"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// nationalIDCheck validates a normalized identifier: upper case, without spaces, dots, hyphens and slashes.
+type nationalIDCheck func(id string) error
+
+// nationalIDs lists the supported identifiers by ISO 3166-1 alpha-2 country code and kind.
+var nationalIDs = map[string]map[string]nationalIDCheck{
+	"BR": {"cpf": checkCPF, "cnpj": checkCNPJ},
+	"CA": {"sin": checkSIN},
+	"ES": {"dni": checkDNI, "nie": checkNIE},
+	"GB": {"nino": checkNINO},
+	"IN": {"pan": checkPAN, "aadhaar": checkAadhaar},
+	"US": {"ssn": checkSSN},
+}
+
+func init() {
+	// EU VAT numbers are registered from the VAT table
+	for country, check := range vatChecks {
+		if nationalIDs[country] == nil {
+			nationalIDs[country] = map[string]nationalIDCheck{}
+		}
+		nationalIDs[country]["vat"] = vatCheck(country, check)
+	}
+}
+
+// nationalIDKind reports whether name is the kind of any supported identifier, e.g. "vat" or "dni".
+func nationalIDKind(name string) bool {
+	for _, kinds := range nationalIDs {
+		if _, ok := kinds[name]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+var nationalIDSeparators = strings.NewReplacer(" ", "", ".", "", "-", "", "/", "")
+
+// NationalID validates that the input is a national identifier of the given country and kind, including its check digits.
+// It is expected to be used in validation rules such as `nationalid:ES,dni` or `nationalid:$Country,vat`.
+// Without a kind, e.g. `nationalid:$Country`, any supported identifier of the country is accepted.
+//
+// Supported identifiers:
+// - BR: cpf, cnpj
+// - CA: sin
+// - ES: dni, nie, vat (NIF, including CIF for companies)
+// - GB: nino
+// - IN: pan, aadhaar (Verhoeff check digit)
+// - US: ssn
+// - vat for AT, BE, DE, DK, EE, ES, FI, FR, GR, HR, IE, IT, LU, NL, PL, PT, SE and SI,
+// with or without the country prefix ("EL" for Greece).
+//
+// Spaces, dots, hyphens and slashes are ignored and letters are compared case-insensitively.
+// Error messages do not contain the identifier.
+//
+// Parameters:
+// - input: The value being validated, expected to be a string.
+// - obj: The object containing additional data (can be used for Field references within the args).
+// - args: A map containing the country and, optionally, the kind, in any order.
+//
+// Returns nil if the input is valid, or an error if:
+// - The country or kind is missing, unknown or given twice
+// - The input does not have the format of the identifier
+// - The check digits are wrong.
+//
+// Example:
+//
+//	input := "12345678A"
+//	obj := nil
+//	args := map[string]Arg{
+//	    "ES":  {Value: "ES"},
+//	    "dni": {Value: "dni"},
+//	}
+//	err := NationalID(input, obj, args)  // err will be: "nationalid validation failed: invalid ES dni: invalid check letter"
+func NationalID(input any, obj any, arguments map[string]args.Arg) error {
+	if len(arguments) == 0 || len(arguments) > 2 {
+		return fmt.Errorf("nationalid expects a country and an optional kind, got %d arguments", len(arguments))
+	}
+
+	// Arguments are unordered, so the kind is recognized by its name
+	country, kind := "", ""
+	for _, v := range arguments {
+		eval, err := v.Evaluate(obj)
+		if err != nil {
+			return err
+		}
+
+		value, err := functions.GetString(eval)
+		if err != nil {
+			return fmt.Errorf("unsupported type for nationalid argument: %w", err)
+		}
+
+		if nationalIDKind(strings.ToLower(value)) {
+			if kind != "" {
+				return fmt.Errorf("nationalid expects at most 1 kind, got %s and %s", kind, strings.ToLower(value))
+			}
+			kind = strings.ToLower(value)
+		} else {
+			if country != "" {
+				return fmt.Errorf("nationalid expects exactly 1 country, got %s and %s", country, strings.ToUpper(value))
+			}
+			country = strings.ToUpper(value)
+		}
+	}
+
+	if country == "" {
+		return fmt.Errorf("nationalid expects a country argument")
+	}
+
+	kinds, ok := nationalIDs[country]
+	if !ok {
+		return fmt.Errorf("nationalid: no identifiers supported for country %s", country)
+	}
+
+	// Check if the input is a string
+	value, ok := input.(string)
+	if !ok {
+		return fmt.Errorf("expected a string, got %T", input)
+	}
+	id := strings.ToUpper(nationalIDSeparators.Replace(value))
+
+	if kind != "" {
+		check, ok := kinds[kind]
+		if !ok {
+			return fmt.Errorf("nationalid: %s is not supported for country %s", kind, country)
+		}
+		if err := check(id); err != nil {
+			return fmt.Errorf("nationalid validation failed: invalid %s %s: %w", country, kind, err)
+		}
+		return nil
+	}
+
+	names := make([]string, 0, len(kinds))
+	for name, check := range kinds {
+		if check(id) == nil {
+			return nil
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return fmt.Errorf("nationalid validation failed: not a valid %s %s", country, strings.Join(names, " or "))
+}
+
+var (
+	errIDFormat = fmt.Errorf("invalid format")
+	errIDCheck  = fmt.Errorf("invalid check digit")
+)
+
+// isDigits reports whether s is a non-empty string of ASCII digits.
+func isDigits(s string) bool {
+	return s != "" && strings.Trim(s, "0123456789") == ""
+}
+
+// weightedSum multiplies each digit of s with the weight at the same position and sums the products.
+func weightedSum(s string, weights ...int) int {
+	sum := 0
+	for i, w := range weights {
+		sum += int(s[i]-'0') * w
+	}
+	return sum
+}
+
+// allSame reports whether all characters of s are equal, which is a valid checksum for
+// many weighted schemes but never an issued number.
+func allSame(s string) bool {
+	return strings.Count(s, s[:1]) == len(s)
+}
+
+// checkSSN validates a US Social Security Number: the area cannot be 000, 666 or 900-999,
+// the group cannot be 00 and the serial cannot be 0000. SSNs carry no check digit.
+func checkSSN(id string) error {
+	if len(id) != 9 || !isDigits(id) {
+		return errIDFormat
+	}
+	area := id[:3]
+	if area == "000" || area == "666" || area[0] == '9' || id[3:5] == "00" || id[5:] == "0000" {
+		return fmt.Errorf("invalid number range")
+	}
+	return nil
+}
+
+// ninoRegex matches a UK National Insurance number; the prefixes BG, GB, KN, NK, NT, TN and ZZ are not allocated.
+var ninoRegex = regex.CompileOnce(`^[A-CEGHJ-PR-TW-Z][A-CEGHJ-NPR-TW-Z][0-9]{6}[A-D]$`)
+
+// checkNINO validates a UK National Insurance number. NINOs carry no check digit.
+func checkNINO(id string) error {
+	if !ninoRegex().MatchString(id) {
+		return errIDFormat
+	}
+	switch id[:2] {
+	case "BG", "GB", "KN", "NK", "NT", "TN", "ZZ":
+		return fmt.Errorf("invalid prefix")
+	}
+	return nil
+}
+
+// checkSIN validates a Canadian Social Insurance Number: 9 digits with a Luhn check digit.
+// Numbers starting with 0 or 8 are not assigned to individuals.
+func checkSIN(id string) error {
+	if len(id) != 9 || !isDigits(id) || id[0] == '0' || id[0] == '8' {
+		return errIDFormat
+	}
+	if !luhn(id) {
+		return errIDCheck
+	}
+	return nil
+}
+
+// checkCPF validates a Brazilian individual taxpayer number: 11 digits with two mod-11 check digits.
+func checkCPF(id string) error {
+	if len(id) != 11 || !isDigits(id) || allSame(id) {
+		return errIDFormat
+	}
+	for n := 9; n <= 10; n++ {
+		sum := 0
+		for i := 0; i < n; i++ {
+			sum += int(id[i]-'0') * (n + 1 - i)
+		}
+		if sum*10%11%10 != int(id[n]-'0') {
+			return errIDCheck
+		}
+	}
+	return nil
+}
+
+// checkCNPJ validates a Brazilian company number: 14 digits with two mod-11 check digits.
+func checkCNPJ(id string) error {
+	if len(id) != 14 || !isDigits(id) || allSame(id) {
+		return errIDFormat
+	}
+	weights := []int{6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
+	for n := 12; n <= 13; n++ {
+		r := weightedSum(id, weights[13-n:]...) % 11
+		check := 0
+		if r >= 2 {
+			check = 11 - r
+		}
+		if check != int(id[n]-'0') {
+			return errIDCheck
+		}
+	}
+	return nil
+}
+
+// panRegex matches an Indian Permanent Account Number; the fourth letter is the holder type (P for persons, C for companies, ...).
+var panRegex = regex.CompileOnce(`^[A-Z]{3}[ABCFGHJLPT][A-Z][0-9]{4}[A-Z]$`)
+
+// checkPAN validates the format of an Indian Permanent Account Number. The check letter algorithm is not public.
+func checkPAN(id string) error {
+	if !panRegex().MatchString(id) {
+		return errIDFormat
+	}
+	return nil
+}
+
+// checkAadhaar validates an Indian Aadhaar number: 12 digits, not starting with 0 or 1, with a Verhoeff check digit.
+func checkAadhaar(id string) error {
+	if len(id) != 12 || !isDigits(id) || id[0] < '2' {
+		return errIDFormat
+	}
+	if !verhoeff(id) {
+		return errIDCheck
+	}
+	return nil
+}
+
+var (
+	verhoeffD = [10][10]int{
+		{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+		{1, 2, 3, 4, 0, 6, 7, 8, 9, 5},
+		{2, 3, 4, 0, 1, 7, 8, 9, 5, 6},
+		{3, 4, 0, 1, 2, 8, 9, 5, 6, 7},
+		{4, 0, 1, 2, 3, 9, 5, 6, 7, 8},
+		{5, 9, 8, 7, 6, 0, 4, 3, 2, 1},
+		{6, 5, 9, 8, 7, 1, 0, 4, 3, 2},
+		{7, 6, 5, 9, 8, 2, 1, 0, 4, 3},
+		{8, 7, 6, 5, 9, 3, 2, 1, 0, 4},
+		{9, 8, 7, 6, 5, 4, 3, 2, 1, 0},
+	}
+	verhoeffP = [8][10]int{
+		{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+		{1, 5, 7, 6, 2, 8, 3, 0, 9, 4},
+		{5, 8, 0, 3, 7, 9, 6, 1, 4, 2},
+		{8, 9, 1, 6, 0, 4, 3, 5, 2, 7},
+		{9, 4, 5, 3, 1, 2, 6, 8, 7, 0},
+		{4, 2, 8, 6, 5, 7, 3, 9, 0, 1},
+		{2, 7, 9, 3, 8, 0, 6, 4, 1, 5},
+		{7, 0, 4, 6, 9, 1, 3, 2, 5, 8},
+	}
+)
+
+// verhoeff reports whether a digit string ends with a valid Verhoeff check digit.
+func verhoeff(digits string) bool {
+	c := 0
+	for i := 0; i < len(digits); i++ {
+		c = verhoeffD[c][verhoeffP[i%8][digits[len(digits)-1-i]-'0']]
+	}
+	return c == 0
+}
+
+// dniLetters maps the remainder of a Spanish DNI number modulo 23 to its check letter.
+const dniLetters = "TRWAGMYFPDXBNJZSQVHLCKE"
+
+// checkDNI validates a Spanish national identity number: 8 digits and a check letter.
+func checkDNI(id string) error {
+	if len(id) != 9 || !isDigits(id[:8]) {
+		return errIDFormat
+	}
+	n, _ := strconv.Atoi(id[:8])
+	if dniLetters[n%23] != id[8] {
+		return fmt.Errorf("invalid check letter")
+	}
+	return nil
+}
+
+// checkNIE validates a Spanish foreigner identity number: X, Y or Z, 7 digits and a check letter.
+// The leading letter stands for the digit 0, 1 or 2 in the DNI algorithm.
+func checkNIE(id string) error {
+	if len(id) != 9 || strings.IndexByte("XYZ", id[0]) < 0 {
+		return errIDFormat
+	}
+	return checkDNI(strconv.Itoa(strings.IndexByte("XYZ", id[0])) + id[1:])
+}
+
+// cifRegex matches a Spanish company tax number: an entity letter, 7 digits and a check digit or letter.
+var cifRegex = regex.CompileOnce(`^[ABCDEFGHJNPQRSUVW][0-9]{7}[0-9A-J]$`)
+
+// checkNIF validates a Spanish tax number, which is a DNI for citizens, a NIE for foreigners,
+// a K, L or M number for special cases, or a CIF for companies.
+func checkNIF(id string) error {
+	if len(id) != 9 {
+		return errIDFormat
+	}
+	switch {
+	case isDigits(id[:8]):
+		return checkDNI(id)
+	case strings.IndexByte("XYZ", id[0]) >= 0:
+		return checkNIE(id)
+	case strings.IndexByte("KLM", id[0]) >= 0:
+		return checkDNI("0" + id[1:])
+	case !cifRegex().MatchString(id):
+		return errIDFormat
+	}
+
+	// CIF: digits in odd positions are doubled and their digits summed
+	sum := 0
+	for i := 1; i <= 7; i++ {
+		d := int(id[i] - '0')
+		if i%2 == 1 {
+			d *= 2
+			d = d/10 + d%10
+		}
+		sum += d
+	}
+	check := (10 - sum%10) % 10
+	letter := "JABCDEFGHI"[check]
+
+	switch {
+	case strings.IndexByte("PQRSNW", id[0]) >= 0 && id[8] == letter:
+	case strings.IndexByte("ABEH", id[0]) >= 0 && id[8] == byte('0'+check):
+	case strings.IndexByte("PQRSNWABEH", id[0]) < 0 && (id[8] == letter || id[8] == byte('0'+check)):
+	default:
+		return errIDCheck
+	}
+	return nil
+}
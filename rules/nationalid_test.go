@@ -0,0 +1,133 @@
+package rules
+
+import (
+	"go-runtimevalidation/args"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func nationalIDArgs(values ...string) map[string]args.Arg {
+	arguments := map[string]args.Arg{}
+	for _, v := range values {
+		arguments[v] = args.Arg{Value: v}
+	}
+	return arguments
+}
+
+func TestNationalID(t *testing.T) {
+	vectors := []struct {
+		country, kind string
+		valid         []string
+		invalid       []string
+	}{
+		{"US", "ssn", []string{"123-45-6789", "078051120"}, []string{"000-12-3456", "666-12-3456", "912-34-5678", "123-00-4567", "123-45-0000", "12-345-678"}},
+		{"GB", "nino", []string{"AB 12 34 56 C", "ab123456c"}, []string{"GB123456A", "DA123456A", "AB123456E", "AB12345C"}},
+		{"CA", "sin", []string{"130 692 544", "130-692-544"}, []string{"130 692 545", "046 454 286", "830 692 544", "13069254"}},
+		{"BR", "cpf", []string{"529.982.247-25", "52998224725"}, []string{"529.982.247-24", "111.111.111-11", "5299822472"}},
+		{"BR", "cnpj", []string{"11.222.333/0001-81"}, []string{"11.222.333/0001-80", "00.000.000/0000-00"}},
+		{"IN", "pan", []string{"ABCPE1234F"}, []string{"ABCXE1234F", "ABCPE12345"}},
+		{"IN", "aadhaar", []string{"2341 2341 2346"}, []string{"2341 2341 2345", "1341 2341 2346", "23412341234"}},
+		{"ES", "dni", []string{"12345678Z", "12345678-z"}, []string{"12345678A", "1234567Z"}},
+		{"ES", "nie", []string{"X1234567L", "Y0000000Z"}, []string{"X1234567A", "A1234567L"}},
+		{"ES", "vat", []string{"ESA58818501", "B58378431", "Q2826000H", "ES12345678Z", "X1234567L"}, []string{"ESA58818502", "Q28260008", "ES12345678A"}},
+		{"AT", "vat", []string{"ATU13585627", "U13585627"}, []string{"ATU13585626", "AT13585627"}},
+		{"BE", "vat", []string{"BE0403170701", "BE 0776.091.951", "403170701"}, []string{"BE0403170702", "BE2403170701"}},
+		{"DE", "vat", []string{"DE136695976", "136695976"}, []string{"DE136695977", "DE036695976"}},
+		{"DK", "vat", []string{"DK13585628"}, []string{"DK13585627"}},
+		{"EE", "vat", []string{"EE100931558"}, []string{"EE100931557", "EE200931558"}},
+		{"FI", "vat", []string{"FI20774740"}, []string{"FI20774741"}},
+		{"FR", "vat", []string{"FR40303265045", "FRK7399859412"}, []string{"FR41303265045", "FRIO303265045"}},
+		{"GR", "vat", []string{"EL094259216", "094259216"}, []string{"EL094259217"}},
+		{"HR", "vat", []string{"HR33392005961"}, []string{"HR33392005962"}},
+		{"IE", "vat", []string{"IE6388047V", "IE1234567FA"}, []string{"IE6388047W", "IE1234567FZ"}},
+		{"IT", "vat", []string{"IT00743110157"}, []string{"IT00743110158", "IT00000001234"}},
+		{"LU", "vat", []string{"LU26375245"}, []string{"LU26375246"}},
+		{"NL", "vat", []string{"NL004495445B01", "NL000099998B57"}, []string{"NL004495446B01", "NL004495445C01"}},
+		{"PL", "vat", []string{"PL5260250274"}, []string{"PL5260250275"}},
+		{"PT", "vat", []string{"PT501964843"}, []string{"PT501964844"}},
+		{"SE", "vat", []string{"SE556188840401"}, []string{"SE556188840501", "SE556188840402"}},
+		{"SI", "vat", []string{"SI50223054"}, []string{"SI50223055"}},
+	}
+
+	for _, v := range vectors {
+		t.Run(v.country+" "+v.kind, func(t *testing.T) {
+			arguments := nationalIDArgs(v.country, v.kind)
+			for _, id := range v.valid {
+				assert.NoError(t, NationalID(id, nil, arguments), id)
+			}
+			for _, id := range v.invalid {
+				assert.Error(t, NationalID(id, nil, arguments), id)
+			}
+		})
+	}
+
+	t.Run("Error does not contain the identifier", func(t *testing.T) {
+		err := NationalID("12345678A", nil, nationalIDArgs("ES", "dni"))
+		assert.Error(t, err)
+		assert.Equal(t, "nationalid validation failed: invalid ES dni: invalid check letter", err.Error())
+	})
+
+	t.Run("Field references in any order", func(t *testing.T) {
+		obj := struct{ Country, Kind string }{Country: "br", Kind: "CPF"}
+		arguments := map[string]args.Arg{
+			"$Kind":    {Type: args.FieldArg, Field: "Kind"},
+			"$Country": {Type: args.FieldArg, Field: "Country"},
+		}
+		assert.NoError(t, NationalID("529.982.247-25", obj, arguments))
+	})
+
+	t.Run("Any kind of the country", func(t *testing.T) {
+		arguments := nationalIDArgs("ES")
+		assert.NoError(t, NationalID("12345678Z", nil, arguments))
+		assert.NoError(t, NationalID("X1234567L", nil, arguments))
+
+		err := NationalID("12345678A", nil, arguments)
+		assert.Error(t, err)
+		assert.Equal(t, "nationalid validation failed: not a valid ES dni or nie or vat", err.Error())
+	})
+
+	t.Run("Unsupported country or kind", func(t *testing.T) {
+		err := NationalID("123", nil, nationalIDArgs("JP", "vat"))
+		assert.Error(t, err)
+		assert.Equal(t, "nationalid: no identifiers supported for country JP", err.Error())
+
+		err = NationalID("123", nil, nationalIDArgs("US", "vat"))
+		assert.Error(t, err)
+		assert.Equal(t, "nationalid: vat is not supported for country US", err.Error())
+	})
+
+	t.Run("Invalid arguments", func(t *testing.T) {
+		err := NationalID("123", nil, nationalIDArgs("dni"))
+		assert.Error(t, err)
+		assert.Equal(t, "nationalid expects a country argument", err.Error())
+
+		err = NationalID("123", nil, nationalIDArgs("ES", "FR"))
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "nationalid expects exactly 1 country")
+
+		err = NationalID("123", nil, nationalIDArgs())
+		assert.Error(t, err)
+		assert.Equal(t, "nationalid expects a country and an optional kind, got 0 arguments", err.Error())
+	})
+
+	t.Run("Invalid input type", func(t *testing.T) {
+		err := NationalID(12345678, nil, nationalIDArgs("US", "ssn"))
+		assert.Error(t, err)
+		assert.Equal(t, "expected a string, got int", err.Error())
+	})
+}
+
+func TestVerhoeff(t *testing.T) {
+	assert.True(t, verhoeff("2363"))
+	assert.False(t, verhoeff("2364"))
+}
+
+func TestVATPrefix(t *testing.T) {
+	err := NationalID("DE136695976", nil, nationalIDArgs("FR", "vat"))
+	assert.Error(t, err)
+	assert.Equal(t, "nationalid validation failed: invalid FR vat: country prefix DE does not match FR", err.Error())
+
+	assert.NoError(t, NationalID("EL094259216", nil, nationalIDArgs("GR", "vat")))
+	assert.Error(t, NationalID("GR094259216", nil, nationalIDArgs("GR", "vat")))
+}
@@ -0,0 +1,35 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/functions"
+)
+
+// Negative validates that the input is a number less than zero.
+//
+// The input is converted with functions.GetNumber, so that it works across all integer and float kinds,
+// *big.Int, *big.Float and *big.Rat values and decimal strings.
+//
+// Parameters:
+// - input: The value being validated. It can be an integer, a float, a *big.Int, *big.Float or *big.Rat, or a decimal string.
+//
+// Returns an error if:
+// - The input is not a finite number
+// - The input is zero or positive.
+//
+// Example:
+//
+//	err := Negative(-1.5)  // err will be nil
+//	err := Negative("2")  // err will be: "negative validation failed: 2 is not negative"
+func Negative(input any) error {
+	value, err := functions.GetNumber(input)
+	if err != nil {
+		return fmt.Errorf("unsupported type for input field: %w", err)
+	}
+
+	if value.Sign() >= 0 {
+		return fmt.Errorf("negative validation failed: %s is not negative", functions.FormatNumber(value))
+	}
+
+	return nil
+}
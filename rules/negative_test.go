@@ -0,0 +1,70 @@
+package rules
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNegative(t *testing.T) {
+	// Test case 1: Negative integer
+	t.Run("Negative integer", func(t *testing.T) {
+		err := Negative(-1)
+		assert.NoError(t, err)
+	})
+
+	// Test case 2: Negative float32
+	t.Run("Negative float32", func(t *testing.T) {
+		err := Negative(float32(-0.25))
+		assert.NoError(t, err)
+	})
+
+	// Test case 3: Negative decimal string
+	t.Run("Negative decimal string", func(t *testing.T) {
+		err := Negative("-0.01")
+		assert.NoError(t, err)
+	})
+
+	// Test case 4: Negative big.Int
+	t.Run("Negative big.Int", func(t *testing.T) {
+		err := Negative(big.NewInt(-5))
+		assert.NoError(t, err)
+	})
+
+	// Test case 5: Zero is not negative
+	t.Run("Zero", func(t *testing.T) {
+		err := Negative(0)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "negative validation failed: 0 is not negative")
+	})
+
+	// Test case 6: Negative zero is zero
+	t.Run("Negative zero", func(t *testing.T) {
+		err := Negative(math.Copysign(0, -1))
+		assert.Error(t, err)
+		assert.EqualError(t, err, "negative validation failed: 0 is not negative")
+	})
+
+	// Test case 7: Positive unsigned integer
+	t.Run("Positive unsigned integer", func(t *testing.T) {
+		err := Negative(uint(2))
+		assert.Error(t, err)
+		assert.EqualError(t, err, "negative validation failed: 2 is not negative")
+	})
+
+	// Test case 8: Negative infinity is not a finite number
+	t.Run("Negative infinity", func(t *testing.T) {
+		err := Negative(math.Inf(-1))
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unsupported type for input field: -Inf is not a finite number")
+	})
+
+	// Test case 9: Input is not a number
+	t.Run("Sign without digits", func(t *testing.T) {
+		err := Negative("-")
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unsupported type for input field: failed to parse \"-\" of type string as a number")
+	})
+}
@@ -0,0 +1,33 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/functions"
+)
+
+// NonZero validates that the input is a number other than zero, e.g. a divisor or an exchange rate.
+// Zero is detected exactly, so "0.000" and -0.0 are zero while 1e-20 is not.
+//
+// Parameters:
+// - input: The value being validated. It can be an integer, a float, a *big.Int, *big.Float or *big.Rat, or a decimal string.
+//
+// Returns an error if:
+// - The input is not a finite number
+// - The input is zero.
+//
+// Example:
+//
+//	err := NonZero(-3)  // err will be nil
+//	err := NonZero("0.00")  // err will be: "nonzero validation failed: value is zero"
+func NonZero(input any) error {
+	value, err := functions.GetNumber(input)
+	if err != nil {
+		return fmt.Errorf("unsupported type for input field: %w", err)
+	}
+
+	if value.Sign() == 0 {
+		return fmt.Errorf("nonzero validation failed: value is zero")
+	}
+
+	return nil
+}
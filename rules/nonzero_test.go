@@ -0,0 +1,77 @@
+package rules
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNonZero(t *testing.T) {
+	// Test case 1: Negative integer
+	t.Run("Negative integer", func(t *testing.T) {
+		err := NonZero(-3)
+		assert.NoError(t, err)
+	})
+
+	// Test case 2: Tiny float
+	t.Run("Tiny float", func(t *testing.T) {
+		err := NonZero(1e-20)
+		assert.NoError(t, err)
+	})
+
+	// Test case 3: Decimal string
+	t.Run("Decimal string", func(t *testing.T) {
+		err := NonZero("0.001")
+		assert.NoError(t, err)
+	})
+
+	// Test case 4: Big float
+	t.Run("Big float", func(t *testing.T) {
+		err := NonZero(big.NewFloat(2))
+		assert.NoError(t, err)
+	})
+
+	// Test case 5: Zero integer
+	t.Run("Zero integer", func(t *testing.T) {
+		err := NonZero(0)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "nonzero validation failed: value is zero")
+	})
+
+	// Test case 6: Zero with trailing decimals
+	t.Run("Zero decimal string", func(t *testing.T) {
+		err := NonZero("0.000")
+		assert.Error(t, err)
+		assert.EqualError(t, err, "nonzero validation failed: value is zero")
+	})
+
+	// Test case 7: Negative zero
+	t.Run("Negative zero", func(t *testing.T) {
+		err := NonZero(math.Copysign(0, -1))
+		assert.Error(t, err)
+		assert.EqualError(t, err, "nonzero validation failed: value is zero")
+	})
+
+	// Test case 8: Zero big.Int
+	t.Run("Zero big.Int", func(t *testing.T) {
+		err := NonZero(new(big.Int))
+		assert.Error(t, err)
+		assert.EqualError(t, err, "nonzero validation failed: value is zero")
+	})
+
+	// Test case 9: NaN is not a number
+	t.Run("NaN", func(t *testing.T) {
+		err := NonZero(math.NaN())
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unsupported type for input field: NaN is not a finite number")
+	})
+
+	// Test case 10: Input is not a number
+	t.Run("Boolean input", func(t *testing.T) {
+		err := NonZero(true)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unsupported type for input field: failed to parse true of type bool as a number")
+	})
+}
@@ -0,0 +1,50 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/args"
+	"regexp"
+)
+
+// NotRegex checks that the input string does not match a given regular expression pattern.
+// It is the counterpart of Regex and accepts the same argument, e.g. `notregex:$Pattern`.
+//
+// Parameters:
+// - input: the value to be validated (expected to be a string).
+// - obj : The struct object whose fields will be compared against the values in the args map.
+// - args: a map of arguments (expects one argument, the regex pattern).
+//
+// Returns:
+// - error: an error if the input matches the pattern, if the argument count/type is incorrect, or if the regex pattern is invalid.
+// Returns nil if validation passes.
+func NotRegex(input any, obj any, arguments map[string]args.Arg) error {
+	exp, err := regexArg("notregex", obj, arguments)
+	if err != nil {
+		return err
+	}
+
+	return NotMatchRegex(input, exp)
+}
+
+// NotMatchRegex checks that the input string does not match a precompiled regular expression.
+// It backs the `notregex:/pattern/flags` and `notregex:@name` forms.
+//
+// Parameters:
+// - input: the value to be validated (expected to be a string).
+// - exp: the compiled regular expression.
+//
+// Returns:
+// - error: an error if the input is not a string or matches the expression. Returns nil if validation passes.
+func NotMatchRegex(input any, exp *regexp.Regexp) error {
+	// Check if the input is a string
+	value, ok := input.(string)
+	if !ok {
+		return fmt.Errorf("expected a string, got %T", input)
+	}
+
+	if exp.MatchString(value) {
+		return fmt.Errorf("value %s matches regex %s", value, exp)
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package rules
+
+import (
+	"go-runtimevalidation/args"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotRegex(t *testing.T) {
+	t.Run("Input does not match pattern", func(t *testing.T) {
+		err := NotRegex("hello", nil, map[string]args.Arg{"pattern": {Value: "[0-9]"}})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Input matches pattern", func(t *testing.T) {
+		err := NotRegex("hello1", nil, map[string]args.Arg{"pattern": {Value: "[0-9]"}})
+		assert.EqualError(t, err, "value hello1 matches regex [0-9]")
+	})
+
+	t.Run("Pattern from field", func(t *testing.T) {
+		obj := struct {
+			Forbidden string
+		}{Forbidden: "^admin"}
+
+		err := NotRegex("administrator", obj, map[string]args.Arg{"$Forbidden": {Type: args.FieldArg, Field: "Forbidden"}})
+		assert.Error(t, err)
+	})
+
+	t.Run("Invalid regex pattern", func(t *testing.T) {
+		err := NotRegex("hello", nil, map[string]args.Arg{"pattern": {Value: "("}})
+		assert.EqualError(t, err, "invalid regex: (")
+	})
+
+	t.Run("No pattern provided", func(t *testing.T) {
+		err := NotRegex("hello", nil, map[string]args.Arg{})
+		assert.EqualError(t, err, "notregex expects exactly 1 argument, got 0")
+	})
+}
+
+func TestNotMatchRegex(t *testing.T) {
+	exp := regexp.MustCompile(`\s`)
+
+	t.Run("Input does not match compiled regex", func(t *testing.T) {
+		assert.NoError(t, NotMatchRegex("hello", exp))
+	})
+
+	t.Run("Input matches compiled regex", func(t *testing.T) {
+		assert.Error(t, NotMatchRegex("hello world", exp))
+	})
+
+	t.Run("Invalid input type", func(t *testing.T) {
+		assert.EqualError(t, NotMatchRegex(1.5, exp), "expected a string, got float64")
+	})
+}
@@ -0,0 +1,326 @@
+package rules
+
+import (
+	_ "embed"
+	"fmt"
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/functions"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+//go:embed data/common-passwords.txt
+var commonPasswordList string
+
+var (
+	commonPasswordsOnce sync.Once
+	commonPasswords     map[string]bool
+)
+
+// isCommonPassword reports whether the password is on the embedded deny list, ignoring case.
+func isCommonPassword(password string) bool {
+	commonPasswordsOnce.Do(func() {
+		commonPasswords = map[string]bool{}
+		for _, line := range strings.Split(commonPasswordList, "\n") {
+			if line != "" && !strings.HasPrefix(line, "#") {
+				commonPasswords[line] = true
+			}
+		}
+	})
+	return commonPasswords[strings.ToLower(password)]
+}
+
+// passwordPolicy is the set of requirements configured by the arguments of the password rule.
+type passwordPolicy struct {
+	min, max  int
+	upper     bool
+	lower     bool
+	digit     bool
+	special   bool
+	classes   int
+	maxRepeat int
+	maxSeq    int
+	entropy   float64
+	common    bool
+	fields    []passwordField
+}
+
+// passwordField is another field of the object that the password must not equal or contain.
+type passwordField struct {
+	name  string
+	value string
+}
+
+// defaultPasswordPolicy is used when the password rule has no arguments.
+var defaultPasswordPolicy = passwordPolicy{min: 8, common: true}
+
+// Password validates that the input satisfies a password policy and reports every unmet requirement.
+// It is expected to be used in validation rules such as `password` or
+// `password:min=12,upper,lower,digit,maxrepeat=2,maxseq=3,entropy=50,common,$Email,$Username`.
+//
+// Arguments (in any order):
+// - min=N, max=N: minimum and maximum length in characters (runes)
+// - upper, lower, digit, special: require an upper case letter, a lower case letter, a digit or a special character
+// - classes=N: require at least N of these four character classes
+// - maxrepeat=N: allow at most N identical characters in a row
+// - maxseq=N: allow at most N sequential characters in a row, such as "abc" or "321"
+// - entropy=N: require an estimated entropy of at least N bits (length × log2 of the character pool size)
+// - common: reject passwords on the embedded list of the 1000 most common passwords
+// - $Field: reject passwords that equal or contain the field's value, ignoring case (and, for email addresses, their local part)
+//
+// Without arguments, the policy is `min=8,common`.
+//
+// Parameters:
+// - input: The value being validated, expected to be a string.
+// - obj: The object containing additional data (can be used for Field references within the args).
+// - args: A map of policy arguments. May be empty.
+//
+// Returns nil if the input is valid, or an error if:
+// - An argument is not a known option or has an invalid value
+// - The input is not a string
+// - Any requirement is not met, as a *PasswordError listing all of them. The password is never part of the message.
+//
+// Example:
+//
+//	input := "Summer2024"
+//	obj := nil
+//	args := map[string]Arg{
+//	    "min=12":  {Value: "min=12"},
+//	    "special": {Value: "special"},
+//	}
+//	err := Password(input, obj, args)  // err will be: "password validation failed: must be at least 12 characters; must contain a special character"
+func Password(input any, obj any, arguments map[string]args.Arg) error {
+	policy, err := parsePasswordPolicy(obj, arguments)
+	if err != nil {
+		return err
+	}
+
+	// Check if the input is a string
+	value, ok := input.(string)
+	if !ok {
+		return fmt.Errorf("expected a string, got %T", input)
+	}
+
+	violations := policy.check(value)
+	if len(violations) > 0 {
+		return &PasswordError{Violations: violations}
+	}
+
+	return nil
+}
+
+// passwordOptions are the options of the password rule, Field references are compared with the password instead.
+var passwordOptions = args.Options{
+	Rule:   "password",
+	Values: []string{"min", "max", "classes", "maxrepeat", "maxseq", "entropy"},
+	Flags:  []string{"upper", "lower", "digit", "special", "common"},
+}
+
+// parsePasswordPolicy builds the policy from the rule arguments.
+func parsePasswordPolicy(obj any, arguments map[string]args.Arg) (passwordPolicy, error) {
+	if len(arguments) == 0 {
+		return defaultPasswordPolicy, nil
+	}
+
+	policy := passwordPolicy{}
+	for key, arg := range arguments {
+		if arg.Type == args.FieldArg {
+			eval, err := arg.Evaluate(obj)
+			if err != nil {
+				return policy, err
+			}
+			value, err := functions.GetString(eval)
+			if err != nil {
+				return policy, fmt.Errorf("unsupported type for password argument %s: %w", key, err)
+			}
+			policy.fields = append(policy.fields, passwordField{name: arg.Field, value: value})
+			continue
+		}
+
+		option, err := passwordOptions.Parse(key, arg)
+		if err != nil {
+			return policy, err
+		}
+
+		switch option.Name {
+		case "upper":
+			policy.upper = true
+		case "lower":
+			policy.lower = true
+		case "digit":
+			policy.digit = true
+		case "special":
+			policy.special = true
+		case "common":
+			policy.common = true
+		case "entropy":
+			bits, err := strconv.ParseFloat(option.Text, 64)
+			if err != nil || bits < 0 {
+				return policy, fmt.Errorf("password: invalid value for %s: %s", option.Name, option.Text)
+			}
+			policy.entropy = bits
+		default:
+			n, err := strconv.Atoi(option.Text)
+			if err != nil || n < 0 || (option.Name == "classes" && n > 4) {
+				return policy, fmt.Errorf("password: invalid value for %s: %s", option.Name, option.Text)
+			}
+			switch option.Name {
+			case "min":
+				policy.min = n
+			case "max":
+				policy.max = n
+			case "classes":
+				policy.classes = n
+			case "maxrepeat":
+				policy.maxRepeat = n
+			case "maxseq":
+				policy.maxSeq = n
+			}
+		}
+	}
+
+	// Report field violations in a stable order
+	sort.Slice(policy.fields, func(i, j int) bool { return policy.fields[i].name < policy.fields[j].name })
+
+	return policy, nil
+}
+
+// check returns the requirements of the policy the password does not meet.
+func (p passwordPolicy) check(password string) []PasswordViolation {
+	var violations []PasswordViolation
+	add := func(code string, format string, a ...any) {
+		violations = append(violations, PasswordViolation{Code: code, Message: fmt.Sprintf(format, a...)})
+	}
+
+	runes := []rune(password)
+	if p.min > 0 && len(runes) < p.min {
+		add(CodePasswordTooShort, "must be at least %d characters", p.min)
+	}
+	if p.max > 0 && len(runes) > p.max {
+		add(CodePasswordTooLong, "must be at most %d characters", p.max)
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSpecial, hasOther bool
+	for _, r := range runes {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsLetter(r):
+			hasOther = true
+		default:
+			hasSpecial = true
+		}
+	}
+
+	if p.upper && !hasUpper {
+		add(CodePasswordMissingUpper, "must contain an upper case letter")
+	}
+	if p.lower && !hasLower {
+		add(CodePasswordMissingLower, "must contain a lower case letter")
+	}
+	if p.digit && !hasDigit {
+		add(CodePasswordMissingDigit, "must contain a digit")
+	}
+	if p.special && !hasSpecial {
+		add(CodePasswordMissingSymbol, "must contain a special character")
+	}
+	if p.classes > 0 {
+		classes := 0
+		for _, has := range []bool{hasUpper, hasLower, hasDigit, hasSpecial} {
+			if has {
+				classes++
+			}
+		}
+		if classes < p.classes {
+			add(CodePasswordTooFewClasses, "must contain at least %d of upper case letters, lower case letters, digits and special characters", p.classes)
+		}
+	}
+
+	if p.maxRepeat > 0 && longestRun(runes, func(prev, cur rune) bool { return prev == cur }) > p.maxRepeat {
+		add(CodePasswordRepeated, "must not repeat a character more than %d times in a row", p.maxRepeat)
+	}
+	if p.maxSeq > 0 && longestSequence(runes) > p.maxSeq {
+		add(CodePasswordSequential, "must not contain more than %d sequential characters, such as abcd or 4321", p.maxSeq)
+	}
+
+	if p.entropy > 0 {
+		pool := 0
+		for _, class := range []struct {
+			has  bool
+			size int
+		}{{hasLower, 26}, {hasUpper, 26}, {hasDigit, 10}, {hasSpecial, 33}, {hasOther, 100}} {
+			if class.has {
+				pool += class.size
+			}
+		}
+		bits := 0.0
+		if pool > 0 {
+			bits = float64(len(runes)) * math.Log2(float64(pool))
+		}
+		if bits < p.entropy {
+			add(CodePasswordLowEntropy, "is too predictable: estimated %.0f bits of entropy, at least %.0f required", math.Floor(bits), p.entropy)
+		}
+	}
+
+	if p.common && isCommonPassword(password) {
+		add(CodePasswordCommon, "is a commonly used password")
+	}
+
+	lower := strings.ToLower(password)
+	for _, f := range p.fields {
+		value := strings.ToLower(strings.TrimSpace(f.value))
+		if value == "" {
+			continue
+		}
+		if lower == value {
+			add(CodePasswordContainsField, "must not be the same as %s", f.name)
+			continue
+		}
+		if strings.Contains(lower, value) {
+			add(CodePasswordContainsField, "must not contain %s", f.name)
+			continue
+		}
+		// An email address is also checked by its local part, e.g. "jane.doe" of "jane.doe@example.com"
+		if local, _, found := strings.Cut(value, "@"); found && len(local) >= 3 && strings.Contains(lower, local) {
+			add(CodePasswordContainsField, "must not contain %s", f.name)
+		}
+	}
+
+	return violations
+}
+
+// longestRun returns the length of the longest run of runes where each rune continues the previous one.
+func longestRun(runes []rune, continues func(prev, cur rune) bool) int {
+	longest, current := 0, 0
+	for i, r := range runes {
+		if i > 0 && continues(runes[i-1], r) {
+			current++
+		} else {
+			current = 1
+		}
+		if current > longest {
+			longest = current
+		}
+	}
+	return longest
+}
+
+// longestSequence returns the length of the longest ascending or descending sequence of consecutive
+// characters, such as "abcd", "4321" or "XYZ". Letters are compared case-insensitively.
+func longestSequence(runes []rune) int {
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+	ascending := longestRun(lower, func(prev, cur rune) bool { return cur == prev+1 })
+	descending := longestRun(lower, func(prev, cur rune) bool { return cur == prev-1 })
+	return max(ascending, descending)
+}
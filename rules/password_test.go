@@ -0,0 +1,146 @@
+package rules
+
+import (
+	"errors"
+	"go-runtimevalidation/args"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// passwordArgs builds plain value arguments as ParseArgs would for a password rule.
+func passwordArgs(options ...string) map[string]args.Arg {
+	arguments := map[string]args.Arg{}
+	for _, option := range options {
+		arguments[option] = args.Arg{Value: option}
+	}
+	return arguments
+}
+
+// passwordCodes returns the violation codes of a *PasswordError.
+func passwordCodes(t *testing.T, err error) []string {
+	var passwordErr *PasswordError
+	if !assert.True(t, errors.As(err, &passwordErr)) {
+		return nil
+	}
+	codes := make([]string, len(passwordErr.Violations))
+	for i, v := range passwordErr.Violations {
+		codes[i] = v.Code
+	}
+	return codes
+}
+
+func TestPassword(t *testing.T) {
+	t.Run("Default policy", func(t *testing.T) {
+		assert.NoError(t, Password("correct horse", nil, nil))
+
+		err := Password("short", nil, nil)
+		assert.Error(t, err)
+		assert.Equal(t, "password validation failed: must be at least 8 characters", err.Error())
+
+		err = Password("password", nil, nil)
+		assert.Error(t, err)
+		assert.Equal(t, "password validation failed: is a commonly used password", err.Error())
+	})
+
+	t.Run("Length", func(t *testing.T) {
+		arguments := passwordArgs("min=4", "max=6")
+		assert.NoError(t, Password("héllo", nil, arguments), "length is counted in characters")
+		assert.Equal(t, []string{CodePasswordTooShort}, passwordCodes(t, Password("abc", nil, arguments)))
+		assert.Equal(t, []string{CodePasswordTooLong}, passwordCodes(t, Password("abcdefg", nil, arguments)))
+	})
+
+	t.Run("Character classes", func(t *testing.T) {
+		arguments := passwordArgs("upper", "lower", "digit", "special")
+		assert.NoError(t, Password("Ab1!", nil, arguments))
+
+		err := Password("abc", nil, arguments)
+		assert.Equal(t, []string{CodePasswordMissingUpper, CodePasswordMissingDigit, CodePasswordMissingSymbol}, passwordCodes(t, err))
+		assert.Equal(t, "password validation failed: must contain an upper case letter; must contain a digit; must contain a special character", err.Error())
+	})
+
+	t.Run("Minimum number of classes", func(t *testing.T) {
+		arguments := passwordArgs("classes=3")
+		assert.NoError(t, Password("Abc1", nil, arguments))
+		assert.NoError(t, Password("abc1 ", nil, arguments), "spaces count as special characters")
+		assert.Equal(t, []string{CodePasswordTooFewClasses}, passwordCodes(t, Password("Abcd", nil, arguments)))
+	})
+
+	t.Run("Repeated characters", func(t *testing.T) {
+		arguments := passwordArgs("maxrepeat=2")
+		assert.NoError(t, Password("aabbaa", nil, arguments))
+
+		err := Password("abaaab", nil, arguments)
+		assert.Error(t, err)
+		assert.Equal(t, "password validation failed: must not repeat a character more than 2 times in a row", err.Error())
+	})
+
+	t.Run("Sequential characters", func(t *testing.T) {
+		arguments := passwordArgs("maxseq=3")
+		assert.NoError(t, Password("abc-321-xyz", nil, arguments))
+		assert.Equal(t, []string{CodePasswordSequential}, passwordCodes(t, Password("xabcdx", nil, arguments)))
+		assert.Equal(t, []string{CodePasswordSequential}, passwordCodes(t, Password("x4321x", nil, arguments)))
+		assert.Equal(t, []string{CodePasswordSequential}, passwordCodes(t, Password("aBcD", nil, arguments)), "letters are compared case-insensitively")
+	})
+
+	t.Run("Entropy", func(t *testing.T) {
+		arguments := passwordArgs("entropy=50")
+		assert.NoError(t, Password("Tr0ub4dor&3x", nil, arguments))
+
+		err := Password("abcdefgh", nil, arguments)
+		assert.Error(t, err)
+		assert.Equal(t, "password validation failed: is too predictable: estimated 37 bits of entropy, at least 50 required", err.Error())
+	})
+
+	t.Run("Common passwords", func(t *testing.T) {
+		arguments := passwordArgs("common")
+		assert.NoError(t, Password("vN7#qLp2", nil, arguments))
+		assert.Equal(t, []string{CodePasswordCommon}, passwordCodes(t, Password("Qwerty", nil, arguments)), "the deny list ignores case")
+		assert.Equal(t, []string{CodePasswordCommon}, passwordCodes(t, Password("123456", nil, arguments)))
+	})
+
+	t.Run("Other fields", func(t *testing.T) {
+		user := struct {
+			Email    string
+			Username string
+		}{Email: "jane.doe@example.com", Username: "jdoe"}
+		arguments := map[string]args.Arg{
+			"$Email":    {Type: args.FieldArg, Field: "Email"},
+			"$Username": {Type: args.FieldArg, Field: "Username"},
+		}
+
+		assert.NoError(t, Password("purple-otter-42", user, arguments))
+
+		err := Password("JDOE", user, arguments)
+		assert.Error(t, err)
+		assert.Equal(t, "password validation failed: must not be the same as Username", err.Error())
+
+		err = Password("my-jane.doe-pass", user, arguments)
+		assert.Error(t, err)
+		assert.Equal(t, "password validation failed: must not contain Email", err.Error())
+
+		err = Password("jane.doe@example.com!jdoe", user, arguments)
+		assert.Equal(t, []string{CodePasswordContainsField, CodePasswordContainsField}, passwordCodes(t, err))
+		assert.Equal(t, "password validation failed: must not contain Email; must not contain Username", err.Error())
+	})
+
+	t.Run("Every unmet requirement is reported", func(t *testing.T) {
+		arguments := passwordArgs("min=12", "upper", "digit", "maxrepeat=2", "common")
+		err := Password("aaaaaa", nil, arguments)
+		assert.Equal(t, []string{CodePasswordTooShort, CodePasswordMissingUpper, CodePasswordMissingDigit, CodePasswordRepeated, CodePasswordCommon}, passwordCodes(t, err))
+		assert.NotContains(t, err.Error(), "aaaaaa", "the password is never part of the message")
+	})
+
+	t.Run("Invalid arguments", func(t *testing.T) {
+		assert.EqualError(t, Password("secret", nil, passwordArgs("minimum=8")), "password: unknown option minimum=8")
+		assert.EqualError(t, Password("secret", nil, passwordArgs("min=eight")), "password: invalid value for min: eight")
+		assert.EqualError(t, Password("secret", nil, passwordArgs("classes=5")), "password: invalid value for classes: 5")
+		assert.EqualError(t, Password("secret", nil, passwordArgs("upper=1")), "password: unknown option upper=1")
+	})
+
+	t.Run("Unsupported input type", func(t *testing.T) {
+		err := Password(12345678, nil, nil)
+		assert.Error(t, err)
+		assert.Equal(t, "expected a string, got int", err.Error())
+	})
+}
@@ -0,0 +1,33 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/functions"
+	"time"
+)
+
+// Past validates that the input is a point in time before the current time.
+// The current time is read from functions.Now, so it can be fixed in tests with functions.SetClock.
+//
+// Parameters:
+// - input: The value being validated. It can be a time.Time, *time.Time, Unix seconds, or a date/time string.
+//
+// Returns nil if the input is in the past, or an error if:
+// - The input cannot be converted to a time
+// - The input is not before the current time.
+//
+// Example:
+//
+//	err := Past("1999-01-01") // err will be nil
+func Past(input any) error {
+	value, err := functions.GetTime(input)
+	if err != nil {
+		return fmt.Errorf("unsupported type for input field: %w", err)
+	}
+
+	if now := functions.Now(); !value.Before(now) {
+		return fmt.Errorf("past validation failed: %s is not before %s", value.Format(time.RFC3339), now.Format(time.RFC3339))
+	}
+
+	return nil
+}
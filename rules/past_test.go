@@ -0,0 +1,30 @@
+package rules
+
+import (
+	"go-runtimevalidation/functions"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPast(t *testing.T) {
+	restore := functions.SetClock(func() time.Time { return time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC) })
+	defer restore()
+
+	t.Run("Time in the past", func(t *testing.T) {
+		assert.NoError(t, Past("2024-05-31"))
+	})
+
+	t.Run("Unix seconds in the past", func(t *testing.T) {
+		assert.NoError(t, Past(int64(1704067200)))
+	})
+
+	t.Run("Time in the future", func(t *testing.T) {
+		assert.EqualError(t, Past("2024-06-02"), "past validation failed: 2024-06-02T00:00:00Z is not before 2024-06-01T12:00:00Z")
+	})
+
+	t.Run("Invalid input", func(t *testing.T) {
+		assert.Error(t, Past("yesterday"))
+	})
+}
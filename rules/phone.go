@@ -0,0 +1,111 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/functions"
+	"go-runtimevalidation/iso"
+	"strings"
+)
+
+// phoneModes are the arguments of the phone rule that restrict the accepted numbers instead of naming a region.
+var phoneModes = map[string]bool{
+	"e164":   true,
+	"mobile": true,
+	"fixed":  true,
+}
+
+// Phone validates that the input is a phone number. It is expected to be used in validation rules such as
+// `phone` (international form only), `phone:DE`, `phone:$Country` or `phone:$Country,mobile,e164`.
+//
+// The optional region is the ISO 3166-1 alpha-2 code used for numbers written in national form ("030 123456").
+// The country calling code and the length and leading digits of the national number are checked against
+// the metadata in the iso package (see iso.ParsePhone).
+//
+// Modes:
+// - e164: the input must be in canonical E.164 form, e.g. "+4930123456"
+// - mobile: the number must be in a mobile range
+// - fixed: the number must be in a fixed-line range
+//
+// In regions where mobile and fixed-line numbers cannot be told apart, such as the US, both `mobile` and `fixed` are satisfied.
+// If both are given, either kind is accepted.
+//
+// Parameters:
+// - input: The value being validated, expected to be a string.
+// - obj: The object containing additional data (can be used for Field references within the args).
+// - args: A map containing at most one region and any of the modes. May be empty.
+//
+// Returns nil if the input is valid, or an error if:
+// - More than one region is given
+// - The input is not a valid phone number for its calling code or the region
+// - The number is not in E.164 form, or not of the requested kind.
+//
+// Example:
+//
+//	input := "030 123456"
+//	obj := struct{ Country string }{Country: "DE"}
+//	args := map[string]Arg{
+//	    "$Country": {Type: FieldArg, Field: "Country"},
+//	    "e164":     {Value: "e164"},
+//	}
+//	err := Phone(input, obj, args)  // err will be: "phone validation failed: 030 123456 is not in E.164 form +4930123456"
+func Phone(input any, obj any, arguments map[string]args.Arg) error {
+	modes := map[string]bool{}
+	var regionArg *args.Arg
+	for _, v := range arguments {
+		if name, ok := v.Value.(string); ok && v.Type == args.ValueArg && phoneModes[name] {
+			modes[name] = true
+			continue
+		}
+		if regionArg != nil {
+			return fmt.Errorf("phone expects at most 1 region argument")
+		}
+		regionArg = &v
+	}
+
+	region := ""
+	if regionArg != nil {
+		eval, err := regionArg.Evaluate(obj)
+		if err != nil {
+			return err
+		}
+
+		region, err = functions.GetString(eval)
+		if err != nil {
+			return fmt.Errorf("unsupported type for phone argument: %w", err)
+		}
+	}
+
+	// Check if the input is a string
+	value, err := functions.GetString(input)
+	if err != nil {
+		return fmt.Errorf("expected a string, got %T", input)
+	}
+
+	number, err := iso.ParsePhone(value, region)
+	if err != nil {
+		return fmt.Errorf("phone validation failed: %w", err)
+	}
+
+	if modes["e164"] && value != number.E164() {
+		return fmt.Errorf("phone validation failed: %s is not in E.164 form %s", value, number.E164())
+	}
+
+	if modes["mobile"] || modes["fixed"] {
+		switch {
+		case number.Type == iso.PhoneFixedLineOrMobile:
+		case number.Type == iso.PhoneMobile && modes["mobile"]:
+		case number.Type == iso.PhoneFixedLine && modes["fixed"]:
+		default:
+			expected := []string{}
+			for _, mode := range []string{"mobile", "fixed"} {
+				if modes[mode] {
+					expected = append(expected, mode)
+				}
+			}
+			return fmt.Errorf("phone validation failed: %s is not a %s number", value, strings.Join(expected, " or "))
+		}
+	}
+
+	return nil
+}
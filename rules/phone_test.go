@@ -0,0 +1,73 @@
+package rules
+
+import (
+	"go-runtimevalidation/args"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPhone(t *testing.T) {
+	countryArg := args.Arg{Type: args.FieldArg, Field: "Country"}
+	de := struct{ Country string }{Country: "DE"}
+
+	t.Run("International form without region", func(t *testing.T) {
+		assert.NoError(t, Phone("+49 30 123456", nil, nil))
+		assert.NoError(t, Phone("+12125551234", nil, nil))
+	})
+
+	t.Run("National form requires a region", func(t *testing.T) {
+		err := Phone("030 123456", nil, nil)
+		assert.Error(t, err)
+		assert.Equal(t, "phone validation failed: 030 123456 is not in international format and no default region is given", err.Error())
+
+		assert.NoError(t, Phone("030 123456", nil, map[string]args.Arg{"DE": {Value: "DE"}}))
+		assert.NoError(t, Phone("030 123456", de, map[string]args.Arg{"$Country": countryArg}))
+	})
+
+	t.Run("Invalid national number", func(t *testing.T) {
+		err := Phone("+49 30", nil, nil)
+		assert.Error(t, err)
+		assert.Equal(t, "phone validation failed: +49 30 is not a valid phone number for calling code +49", err.Error())
+	})
+
+	t.Run("E.164 form", func(t *testing.T) {
+		arguments := map[string]args.Arg{"$Country": countryArg, "e164": {Value: "e164"}}
+		assert.NoError(t, Phone("+4930123456", de, arguments))
+
+		err := Phone("030 123456", de, arguments)
+		assert.Error(t, err)
+		assert.Equal(t, "phone validation failed: 030 123456 is not in E.164 form +4930123456", err.Error())
+	})
+
+	t.Run("Mobile numbers", func(t *testing.T) {
+		arguments := map[string]args.Arg{"mobile": {Value: "mobile"}}
+		assert.NoError(t, Phone("+49 151 23456789", nil, arguments))
+		assert.NoError(t, Phone("+1 212 555 1234", nil, arguments), "US numbers may be mobile")
+
+		err := Phone("+49 30 123456", nil, arguments)
+		assert.Error(t, err)
+		assert.Equal(t, "phone validation failed: +49 30 123456 is not a mobile number", err.Error())
+	})
+
+	t.Run("Mobile or fixed-line numbers", func(t *testing.T) {
+		arguments := map[string]args.Arg{"mobile": {Value: "mobile"}, "fixed": {Value: "fixed"}}
+		assert.NoError(t, Phone("+49 30 123456", nil, arguments))
+
+		err := Phone("+44 800 123 4567", nil, arguments)
+		assert.Error(t, err)
+		assert.Equal(t, "phone validation failed: +44 800 123 4567 is not a mobile or fixed number", err.Error())
+	})
+
+	t.Run("Too many regions", func(t *testing.T) {
+		err := Phone("+4930123456", nil, map[string]args.Arg{"DE": {Value: "DE"}, "FR": {Value: "FR"}})
+		assert.Error(t, err)
+		assert.Equal(t, "phone expects at most 1 region argument", err.Error())
+	})
+
+	t.Run("Invalid input type", func(t *testing.T) {
+		err := Phone([]int{1}, nil, nil)
+		assert.Error(t, err)
+		assert.Equal(t, "expected a string, got []int", err.Error())
+	})
+}
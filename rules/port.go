@@ -0,0 +1,50 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/functions"
+	"strconv"
+)
+
+// Port validates that the input is a valid TCP/UDP port number between 1 and 65535.
+// Strings must be plain decimal numbers, without signs or leading zeros.
+//
+// Parameters:
+// - input: The value being validated. It can be an integer or a string.
+//
+// Returns nil if the input is a valid port, or an error if:
+// - The input is not an integer or a string
+// - The input is not a number between 1 and 65535.
+//
+// Example:
+//
+//	err := Port(8080)    // err will be nil
+//	err := Port("65536") // err will be: "invalid port: 65536"
+func Port(input any) error {
+	if value, ok := input.(string); ok {
+		if !isPort(value) {
+			return fmt.Errorf("invalid port: %s", value)
+		}
+		return nil
+	}
+
+	value, err := functions.GetInt(input)
+	if err != nil {
+		return fmt.Errorf("unsupported type for input field: %w", err)
+	}
+
+	if value < 1 || value > 65535 {
+		return fmt.Errorf("invalid port: %d", value)
+	}
+
+	return nil
+}
+
+// isPort reports whether s is a decimal port number between 1 and 65535.
+func isPort(s string) bool {
+	if len(s) == 0 || s[0] == '0' || s[0] == '+' {
+		return false
+	}
+	port, err := strconv.ParseUint(s, 10, 16)
+	return err == nil && port > 0
+}
@@ -0,0 +1,41 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPort(t *testing.T) {
+	t.Run("Valid integer port", func(t *testing.T) {
+		assert.NoError(t, Port(8080))
+	})
+
+	t.Run("Valid string port", func(t *testing.T) {
+		assert.NoError(t, Port("443"))
+	})
+
+	t.Run("Valid upper bound", func(t *testing.T) {
+		assert.NoError(t, Port(uint16(65535)))
+	})
+
+	t.Run("Port zero", func(t *testing.T) {
+		assert.EqualError(t, Port(0), "invalid port: 0")
+	})
+
+	t.Run("Port out of range", func(t *testing.T) {
+		assert.EqualError(t, Port("65536"), "invalid port: 65536")
+	})
+
+	t.Run("Leading zero", func(t *testing.T) {
+		assert.EqualError(t, Port("080"), "invalid port: 080")
+	})
+
+	t.Run("Non-numeric string", func(t *testing.T) {
+		assert.EqualError(t, Port("http"), "invalid port: http")
+	})
+
+	t.Run("Invalid input type", func(t *testing.T) {
+		assert.Error(t, Port(80.5))
+	})
+}
@@ -0,0 +1,36 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/functions"
+)
+
+// Positive validates that the input is a number greater than zero.
+// It is expected to be combined with other numeric rules, such as `positive && multipleof:0.25 && decimals:2`.
+//
+// The input is converted with functions.GetNumber, so that it works across all integer and float kinds,
+// *big.Int, *big.Float and *big.Rat values and decimal strings.
+//
+// Parameters:
+// - input: The value being validated. It can be an integer, a float, a *big.Int, *big.Float or *big.Rat, or a decimal string.
+//
+// Returns an error if:
+// - The input is not a finite number
+// - The input is zero or negative.
+//
+// Example:
+//
+//	err := Positive("0.01")  // err will be nil
+//	err := Positive(0)  // err will be: "positive validation failed: 0 is not positive"
+func Positive(input any) error {
+	value, err := functions.GetNumber(input)
+	if err != nil {
+		return fmt.Errorf("unsupported type for input field: %w", err)
+	}
+
+	if value.Sign() <= 0 {
+		return fmt.Errorf("positive validation failed: %s is not positive", functions.FormatNumber(value))
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package rules
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPositive(t *testing.T) {
+	// Test case 1: Positive integer
+	t.Run("Positive integer", func(t *testing.T) {
+		err := Positive(1)
+		assert.NoError(t, err)
+	})
+
+	// Test case 2: Positive unsigned integer
+	t.Run("Positive unsigned integer", func(t *testing.T) {
+		err := Positive(uint8(3))
+		assert.NoError(t, err)
+	})
+
+	// Test case 3: Small positive float
+	t.Run("Small positive float", func(t *testing.T) {
+		err := Positive(0.001)
+		assert.NoError(t, err)
+	})
+
+	// Test case 4: Positive decimal string
+	t.Run("Positive decimal string", func(t *testing.T) {
+		err := Positive("12.50")
+		assert.NoError(t, err)
+	})
+
+	// Test case 5: Positive big.Rat
+	t.Run("Positive big.Rat", func(t *testing.T) {
+		err := Positive(big.NewRat(1, 3))
+		assert.NoError(t, err)
+	})
+
+	// Test case 6: Zero is not positive
+	t.Run("Zero", func(t *testing.T) {
+		err := Positive(0)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "positive validation failed: 0 is not positive")
+	})
+
+	// Test case 7: Negative float
+	t.Run("Negative float", func(t *testing.T) {
+		err := Positive(-0.5)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "positive validation failed: -0.5 is not positive")
+	})
+
+	// Test case 8: Tiny negative decimal string is compared exactly
+	t.Run("Tiny negative decimal string", func(t *testing.T) {
+		err := Positive("-1e-30")
+		assert.Error(t, err)
+		assert.EqualError(t, err, "positive validation failed: -0.000000000000000000000000000001 is not positive")
+	})
+
+	// Test case 9: NaN is not a number
+	t.Run("NaN", func(t *testing.T) {
+		err := Positive(math.NaN())
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unsupported type for input field: NaN is not a finite number")
+	})
+
+	// Test case 10: Infinity is not a finite number
+	t.Run("Infinity", func(t *testing.T) {
+		err := Positive(math.Inf(1))
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unsupported type for input field: +Inf is not a finite number")
+	})
+
+	// Test case 11: Input is not a number
+	t.Run("Non-numeric string", func(t *testing.T) {
+		err := Positive("abc")
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unsupported type for input field: failed to parse \"abc\" of type string as a number")
+	})
+
+	// Test case 12: Input is nil
+	t.Run("Nil input", func(t *testing.T) {
+		err := Positive(nil)
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unsupported type for input field: failed to parse <nil> of type <nil> as a number")
+	})
+}
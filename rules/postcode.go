@@ -0,0 +1,84 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/functions"
+	"go-runtimevalidation/iso"
+)
+
+// Postcode validates that the input is a postal code of the given country.
+// It is expected to be used in validation rules such as `postcode:DE`, `postcode:$Country` or `postcode:$Country,strict`.
+// The country is an ISO 3166-1 alpha-2 code; the formats are listed in the iso package.
+//
+// Case, spaces and hyphens are not significant by default, so "sw1a1aa" is a valid GB postal code.
+// With the `strict` argument, the input must already be in canonical form ("SW1A 1AA"),
+// and the error reports the canonical form. Use iso.CanonicalPostcode to normalize stored values,
+// and iso.HasPostcodes to skip countries without postal codes.
+//
+// Parameters:
+// - input: The value being validated, expected to be a string.
+// - obj: The object containing additional data (can be used for Field references within the args).
+// - args: A map containing the country and, optionally, `strict`.
+//
+// Returns nil if the input is valid, or an error if:
+// - The country is missing or unknown
+// - No postal code format is known for the country, such as HK, which does not use postal codes (wraps iso.ErrUnsupportedCountry)
+// - The input does not match the country's format
+// - In strict mode, the input is not in canonical form.
+//
+// Example:
+//
+//	input := "sw1a1aa"
+//	obj := struct{ Country string }{Country: "GB"}
+//	args := map[string]Arg{
+//	    "$Country": {Type: FieldArg, Field: "Country"},
+//	    "strict":   {Value: "strict"},
+//	}
+//	err := Postcode(input, obj, args)  // err will be: "postcode validation failed: sw1a1aa is not in canonical form SW1A 1AA"
+func Postcode(input any, obj any, arguments map[string]args.Arg) error {
+	strict := false
+	var countryArg *args.Arg
+	for _, v := range arguments {
+		if v.Type == args.ValueArg && v.Value == "strict" {
+			strict = true
+			continue
+		}
+		if countryArg != nil {
+			return fmt.Errorf("postcode expects a country and an optional strict argument, got %d arguments", len(arguments))
+		}
+		countryArg = &v
+	}
+
+	if countryArg == nil {
+		return fmt.Errorf("postcode expects a country argument")
+	}
+
+	// Evaluate the country argument
+	eval, err := countryArg.Evaluate(obj)
+	if err != nil {
+		return err
+	}
+
+	country, err := functions.GetString(eval)
+	if err != nil {
+		return fmt.Errorf("unsupported type for postcode argument: %w", err)
+	}
+
+	// Check if the input is a string
+	value, err := functions.GetString(input)
+	if err != nil {
+		return fmt.Errorf("expected a string, got %T", input)
+	}
+
+	canonical, err := iso.CanonicalPostcode(country, value)
+	if err != nil {
+		return fmt.Errorf("postcode validation failed: %w", err)
+	}
+
+	if strict && value != canonical {
+		return fmt.Errorf("postcode validation failed: %s is not in canonical form %s", value, canonical)
+	}
+
+	return nil
+}
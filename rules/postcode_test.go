@@ -0,0 +1,79 @@
+package rules
+
+import (
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/iso"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostcode(t *testing.T) {
+	countryArg := map[string]args.Arg{"$Country": {Type: args.FieldArg, Field: "Country"}}
+	strictArgs := map[string]args.Arg{
+		"$Country": {Type: args.FieldArg, Field: "Country"},
+		"strict":   {Value: "strict"},
+	}
+
+	t.Run("Literal country", func(t *testing.T) {
+		err := Postcode("10115", nil, map[string]args.Arg{"DE": {Value: "DE"}})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Field reference", func(t *testing.T) {
+		err := Postcode("K1A 0B1", struct{ Country string }{Country: "CA"}, countryArg)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Case and spaces are not significant", func(t *testing.T) {
+		err := Postcode("sw1a1aa", struct{ Country string }{Country: "GB"}, countryArg)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Invalid postal code", func(t *testing.T) {
+		err := Postcode("1011", nil, map[string]args.Arg{"DE": {Value: "DE"}})
+		assert.Error(t, err)
+		assert.Equal(t, "postcode validation failed: 1011 is not a valid postal code for DE", err.Error())
+	})
+
+	t.Run("Unsupported country", func(t *testing.T) {
+		err := Postcode("999077", nil, map[string]args.Arg{"HK": {Value: "HK"}})
+		assert.ErrorIs(t, err, iso.ErrUnsupportedCountry)
+		assert.Equal(t, "postcode validation failed: unsupported country HK: no postal code format is known", err.Error())
+	})
+
+	t.Run("Unknown country", func(t *testing.T) {
+		err := Postcode("12345", nil, map[string]args.Arg{"XX": {Value: "XX"}})
+		assert.Error(t, err)
+		assert.Equal(t, "postcode validation failed: unknown country XX", err.Error())
+	})
+
+	t.Run("Strict accepts canonical form", func(t *testing.T) {
+		err := Postcode("SW1A 1AA", struct{ Country string }{Country: "GB"}, strictArgs)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Strict reports canonical form", func(t *testing.T) {
+		err := Postcode("sw1a1aa", struct{ Country string }{Country: "GB"}, strictArgs)
+		assert.Error(t, err)
+		assert.Equal(t, "postcode validation failed: sw1a1aa is not in canonical form SW1A 1AA", err.Error())
+	})
+
+	t.Run("Missing country", func(t *testing.T) {
+		err := Postcode("10115", nil, map[string]args.Arg{"strict": {Value: "strict"}})
+		assert.Error(t, err)
+		assert.Equal(t, "postcode expects a country argument", err.Error())
+	})
+
+	t.Run("Too many arguments", func(t *testing.T) {
+		err := Postcode("10115", nil, map[string]args.Arg{"DE": {Value: "DE"}, "FR": {Value: "FR"}})
+		assert.Error(t, err)
+		assert.Equal(t, "postcode expects a country and an optional strict argument, got 2 arguments", err.Error())
+	})
+
+	t.Run("Invalid input type", func(t *testing.T) {
+		err := Postcode([]int{1}, nil, map[string]args.Arg{"DE": {Value: "DE"}})
+		assert.Error(t, err)
+		assert.Equal(t, "expected a string, got []int", err.Error())
+	})
+}
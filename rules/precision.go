@@ -0,0 +1,69 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/functions"
+)
+
+// Precision validates that the input has at most the given number of significant decimal digits.
+// It is expected to be used in validation rules such as `precision:12`, together with `decimals:2` to check
+// that an amount fits a SQL DECIMAL(12,2) column.
+//
+// The precision counts the digits of the integer part without leading zeros and the decimal places without trailing zeros,
+// so 123.450 has a precision of 5 and 0.05 a precision of 2. The input is converted with functions.GetNumber, so that
+// values beyond the range of int64 and float64 are counted exactly.
+//
+// Parameters:
+// - input: The value being validated. It can be an integer, a float, a *big.Int, *big.Float or *big.Rat, or a decimal string.
+// - obj: The object containing additional data (can be used for Field references within the args).
+// - args: A map containing exactly one argument, the maximum number of digits.
+//
+// Returns nil if the input is valid, or an error if:
+// - The number of arguments is not equal to 1
+// - The input is not a finite decimal number, or the argument is not a positive integer
+// - The input has more digits than allowed.
+//
+// Example:
+//
+//	input := "12345.67"
+//	obj := nil
+//	args := map[string]Arg{
+//	    "5": {Value: 5},
+//	}
+//	err := Precision(input, obj, args)  // err will be: "precision validation failed: 12345.67 has 7 digits, more than 5"
+func Precision(input any, obj any, arguments map[string]args.Arg) error {
+	if len(arguments) != 1 {
+		return fmt.Errorf("precision expects exactly 1 argument, got %d", len(arguments))
+	}
+
+	// Get the first (and only) argument
+	var arg args.Arg
+	for _, v := range arguments {
+		arg = v
+		break
+	}
+
+	// Evaluate the argument
+	eval, err := arg.Evaluate(obj)
+	if err != nil {
+		return err
+	}
+
+	max, err := functions.GetInt(eval)
+	if err != nil || max < 1 {
+		return fmt.Errorf("unsupported type for precision argument: %v", eval)
+	}
+
+	digits, err := functions.GetPrecision(input)
+	if err != nil {
+		return fmt.Errorf("unsupported type for input field: %w", err)
+	}
+
+	if int64(digits) > max {
+		number, _ := functions.GetNumber(input)
+		return fmt.Errorf("precision validation failed: %s has %d digits, more than %d", functions.FormatNumber(number), digits, max)
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package rules
+
+import (
+	"go-runtimevalidation/args"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrecision(t *testing.T) {
+	five := map[string]args.Arg{"5": {Value: 5}}
+
+	t.Run("Within precision", func(t *testing.T) {
+		assert.NoError(t, Precision("123.45", nil, five))
+		assert.NoError(t, Precision("123.4500", nil, five))
+		assert.NoError(t, Precision(0.00001, nil, five))
+		assert.NoError(t, Precision(-99999, nil, five))
+	})
+
+	t.Run("Too many digits", func(t *testing.T) {
+		err := Precision("12345.67", nil, five)
+		assert.EqualError(t, err, "precision validation failed: 12345.67 has 7 digits, more than 5")
+
+		err = Precision(100000, nil, five)
+		assert.EqualError(t, err, "precision validation failed: 100000 has 6 digits, more than 5")
+	})
+
+	t.Run("Big numbers", func(t *testing.T) {
+		amount, _ := new(big.Float).SetPrec(128).SetString("1234567890123456789.01")
+		assert.NoError(t, Precision(amount, nil, map[string]args.Arg{"21": {Value: 21}}))
+		assert.Error(t, Precision(amount, nil, map[string]args.Arg{"20": {Value: 20}}))
+
+		err := Precision(big.NewRat(1, 3), nil, five)
+		assert.EqualError(t, err, "unsupported type for input field: 1/3 has no finite decimal representation")
+	})
+
+	t.Run("Invalid arguments", func(t *testing.T) {
+		err := Precision(1, nil, map[string]args.Arg{"0": {Value: 0}})
+		assert.EqualError(t, err, "unsupported type for precision argument: 0")
+
+		err = Precision(1, nil, map[string]args.Arg{})
+		assert.EqualError(t, err, "precision expects exactly 1 argument, got 0")
+	})
+}
@@ -14,6 +14,10 @@ import (
 // If the input is not a valid string, or if the regex pattern is invalid, or if the input does not match the pattern,
 // an error is returned.
 //
+// Since the pattern is compiled on every call, this form is meant for patterns that are only known at validation time,
+// such as `regex:$Pattern`. Constant patterns should use the literal syntax `regex:/pattern/flags` or a named
+// pattern `regex:@name`, which are compiled once when the rule is parsed and validated with MatchRegex.
+//
 // Parameters:
 // - input: the value to be validated (expected to be a string).
 // - obj : The struct object whose fields will be compared against the values in the args map.
@@ -23,37 +27,66 @@ import (
 // - error: an error if validation fails or if the argument count/type is incorrect, or if the regex pattern is invalid.
 // Returns nil if validation passes.
 func Regex(input any, obj any, arguments map[string]args.Arg) error {
-	// Check if the args map contains exactly one argument
-	if len(arguments) != 1 {
-		return fmt.Errorf("regex expects exactly 1 argument, got %d", len(arguments))
-	}
-
-	// Get the argument value
-	arg, err := arguments["pattern"].Evaluate(obj)
+	exp, err := regexArg("regex", obj, arguments)
 	if err != nil {
 		return err
 	}
 
+	return MatchRegex(input, exp)
+}
+
+// MatchRegex checks if the input string matches a precompiled regular expression.
+// It backs the `regex:/pattern/flags` and `regex:@name` forms, whose patterns are compiled when the rule is parsed.
+//
+// Parameters:
+// - input: the value to be validated (expected to be a string).
+// - exp: the compiled regular expression.
+//
+// Returns:
+// - error: an error if the input is not a string or does not match the expression. Returns nil if validation passes.
+func MatchRegex(input any, exp *regexp.Regexp) error {
 	// Check if the input is a string
 	value, ok := input.(string)
 	if !ok {
 		return fmt.Errorf("expected a string, got %T", input)
 	}
 
-	expString, err := functions.GetString(arg)
+	if !exp.MatchString(value) {
+		return fmt.Errorf("value %s does not match regex %s", value, exp)
+	}
+
+	return nil
+}
+
+// regexArg evaluates the single pattern argument of the regex and notregex rules and compiles it.
+func regexArg(name string, obj any, arguments map[string]args.Arg) (*regexp.Regexp, error) {
+	// Check if the args map contains exactly one argument
+	if len(arguments) != 1 {
+		return nil, fmt.Errorf("%s expects exactly 1 argument, got %d", name, len(arguments))
+	}
+
+	// Get the first (and only) argument
+	var arg args.Arg
+	for _, v := range arguments {
+		arg = v
+		break
+	}
+
+	// Evaluate the argument
+	eval, err := arg.Evaluate(obj)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	exp, err := regexp.Compile(expString)
+	expString, err := functions.GetString(eval)
 	if err != nil {
-		return fmt.Errorf("invalid regex: %s", value)
+		return nil, err
 	}
 
-	// Check if the string contains only numeric characters
-	if !exp.MatchString(value) {
-		return fmt.Errorf("value %s does not match regex %s", value, expString)
+	exp, err := regexp.Compile(expString)
+	if err != nil {
+		return nil, fmt.Errorf("invalid regex: %s", expString)
 	}
 
-	return nil
+	return exp, nil
 }
@@ -2,6 +2,7 @@ package rules
 
 import (
 	"go-runtimevalidation/args"
+	"regexp"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -48,3 +49,19 @@ func TestRegex(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestMatchRegex(t *testing.T) {
+	exp := regexp.MustCompile(`(?i)^[a-z]+$`)
+
+	t.Run("Input matches compiled regex", func(t *testing.T) {
+		assert.NoError(t, MatchRegex("Hello", exp))
+	})
+
+	t.Run("Input does not match compiled regex", func(t *testing.T) {
+		assert.EqualError(t, MatchRegex("hello1", exp), "value hello1 does not match regex (?i)^[a-z]+$")
+	})
+
+	t.Run("Invalid input type", func(t *testing.T) {
+		assert.EqualError(t, MatchRegex(12345, exp), "expected a string, got int")
+	})
+}
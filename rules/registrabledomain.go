@@ -0,0 +1,118 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/domain"
+	"slices"
+	"strings"
+)
+
+// RegistrableDomain validates that the input is a registrable domain: a public suffix and one more label,
+// such as "example.com", "example.co.uk" or "project.github.io". Subdomains such as "www.example.com"
+// and public suffixes such as "co.uk" are rejected. Public suffixes come from the list embedded in golang.org/x/net/publicsuffix.
+//
+// Parameters:
+// - input: the value to be validated (expected to be a string).
+//
+// Returns:
+// - error: an error if the input is not a valid hostname, is a public suffix, or is a subdomain of a registrable domain.
+//
+// Example:
+//
+//	err := RegistrableDomain("example.co.uk")     // err will be nil
+//	err := RegistrableDomain("www.example.co.uk") // err will be: "invalid registrabledomain: www.example.co.uk: the registrable domain is example.co.uk"
+func RegistrableDomain(input any) error {
+	// Check if the input is a string
+	value, ok := input.(string)
+	if !ok {
+		return fmt.Errorf("expected a string, got %T", input)
+	}
+
+	name, registrable, err := parseRegistrableDomain(value)
+	if err != nil {
+		return fmt.Errorf("invalid registrabledomain: %s: %w", value, err)
+	}
+
+	if registrable != name.ASCII() {
+		return fmt.Errorf("invalid registrabledomain: %s: the registrable domain is %s", value, registrable)
+	}
+
+	return nil
+}
+
+// SameRegistrableDomain validates that the input is a hostname whose registrable domain is one of the given domains.
+// It is expected to be used in validation rules such as `registrabledomain:example.com` or `registrabledomain:$SiteDomain`,
+// e.g. to check that a redirect target belongs to the same site: "www.example.com" and "api.eu.example.com"
+// both have the registrable domain "example.com". Domains are compared in lower case ASCII form.
+//
+// Parameters:
+// - input: The value being validated, expected to be a hostname string.
+// - obj: The object containing additional data (can be used for Field references within the args).
+// - args: A map of arguments, each a registrable domain. At least one is required.
+//
+// Returns nil if the input is valid, or an error if:
+// - An argument is not a valid domain name
+// - The input is not a valid hostname or is a public suffix
+// - The registrable domain of the input is not one of the given domains.
+//
+// Example:
+//
+//	input := "login.example.net"
+//	args := map[string]Arg{"example.com": {Value: "example.com"}}
+//	err := SameRegistrableDomain(input, nil, args)  // err will be: "registrabledomain validation failed: example.net is not one of example.com"
+func SameRegistrableDomain(input any, obj any, arguments map[string]args.Arg) error {
+	if len(arguments) == 0 {
+		return fmt.Errorf("registrabledomain expects at least one domain")
+	}
+
+	allowed := make([]string, 0, len(arguments))
+	for key, v := range arguments {
+		eval, err := v.Evaluate(obj)
+		if err != nil {
+			return err
+		}
+		text, ok := eval.(string)
+		if !ok {
+			return fmt.Errorf("unsupported type for registrabledomain argument %s: %T", key, eval)
+		}
+		name, err := domain.Parse(text)
+		if err != nil {
+			return fmt.Errorf("invalid registrabledomain argument %s: %w", text, err)
+		}
+		allowed = append(allowed, name.ASCII())
+	}
+
+	// Check if the input is a string
+	value, ok := input.(string)
+	if !ok {
+		return fmt.Errorf("expected a string, got %T", input)
+	}
+
+	_, registrable, err := parseRegistrableDomain(value)
+	if err != nil {
+		return fmt.Errorf("invalid registrabledomain: %s: %w", value, err)
+	}
+
+	if !slices.Contains(allowed, registrable) {
+		slices.Sort(allowed)
+		return fmt.Errorf("registrabledomain validation failed: %s is not one of %s", registrable, strings.Join(allowed, ", "))
+	}
+
+	return nil
+}
+
+// parseRegistrableDomain parses a hostname, optionally ending with a dot, and returns its registrable domain.
+func parseRegistrableDomain(value string) (domain.Name, string, error) {
+	name, err := domain.Parse(value)
+	if err != nil {
+		return domain.Name{}, "", err
+	}
+
+	registrable, err := name.RegistrableDomain()
+	if err != nil {
+		return domain.Name{}, "", err
+	}
+
+	return name, registrable, nil
+}
@@ -0,0 +1,62 @@
+package rules
+
+import (
+	"go-runtimevalidation/args"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistrableDomain(t *testing.T) {
+	t.Run("Registrable domains", func(t *testing.T) {
+		assert.NoError(t, RegistrableDomain("example.com"))
+		assert.NoError(t, RegistrableDomain("example.co.uk"))
+		assert.NoError(t, RegistrableDomain("project.github.io"))
+		assert.NoError(t, RegistrableDomain("bücher.de"))
+	})
+
+	t.Run("Subdomain", func(t *testing.T) {
+		err := RegistrableDomain("www.example.co.uk")
+		assert.EqualError(t, err, "invalid registrabledomain: www.example.co.uk: the registrable domain is example.co.uk")
+	})
+
+	t.Run("Public suffix", func(t *testing.T) {
+		err := RegistrableDomain("co.uk")
+		assert.EqualError(t, err, "invalid registrabledomain: co.uk: co.uk is a public suffix")
+	})
+
+	t.Run("Invalid name", func(t *testing.T) {
+		err := RegistrableDomain("exa_mple.com")
+		assert.EqualError(t, err, `invalid registrabledomain: exa_mple.com: label "exa_mple" contains invalid character '_'`)
+	})
+
+	t.Run("Wrong type", func(t *testing.T) {
+		assert.EqualError(t, RegistrableDomain(42), "expected a string, got int")
+	})
+}
+
+func TestSameRegistrableDomain(t *testing.T) {
+	site := map[string]args.Arg{"example.com": {Value: "example.com"}}
+
+	t.Run("Same site", func(t *testing.T) {
+		assert.NoError(t, SameRegistrableDomain("example.com", nil, site))
+		assert.NoError(t, SameRegistrableDomain("api.eu.Example.COM", nil, site))
+	})
+
+	t.Run("Other site", func(t *testing.T) {
+		err := SameRegistrableDomain("example.com.evil.net", nil, site)
+		assert.EqualError(t, err, "registrabledomain validation failed: evil.net is not one of example.com")
+	})
+
+	t.Run("Field argument", func(t *testing.T) {
+		type Link struct{ Site string }
+		arguments := map[string]args.Arg{"$Site": {Type: args.FieldArg, Field: "Site"}}
+		assert.NoError(t, SameRegistrableDomain("www.bücher.de", Link{Site: "xn--bcher-kva.de"}, arguments))
+		assert.Error(t, SameRegistrableDomain("www.example.de", Link{Site: "xn--bcher-kva.de"}, arguments))
+	})
+
+	t.Run("Missing arguments", func(t *testing.T) {
+		err := SameRegistrableDomain("example.com", nil, nil)
+		assert.EqualError(t, err, "registrabledomain expects at least one domain")
+	})
+}
@@ -2,8 +2,9 @@ package rules
 
 import (
 	"fmt"
+	"go-runtimevalidation/args"
 	"go-runtimevalidation/functions"
-	"go-runtimevalidation/regex"
+	"go-runtimevalidation/semver"
 )
 
 // SemVer validates if the input is a valid Semantic Version (SemVer).
@@ -26,9 +27,43 @@ func SemVer(input any) error {
 	}
 
 	// Check if the string is a valid semver
-	if !regex.SemverRegex().MatchString(value) {
+	if _, err := semver.Parse(value); err != nil {
 		return fmt.Errorf("invalid semver: %s", value)
 	}
 
 	return nil
 }
+
+// semVerOperands returns the input and the single argument of a version comparison rule as versions.
+func semVerOperands(name string, input any, obj any, arguments map[string]args.Arg) (semver.Version, semver.Version, error) {
+	if len(arguments) != 1 {
+		return semver.Version{}, semver.Version{}, fmt.Errorf("%s expects exactly 1 argument, got %d", name, len(arguments))
+	}
+
+	// Get the first (and only) argument
+	var arg args.Arg
+	for _, v := range arguments {
+		arg = v
+		break
+	}
+
+	// Evaluate the argument
+	eval, err := arg.Evaluate(obj)
+	if err != nil {
+		return semver.Version{}, semver.Version{}, err
+	}
+
+	// Get the value of the input
+	lhs, err := functions.GetSemVer(input)
+	if err != nil {
+		return semver.Version{}, semver.Version{}, fmt.Errorf("invalid input field: %w", err)
+	}
+
+	// Get the version to compare against
+	rhs, err := functions.GetSemVer(eval)
+	if err != nil {
+		return semver.Version{}, semver.Version{}, fmt.Errorf("invalid %s argument: %w", name, err)
+	}
+
+	return lhs, rhs, nil
+}
@@ -0,0 +1,43 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/args"
+)
+
+// SemVerGT validates that the input is a semantic version greater than another version, compared by precedence as defined by semver.org:
+// prereleases come before the release (1.0.0-rc.1 < 1.0.0) and build metadata is ignored.
+// It is expected to be used in validation rules such as `semvergt:1.2.0` or `semvergt:$MinVersion`.
+//
+// Parameters:
+// - input: The value being validated. It can be a version string or a semver.Version.
+// - obj: The object containing additional data (can be used for Field references within the args).
+// - args: A map containing exactly one argument, the version to compare against.
+//
+// Returns nil if the input is valid, or an error if:
+// - The number of arguments is not equal to 1
+// - The input or the argument is not a valid semantic version
+// - The input is not greater than the argument.
+//
+// Example:
+//
+//	input := "1.10.0-rc.1"
+//	obj := nil
+//	args := map[string]Arg{
+//	    "1.10.0": {Value: "1.10.0"},
+//	}
+//	err := SemVerGT(input, obj, args)  // err will be: "semvergt validation failed: 1.10.0-rc.1 <= 1.10.0"
+func SemVerGT(input any, obj any, arguments map[string]args.Arg) error {
+	lhs, rhs, err := semVerOperands("semvergt", input, obj, arguments)
+	if err != nil {
+		return err
+	}
+
+	// Compare versions
+	if !(lhs.Compare(rhs) > 0) {
+		return fmt.Errorf("semvergt validation failed: %s <= %s", lhs, rhs)
+	}
+
+	// Validation passed
+	return nil
+}
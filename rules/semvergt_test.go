@@ -0,0 +1,36 @@
+package rules
+
+import (
+	"go-runtimevalidation/args"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSemVerGT(t *testing.T) {
+	t.Run("Greater version", func(t *testing.T) {
+		assert.NoError(t, SemVerGT("1.10.0", nil, map[string]args.Arg{"1.9.0": {Value: "1.9.0"}}))
+		assert.NoError(t, SemVerGT("1.0.0", nil, map[string]args.Arg{"1.0.0-rc.1": {Value: "1.0.0-rc.1"}}))
+	})
+
+	t.Run("Equal version", func(t *testing.T) {
+		err := SemVerGT("1.0.0+build.2", nil, map[string]args.Arg{"1.0.0+build.1": {Value: "1.0.0+build.1"}})
+		assert.EqualError(t, err, "semvergt validation failed: 1.0.0+build.2 <= 1.0.0+build.1")
+	})
+
+	t.Run("Minimum from field", func(t *testing.T) {
+		obj := struct{ MinVersion string }{MinVersion: "2.0.0"}
+		err := SemVerGT("1.10.0-rc.1", obj, map[string]args.Arg{"$MinVersion": {Type: args.FieldArg, Field: "MinVersion"}})
+		assert.EqualError(t, err, "semvergt validation failed: 1.10.0-rc.1 <= 2.0.0")
+	})
+
+	t.Run("Invalid argument", func(t *testing.T) {
+		err := SemVerGT("1.0.0", nil, map[string]args.Arg{"latest": {Value: "latest"}})
+		assert.EqualError(t, err, `invalid semvergt argument: failed to parse "latest" as semver: version "latest" is not of the form MAJOR.MINOR.PATCH`)
+	})
+
+	t.Run("Wrong number of arguments", func(t *testing.T) {
+		err := SemVerGT("1.0.0", nil, map[string]args.Arg{})
+		assert.EqualError(t, err, "semvergt expects exactly 1 argument, got 0")
+	})
+}
@@ -0,0 +1,25 @@
+package rules
+
+import (
+	"go-runtimevalidation/args"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSemVerGTE(t *testing.T) {
+	t.Run("Greater or equal version", func(t *testing.T) {
+		assert.NoError(t, SemVerGTE("1.10.0", nil, map[string]args.Arg{"1.9.0": {Value: "1.9.0"}}))
+		assert.NoError(t, SemVerGTE("1.9.0", nil, map[string]args.Arg{"1.9.0": {Value: "1.9.0"}}))
+	})
+
+	t.Run("Lower version", func(t *testing.T) {
+		err := SemVerGTE("1.9.0", nil, map[string]args.Arg{"1.10.0": {Value: "1.10.0"}})
+		assert.EqualError(t, err, "semvergte validation failed: 1.9.0 < 1.10.0")
+	})
+
+	t.Run("Invalid input", func(t *testing.T) {
+		err := SemVerGTE(1.9, nil, map[string]args.Arg{"1.10.0": {Value: "1.10.0"}})
+		assert.EqualError(t, err, "invalid input field: failed to parse 1.9 of type float64 as semver")
+	})
+}
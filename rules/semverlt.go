@@ -0,0 +1,42 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/args"
+)
+
+// SemVerLT validates that the input is a semantic version less than another version, compared by precedence (see SemVerGT).
+// It is expected to be used in validation rules such as `semverlt:1.2.0` or `semverlt:$MaxVersion`.
+//
+// Parameters:
+// - input: The value being validated. It can be a version string or a semver.Version.
+// - obj: The object containing additional data (can be used for Field references within the args).
+// - args: A map containing exactly one argument, the version to compare against.
+//
+// Returns nil if the input is valid, or an error if:
+// - The number of arguments is not equal to 1
+// - The input or the argument is not a valid semantic version
+// - The input is not less than the argument.
+//
+// Example:
+//
+//	input := "2.0.0"
+//	obj := nil
+//	args := map[string]Arg{
+//	    "2.0.0-rc.1": {Value: "2.0.0-rc.1"},
+//	}
+//	err := SemVerLT(input, obj, args)  // err will be: "semverlt validation failed: 2.0.0 >= 2.0.0-rc.1"
+func SemVerLT(input any, obj any, arguments map[string]args.Arg) error {
+	lhs, rhs, err := semVerOperands("semverlt", input, obj, arguments)
+	if err != nil {
+		return err
+	}
+
+	// Compare versions
+	if !(lhs.Compare(rhs) < 0) {
+		return fmt.Errorf("semverlt validation failed: %s >= %s", lhs, rhs)
+	}
+
+	// Validation passed
+	return nil
+}
@@ -0,0 +1,25 @@
+package rules
+
+import (
+	"go-runtimevalidation/args"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSemVerLT(t *testing.T) {
+	t.Run("Lower version", func(t *testing.T) {
+		assert.NoError(t, SemVerLT("2.0.0-rc.1", nil, map[string]args.Arg{"2.0.0": {Value: "2.0.0"}}))
+		assert.NoError(t, SemVerLT("1.0.0-alpha.beta", nil, map[string]args.Arg{"1.0.0-beta": {Value: "1.0.0-beta"}}))
+	})
+
+	t.Run("Greater version", func(t *testing.T) {
+		err := SemVerLT("2.0.0", nil, map[string]args.Arg{"2.0.0-rc.1": {Value: "2.0.0-rc.1"}})
+		assert.EqualError(t, err, "semverlt validation failed: 2.0.0 >= 2.0.0-rc.1")
+	})
+
+	t.Run("Maximum from field", func(t *testing.T) {
+		obj := struct{ MaxVersion string }{MaxVersion: "1.0.0-beta.11"}
+		assert.NoError(t, SemVerLT("1.0.0-beta.2", obj, map[string]args.Arg{"$MaxVersion": {Type: args.FieldArg, Field: "MaxVersion"}}))
+	})
+}
@@ -0,0 +1,42 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/args"
+)
+
+// SemVerLTE validates that the input is a semantic version less than or equal to another version, compared by precedence (see SemVerGT).
+// It is expected to be used in validation rules such as `semverlte:1.2.0` or `semverlte:$MaxVersion`.
+//
+// Parameters:
+// - input: The value being validated. It can be a version string or a semver.Version.
+// - obj: The object containing additional data (can be used for Field references within the args).
+// - args: A map containing exactly one argument, the version to compare against.
+//
+// Returns nil if the input is valid, or an error if:
+// - The number of arguments is not equal to 1
+// - The input or the argument is not a valid semantic version
+// - The input is not less than or equal to the argument.
+//
+// Example:
+//
+//	input := "1.10.0"
+//	obj := nil
+//	args := map[string]Arg{
+//	    "1.9.0": {Value: "1.9.0"},
+//	}
+//	err := SemVerLTE(input, obj, args)  // err will be: "semverlte validation failed: 1.10.0 > 1.9.0"
+func SemVerLTE(input any, obj any, arguments map[string]args.Arg) error {
+	lhs, rhs, err := semVerOperands("semverlte", input, obj, arguments)
+	if err != nil {
+		return err
+	}
+
+	// Compare versions
+	if !(lhs.Compare(rhs) <= 0) {
+		return fmt.Errorf("semverlte validation failed: %s > %s", lhs, rhs)
+	}
+
+	// Validation passed
+	return nil
+}
@@ -0,0 +1,20 @@
+package rules
+
+import (
+	"go-runtimevalidation/args"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSemVerLTE(t *testing.T) {
+	t.Run("Lower or equal version", func(t *testing.T) {
+		assert.NoError(t, SemVerLTE("1.9.0", nil, map[string]args.Arg{"1.10.0": {Value: "1.10.0"}}))
+		assert.NoError(t, SemVerLTE("1.10.0", nil, map[string]args.Arg{"1.10.0": {Value: "1.10.0"}}))
+	})
+
+	t.Run("Greater version", func(t *testing.T) {
+		err := SemVerLTE("1.10.0", nil, map[string]args.Arg{"1.9.0": {Value: "1.9.0"}})
+		assert.EqualError(t, err, "semverlte validation failed: 1.10.0 > 1.9.0")
+	})
+}
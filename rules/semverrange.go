@@ -0,0 +1,90 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/functions"
+	"go-runtimevalidation/semver"
+	"sort"
+	"strings"
+)
+
+// SemVerRange validates that the input is a semantic version within a version range.
+// It is expected to be used in validation rules such as `semverrange:">=1.2.0 <2.0.0"`, `semverrange:^1.2,~2.0.3`
+// or `semverrange:$Supported,prerelease`. Ranges containing operators or spaces must be quoted.
+//
+// Ranges use the npm syntax described in semver.ParseRange: primitives (<, <=, >, >=, =), wildcards (1.x, 1.2.*),
+// tilde (~1.2.3), caret (^1.2.3) and hyphen (1.2.3 - 2.0.0) ranges, combined with "||". Several range arguments
+// are combined with "||" as well. Versions are compared by precedence as defined by semver.org.
+//
+// A prerelease version only satisfies a range that explicitly mentions a prerelease of the same major, minor and patch version,
+// so that `^1.2.0` accepts 1.3.0 but not 1.3.0-rc.1. The `prerelease` argument lets prereleases satisfy ranges by precedence alone.
+//
+// Parameters:
+// - input: The value being validated. It can be a version string or a semver.Version.
+// - obj: The object containing additional data (can be used for Field references within the args).
+// - args: A map of ranges, as values or Field references, and the optional `prerelease` flag. At least one range is required.
+//
+// Returns nil if the input is valid, or an error if:
+// - No range is given or a range cannot be parsed
+// - The input is not a valid semantic version
+// - The input does not satisfy any of the ranges.
+//
+// Example:
+//
+//	input := "2.1.0"
+//	obj := nil
+//	args := map[string]Arg{
+//	    `">=1.2.0 <2.0.0"`: {Value: ">=1.2.0 <2.0.0"},
+//	}
+//	err := SemVerRange(input, obj, args)  // err will be: "semverrange validation failed: 2.1.0 does not satisfy >=1.2.0 <2.0.0"
+func SemVerRange(input any, obj any, arguments map[string]args.Arg) error {
+	var ranges []semver.Range
+	includePrerelease := false
+	for key, arg := range arguments {
+		if arg.Type == args.ValueArg && arg.Value == "prerelease" {
+			includePrerelease = true
+			continue
+		}
+
+		eval, err := arg.Evaluate(obj)
+		if err != nil {
+			return err
+		}
+
+		text, ok := eval.(string)
+		if !ok && arg.Type == args.ValueArg {
+			// Unquoted ranges such as 1.10 are parsed as numbers, so use the argument as written
+			text = key
+		} else if !ok {
+			return fmt.Errorf("unsupported type for semverrange argument %s: %T", key, eval)
+		}
+
+		r, err := semver.ParseRange(text)
+		if err != nil {
+			return fmt.Errorf("invalid semverrange argument %s: %w", key, err)
+		}
+		ranges = append(ranges, r)
+	}
+
+	if len(ranges) == 0 {
+		return fmt.Errorf("semverrange expects at least one range")
+	}
+
+	// Get the value of the input
+	version, err := functions.GetSemVer(input)
+	if err != nil {
+		return fmt.Errorf("invalid input field: %w", err)
+	}
+
+	texts := make([]string, len(ranges))
+	for i, r := range ranges {
+		if r.Contains(version) || includePrerelease && r.ContainsPrerelease(version) {
+			return nil
+		}
+		texts[i] = r.String()
+	}
+
+	sort.Strings(texts)
+	return fmt.Errorf("semverrange validation failed: %s does not satisfy %s", version, strings.Join(texts, " || "))
+}
@@ -0,0 +1,71 @@
+package rules
+
+import (
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/semver"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSemVerRange(t *testing.T) {
+	between := map[string]args.Arg{`">=1.2.0 <2.0.0"`: {Value: ">=1.2.0 <2.0.0"}}
+
+	t.Run("Version in range", func(t *testing.T) {
+		assert.NoError(t, SemVerRange("1.2.0", nil, between))
+		assert.NoError(t, SemVerRange(semver.MustParse("1.9.9"), nil, between))
+	})
+
+	t.Run("Version out of range", func(t *testing.T) {
+		err := SemVerRange("2.1.0", nil, between)
+		assert.EqualError(t, err, "semverrange validation failed: 2.1.0 does not satisfy >=1.2.0 <2.0.0")
+	})
+
+	t.Run("Several ranges", func(t *testing.T) {
+		ranges := map[string]args.Arg{"^1.2": {Value: "^1.2"}, "~3.0.1": {Value: "~3.0.1"}}
+		assert.NoError(t, SemVerRange("1.4.0", nil, ranges))
+		assert.NoError(t, SemVerRange("3.0.5", nil, ranges))
+
+		err := SemVerRange("3.1.0", nil, ranges)
+		assert.EqualError(t, err, "semverrange validation failed: 3.1.0 does not satisfy ^1.2 || ~3.0.1")
+	})
+
+	t.Run("Unquoted numeric range", func(t *testing.T) {
+		// 1.10 is parsed as the number 1.1, the range is taken as written
+		ranges := map[string]args.Arg{"1.10": {Value: 1.1}}
+		assert.NoError(t, SemVerRange("1.10.3", nil, ranges))
+		assert.Error(t, SemVerRange("1.1.0", nil, ranges))
+	})
+
+	t.Run("Prereleases", func(t *testing.T) {
+		caret := map[string]args.Arg{"^1.2.0": {Value: "^1.2.0"}}
+		err := SemVerRange("1.3.0-rc.1", nil, caret)
+		assert.EqualError(t, err, "semverrange validation failed: 1.3.0-rc.1 does not satisfy ^1.2.0")
+
+		assert.NoError(t, SemVerRange("1.3.0-rc.1", nil, map[string]args.Arg{`">=1.3.0-rc.0 <2.0.0"`: {Value: ">=1.3.0-rc.0 <2.0.0"}}))
+
+		caret["prerelease"] = args.Arg{Value: "prerelease"}
+		assert.NoError(t, SemVerRange("1.3.0-rc.1", nil, caret))
+		assert.Error(t, SemVerRange("2.0.0-rc.1", nil, caret))
+	})
+
+	t.Run("Range from field", func(t *testing.T) {
+		obj := struct{ Supported string }{Supported: "1.x || 2.x"}
+		arguments := map[string]args.Arg{"$Supported": {Type: args.FieldArg, Field: "Supported"}}
+		assert.NoError(t, SemVerRange("2.5.0", obj, arguments))
+		assert.Error(t, SemVerRange("3.0.0", obj, arguments))
+	})
+
+	t.Run("Invalid input", func(t *testing.T) {
+		err := SemVerRange("1.2", nil, between)
+		assert.EqualError(t, err, `invalid input field: failed to parse "1.2" as semver: version "1.2" is not of the form MAJOR.MINOR.PATCH`)
+	})
+
+	t.Run("Invalid arguments", func(t *testing.T) {
+		err := SemVerRange("1.2.0", nil, map[string]args.Arg{"1.2.z": {Value: "1.2.z"}})
+		assert.EqualError(t, err, `invalid semverrange argument 1.2.z: comparator "1.2.z": patch version "z" is not a number`)
+
+		err = SemVerRange("1.2.0", nil, map[string]args.Arg{"prerelease": {Value: "prerelease"}})
+		assert.EqualError(t, err, "semverrange expects at least one range")
+	})
+}
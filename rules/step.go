@@ -0,0 +1,99 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/functions"
+	"math/big"
+)
+
+// stepOptions are the options of the step rule.
+var stepOptions = args.Options{Rule: "step", Values: []string{"base"}}
+
+// Step validates that the input is on a grid of values spaced by a step, like the step attribute of an HTML number input.
+// It is expected to be used in validation rules such as `step:0.25`, `step:5,base=1` or `step:$Increment,base=$Min`.
+//
+// The valid values are base + k * step for any integer k, with a base of 0 by default, so that `step:5,base=1`
+// accepts 1, 6 and -4. The input and the arguments are converted with functions.GetNumber and compared exactly,
+// and the error names the nearest valid values.
+//
+// Parameters:
+// - input: The value being validated. It can be an integer, a float, a *big.Int, *big.Float or *big.Rat, or a decimal string.
+// - obj: The object containing additional data (can be used for Field references within the args).
+// - args: A map containing the positive step, as a value or a Field reference, and the optional `base=N` option.
+//
+// Returns nil if the input is valid, or an error if:
+// - The step is missing, not positive or given twice, or an option is invalid
+// - The input is not a finite number
+// - The input is not on the grid.
+//
+// Example:
+//
+//	input := 1.3
+//	obj := nil
+//	args := map[string]Arg{
+//	    "0.25":   {Value: 0.25},
+//	    "base=1": {Value: "base=1"},
+//	}
+//	err := Step(input, obj, args)  // err will be: "step validation failed: 1.3 is not a valid step, the nearest valid values are 1.25 and 1.5"
+func Step(input any, obj any, arguments map[string]args.Arg) error {
+	var step *big.Rat
+	base := new(big.Rat)
+	for key, arg := range arguments {
+		if stepOptions.IsOption(arg) {
+			option, err := stepOptions.Parse(key, arg)
+			if err != nil {
+				return err
+			}
+
+			eval, err := option.Evaluate(obj)
+			if err != nil {
+				return err
+			}
+			value, err := functions.GetNumber(eval)
+			if err != nil {
+				return fmt.Errorf("step: invalid value for base: %s", option.Text)
+			}
+			base = value
+			continue
+		}
+
+		if step != nil {
+			return fmt.Errorf("step expects exactly 1 step, got %s as well", key)
+		}
+
+		eval, err := arg.Evaluate(obj)
+		if err != nil {
+			return err
+		}
+		value, err := functions.GetNumber(eval)
+		if err != nil {
+			return fmt.Errorf("unsupported type for step argument: %w", err)
+		}
+		if value.Sign() <= 0 {
+			return fmt.Errorf("step argument must be positive, got %s", functions.FormatNumber(value))
+		}
+		step = value
+	}
+
+	if step == nil {
+		return fmt.Errorf("step expects exactly 1 step, got none")
+	}
+
+	value, err := functions.GetNumber(input)
+	if err != nil {
+		return fmt.Errorf("unsupported type for input field: %w", err)
+	}
+
+	// Number of whole steps from the base, rounded down
+	steps := new(big.Rat).Quo(new(big.Rat).Sub(value, base), step)
+	if steps.IsInt() {
+		return nil
+	}
+	k := new(big.Int).Div(steps.Num(), steps.Denom())
+
+	lower := new(big.Rat).Add(base, new(big.Rat).Mul(new(big.Rat).SetInt(k), step))
+	upper := new(big.Rat).Add(lower, step)
+	return fmt.Errorf("step validation failed: %s is not a valid step, the nearest valid values are %s and %s",
+		functions.FormatNumber(value), functions.FormatNumber(lower), functions.FormatNumber(upper))
+}
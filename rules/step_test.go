@@ -0,0 +1,210 @@
+package rules
+
+import (
+	"go-runtimevalidation/args"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStep(t *testing.T) {
+	// Test case 1: Zero is on every grid
+	t.Run("Zero", func(t *testing.T) {
+		err := Step(0, nil, map[string]args.Arg{
+			"0.25": {Value: 0.25},
+		})
+		assert.NoError(t, err)
+	})
+
+	// Test case 2: Input on the grid
+	t.Run("Input on the grid", func(t *testing.T) {
+		err := Step(1.75, nil, map[string]args.Arg{
+			"0.25": {Value: 0.25},
+		})
+		assert.NoError(t, err)
+	})
+
+	// Test case 3: Negative input on the grid
+	t.Run("Negative input on the grid", func(t *testing.T) {
+		err := Step(-0.5, nil, map[string]args.Arg{
+			"0.25": {Value: 0.25},
+		})
+		assert.NoError(t, err)
+	})
+
+	// Test case 4: Decimal string on the grid
+	t.Run("Decimal string on the grid", func(t *testing.T) {
+		err := Step("100.00", nil, map[string]args.Arg{
+			"0.25": {Value: 0.25},
+		})
+		assert.NoError(t, err)
+	})
+
+	// Test case 5: big.Rat on the grid
+	t.Run("big.Rat on the grid", func(t *testing.T) {
+		err := Step(big.NewRat(5, 4), nil, map[string]args.Arg{
+			"0.25": {Value: 0.25},
+		})
+		assert.NoError(t, err)
+	})
+
+	// Test case 6: Without a base, the nearest valid values are multiples of the step
+	t.Run("Input off the grid", func(t *testing.T) {
+		err := Step(1.3, nil, map[string]args.Arg{
+			"0.25": {Value: 0.25},
+		})
+		assert.Error(t, err)
+		assert.EqualError(t, err, "step validation failed: 1.3 is not a valid step, the nearest valid values are 1.25 and 1.5")
+	})
+
+	// Test case 7: Negative input off the grid
+	t.Run("Negative input off the grid", func(t *testing.T) {
+		err := Step(-0.1, nil, map[string]args.Arg{
+			"0.25": {Value: 0.25},
+		})
+		assert.Error(t, err)
+		assert.EqualError(t, err, "step validation failed: -0.1 is not a valid step, the nearest valid values are -0.25 and 0")
+	})
+
+	// Test case 8: An explicit zero base gives the same grid as no base
+	t.Run("Explicit zero base", func(t *testing.T) {
+		err := Step(1.3, nil, map[string]args.Arg{
+			"0.25":   {Value: 0.25},
+			"base=0": {Value: "base=0"},
+		})
+		assert.Error(t, err)
+		assert.EqualError(t, err, "step validation failed: 1.3 is not a valid step, the nearest valid values are 1.25 and 1.5")
+	})
+
+	// Test case 9: Nearest valid values around zero
+	t.Run("Explicit zero base with negative input", func(t *testing.T) {
+		err := Step(-0.1, nil, map[string]args.Arg{
+			"0.25":   {Value: 0.25},
+			"base=0": {Value: "base=0"},
+		})
+		assert.Error(t, err)
+		assert.EqualError(t, err, "step validation failed: -0.1 is not a valid step, the nearest valid values are -0.25 and 0")
+	})
+
+	// Test case 10: The base itself is valid
+	t.Run("Input equals base", func(t *testing.T) {
+		err := Step(1, nil, map[string]args.Arg{
+			"5":      {Value: 5},
+			"base=1": {Value: "base=1"},
+		})
+		assert.NoError(t, err)
+	})
+
+	// Test case 11: Input above the base
+	t.Run("Input above base", func(t *testing.T) {
+		err := Step(16, nil, map[string]args.Arg{
+			"5":      {Value: 5},
+			"base=1": {Value: "base=1"},
+		})
+		assert.NoError(t, err)
+	})
+
+	// Test case 12: Input below the base
+	t.Run("Input below base", func(t *testing.T) {
+		err := Step(-4, nil, map[string]args.Arg{
+			"5":      {Value: 5},
+			"base=1": {Value: "base=1"},
+		})
+		assert.NoError(t, err)
+	})
+
+	// Test case 13: Input off a grid with a base
+	t.Run("Input off the grid with base", func(t *testing.T) {
+		err := Step(10, nil, map[string]args.Arg{
+			"5":      {Value: 5},
+			"base=1": {Value: "base=1"},
+		})
+		assert.Error(t, err)
+		assert.EqualError(t, err, "step validation failed: 10 is not a valid step, the nearest valid values are 6 and 11")
+	})
+
+	// Test case 14: Step and base from Field references
+	t.Run("Field references", func(t *testing.T) {
+		obj := struct {
+			Increment string
+			Min       float64
+		}{Increment: "0.1", Min: 0.05}
+
+		err := Step(0.35, obj, map[string]args.Arg{
+			"$Increment": {Type: args.FieldArg, Field: "Increment"},
+			"base=$Min":  {Value: "base=$Min"},
+		})
+		assert.NoError(t, err)
+	})
+
+	// Test case 15: Input off a grid from Field references
+	t.Run("Field references off the grid", func(t *testing.T) {
+		obj := struct {
+			Increment string
+			Min       float64
+		}{Increment: "0.1", Min: 0.05}
+
+		err := Step(0.3, obj, map[string]args.Arg{
+			"$Increment": {Type: args.FieldArg, Field: "Increment"},
+			"base=$Min":  {Value: "base=$Min"},
+		})
+		assert.Error(t, err)
+		assert.EqualError(t, err, "step validation failed: 0.3 is not a valid step, the nearest valid values are 0.25 and 0.35")
+	})
+
+	// Test case 16: Missing step
+	t.Run("Missing step", func(t *testing.T) {
+		err := Step(1, nil, map[string]args.Arg{})
+		assert.Error(t, err)
+		assert.EqualError(t, err, "step expects exactly 1 step, got none")
+	})
+
+	// Test case 17: Step is not positive
+	t.Run("Zero step", func(t *testing.T) {
+		err := Step(1, nil, map[string]args.Arg{
+			"0": {Value: 0},
+		})
+		assert.Error(t, err)
+		assert.EqualError(t, err, "step argument must be positive, got 0")
+	})
+
+	// Test case 18: Unknown option
+	t.Run("Unknown option", func(t *testing.T) {
+		err := Step(1, nil, map[string]args.Arg{
+			"1":        {Value: 1},
+			"offset=1": {Value: "offset=1"},
+		})
+		assert.Error(t, err)
+		assert.EqualError(t, err, "step: unknown option offset=1")
+	})
+
+	// Test case 19: Base is not a number
+	t.Run("Invalid base", func(t *testing.T) {
+		err := Step(1, nil, map[string]args.Arg{
+			"1":      {Value: 1},
+			"base=x": {Value: "base=x"},
+		})
+		assert.Error(t, err)
+		assert.EqualError(t, err, "step: invalid value for base: x")
+	})
+
+	// Test case 20: Base without a value
+	t.Run("Missing base value", func(t *testing.T) {
+		err := Step(1, nil, map[string]args.Arg{
+			"1":    {Value: 1},
+			"base": {Value: "base"},
+		})
+		assert.Error(t, err)
+		assert.EqualError(t, err, "step: missing value for base")
+	})
+
+	// Test case 21: Input is not a number
+	t.Run("Non-numeric input", func(t *testing.T) {
+		err := Step("x", nil, map[string]args.Arg{
+			"0.25": {Value: 0.25},
+		})
+		assert.Error(t, err)
+		assert.EqualError(t, err, "unsupported type for input field: failed to parse \"x\" of type string as a number")
+	})
+}
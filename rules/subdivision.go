@@ -0,0 +1,66 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/functions"
+	"go-runtimevalidation/iso"
+)
+
+// Subdivision validates that the input is an ISO 3166-2 subdivision code, e.g. "DE-BY" or "US-CA".
+// It is expected to be used in validation rules such as `subdivision` or `subdivision:$Country`,
+// where the optional argument is the ISO 3166-1 alpha-2 code of the country the subdivision must belong to.
+//
+// Parameters:
+// - input: The value being validated, expected to be a string.
+// - obj: The object containing additional data (can be used for Field references within the args).
+// - args: A map containing at most one argument, the country. May be empty.
+//
+// Returns nil if the input is valid, or an error if:
+// - More than one argument is provided
+// - The input is not a known subdivision code
+// - The subdivision does not belong to the given country.
+//
+// Example:
+//
+//	input := "US-CA"
+//	obj := struct{ Country string }{Country: "DE"}
+//	args := map[string]Arg{
+//	    "$Country": {Type: FieldArg, Field: "Country"},
+//	}
+//	err := Subdivision(input, obj, args)  // err will be: "subdivision validation failed: US-CA is not in DE"
+func Subdivision(input any, obj any, arguments map[string]args.Arg) error {
+	if len(arguments) > 1 {
+		return fmt.Errorf("subdivision expects at most 1 argument, got %d", len(arguments))
+	}
+
+	// Check if the input is a string
+	value, err := functions.GetString(input)
+	if err != nil {
+		return fmt.Errorf("expected a string, got %T", input)
+	}
+
+	subdivision, ok := iso.SubdivisionByCode(value)
+	if !ok {
+		return fmt.Errorf("invalid subdivision: %s", value)
+	}
+
+	// Get the optional country argument
+	for _, v := range arguments {
+		eval, err := v.Evaluate(obj)
+		if err != nil {
+			return err
+		}
+
+		country, err := functions.GetString(eval)
+		if err != nil {
+			return fmt.Errorf("unsupported type for subdivision argument: %w", err)
+		}
+
+		if subdivision.Country() != country {
+			return fmt.Errorf("subdivision validation failed: %s is not in %s", value, country)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package rules
+
+import (
+	"go-runtimevalidation/args"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubdivision(t *testing.T) {
+	t.Run("Valid subdivision", func(t *testing.T) {
+		assert.NoError(t, Subdivision("DE-BY", nil, nil))
+		assert.NoError(t, Subdivision("US-CA", nil, nil))
+		assert.NoError(t, Subdivision("GB-ABE", nil, nil))
+	})
+
+	t.Run("Unknown subdivision", func(t *testing.T) {
+		err := Subdivision("DE-XX", nil, nil)
+		assert.Error(t, err)
+		assert.Equal(t, "invalid subdivision: DE-XX", err.Error())
+	})
+
+	t.Run("Country code only", func(t *testing.T) {
+		assert.Error(t, Subdivision("DE", nil, nil))
+	})
+
+	t.Run("Subdivision of the given country", func(t *testing.T) {
+		obj := struct{ Country string }{Country: "US"}
+		err := Subdivision("US-CA", obj, map[string]args.Arg{"$Country": {Type: args.FieldArg, Field: "Country"}})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Subdivision of another country", func(t *testing.T) {
+		err := Subdivision("US-CA", nil, map[string]args.Arg{"DE": {Value: "DE"}})
+		assert.Error(t, err)
+		assert.Equal(t, "subdivision validation failed: US-CA is not in DE", err.Error())
+	})
+
+	t.Run("Too many arguments", func(t *testing.T) {
+		err := Subdivision("US-CA", nil, map[string]args.Arg{"DE": {Value: "DE"}, "US": {Value: "US"}})
+		assert.Error(t, err)
+		assert.Equal(t, "subdivision expects at most 1 argument, got 2", err.Error())
+	})
+}
@@ -0,0 +1,40 @@
+package rules
+
+import (
+	"fmt"
+	"time"
+	_ "time/tzdata" // embed the IANA time zone database so validation does not depend on the host
+)
+
+// Timezone validates that the input is an IANA time zone name such as "Europe/Berlin" or "UTC".
+// The time zone database is embedded in the binary, so results are the same on every host.
+// The special names "" and "Local", which time.LoadLocation also accepts, are rejected.
+//
+// Parameters:
+// - input: The value being validated, expected to be a string.
+//
+// Returns nil if the input is a valid time zone, or an error if:
+// - The input is not a string
+// - The input is not a known IANA time zone name.
+//
+// Example:
+//
+//	err := Timezone("America/New_York") // err will be nil
+//	err := Timezone("Mars/Olympus")     // err will be: "invalid timezone: Mars/Olympus"
+func Timezone(input any) error {
+	// Check if the input is a string
+	value, ok := input.(string)
+	if !ok {
+		return fmt.Errorf("expected a string, got %T", input)
+	}
+
+	if value == "" || value == "Local" {
+		return fmt.Errorf("invalid timezone: %s", value)
+	}
+
+	if _, err := time.LoadLocation(value); err != nil {
+		return fmt.Errorf("invalid timezone: %s", value)
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimezone(t *testing.T) {
+	t.Run("Valid IANA zone", func(t *testing.T) {
+		assert.NoError(t, Timezone("Europe/Berlin"))
+	})
+
+	t.Run("Valid UTC", func(t *testing.T) {
+		assert.NoError(t, Timezone("UTC"))
+	})
+
+	t.Run("Unknown zone", func(t *testing.T) {
+		assert.EqualError(t, Timezone("Mars/Olympus"), "invalid timezone: Mars/Olympus")
+	})
+
+	t.Run("Local is rejected", func(t *testing.T) {
+		assert.EqualError(t, Timezone("Local"), "invalid timezone: Local")
+	})
+
+	t.Run("Empty string", func(t *testing.T) {
+		assert.EqualError(t, Timezone(""), "invalid timezone: ")
+	})
+
+	t.Run("Invalid input type", func(t *testing.T) {
+		assert.EqualError(t, Timezone(1), "expected a string, got int")
+	})
+}
@@ -3,10 +3,13 @@ package rules
 import (
 	"fmt"
 	"go-runtimevalidation/regex"
+	"strings"
+	"time"
 )
 
 // ULID validates if the input is a valid ULID (Universally Unique Lexicographically Sortable Identifier).
-// A ULID is a 26-character string consisting of upper-case letters and numbers, following a specific format.
+// A ULID is a 26-character string in Crockford base32 (digits and letters other than I, L, O and U, in either case),
+// starting with a 48-bit timestamp. Values above 7ZZZZZZZZZZZZZZZZZZZZZZZZZ overflow 128 bits and are rejected.
 //
 // Parameters:
 // - input: The value to be validated, expected to be a string.
@@ -30,9 +33,29 @@ func ULID(input any) error {
 	}
 
 	// Check if the string is a valid ULID value
-	if !regex.ULIDRegex().MatchString(value) {
+	if _, err := ulidTime(value); err != nil {
 		return fmt.Errorf("invalid ULID value: %s", value)
 	}
 
 	return nil
 }
+
+// ulidAlphabet is the Crockford base32 alphabet used by ULIDs.
+const ulidAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// ulidTime returns the timestamp in the first 10 characters of a ULID, a Unix time in milliseconds.
+// The first character is at most 7, since a ULID holds 128 bits and 26 base32 characters could hold 130.
+func ulidTime(value string) (time.Time, error) {
+	if !regex.ULIDRegex().MatchString(value) {
+		return time.Time{}, fmt.Errorf("invalid ULID")
+	}
+	if value[0] > '7' {
+		return time.Time{}, fmt.Errorf("ULID overflows 128 bits")
+	}
+
+	var ms int64
+	for _, c := range strings.ToUpper(value[:10]) {
+		ms = ms<<5 | int64(strings.IndexRune(ulidAlphabet, c))
+	}
+	return time.UnixMilli(ms).UTC(), nil
+}
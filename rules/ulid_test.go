@@ -20,6 +20,11 @@ func TestULID(t *testing.T) {
 		assert.EqualError(t, err, "invalid ULID value: invalid-ulid")
 	})
 
+	t.Run("Invalid ULID - overflows 128 bits", func(t *testing.T) {
+		err := ULID("80000000000000000000000000")
+		assert.EqualError(t, err, "invalid ULID value: 80000000000000000000000000")
+	})
+
 	t.Run("Non-string input", func(t *testing.T) {
 		input := 12345
 		err := ULID(input)
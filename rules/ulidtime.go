@@ -0,0 +1,48 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/args"
+)
+
+// ULIDTime validates that the input is a ULID whose embedded timestamp, a Unix time in milliseconds, lies within bounds.
+// It is expected to be used in validation rules such as `ulidtime:past,maxage=720h`.
+// It takes the same arguments as UUIDTime: past, maxage=D, after=T, before=T and leeway=D.
+//
+// Parameters:
+// - input: The value being validated, expected to be a string.
+// - obj: The object containing additional data (can be used for Field references within the args).
+// - args: A map of bound arguments. At least one is required.
+//
+// Returns nil if the input is valid, or an error if:
+// - An argument is not a known option or has an invalid value
+// - The input is not a valid ULID
+// - The timestamp is out of bounds.
+//
+// Example:
+//
+//	input := "01ARZ3NDEKTSV4RRFFQ69G5FAV"  // 2016-07-30T23:54:10.259Z
+//	obj := nil
+//	args := map[string]Arg{
+//	    "maxage=720h": {Value: "maxage=720h"},
+//	}
+//	err := ULIDTime(input, obj, args)  // err will be: "ulidtime validation failed: timestamp 2016-07-30T23:54:10.259Z is older than 720h0m0s"
+func ULIDTime(input any, obj any, arguments map[string]args.Arg) error {
+	policy, err := parseIDTimePolicy("ulidtime", obj, arguments)
+	if err != nil {
+		return err
+	}
+
+	// Check if the input is a string
+	value, ok := input.(string)
+	if !ok {
+		return fmt.Errorf("expected a string, got %T", input)
+	}
+
+	timestamp, err := ulidTime(value)
+	if err != nil {
+		return fmt.Errorf("invalid ULID value: %s", value)
+	}
+
+	return policy.check("ulidtime", timestamp)
+}
@@ -0,0 +1,43 @@
+package rules
+
+import (
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/functions"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestULIDTime(t *testing.T) {
+	restore := functions.SetClock(func() time.Time { return time.Date(2016, 8, 1, 0, 0, 0, 0, time.UTC) })
+	defer restore()
+
+	// 01ARZ3NDEKTSV4RRFFQ69G5FAV was generated at 2016-07-30T23:54:10.259Z
+	ulid := "01ARZ3NDEKTSV4RRFFQ69G5FAV"
+
+	t.Run("Within bounds", func(t *testing.T) {
+		assert.NoError(t, ULIDTime(ulid, nil, map[string]args.Arg{"past": {Value: "past"}, "maxage=72h": {Value: "maxage=72h"}}))
+		assert.NoError(t, ULIDTime("01arz3ndektsv4rrffq69g5fav", nil, map[string]args.Arg{"past": {Value: "past"}}))
+	})
+
+	t.Run("Too old", func(t *testing.T) {
+		err := ULIDTime(ulid, nil, map[string]args.Arg{"maxage=1h": {Value: "maxage=1h"}})
+		assert.EqualError(t, err, "ulidtime validation failed: timestamp 2016-07-30T23:54:10.259Z is older than 1h0m0s")
+	})
+
+	t.Run("In the future", func(t *testing.T) {
+		err := ULIDTime("7ZZZZZZZZZZZZZZZZZZZZZZZZZ", nil, map[string]args.Arg{"past": {Value: "past"}})
+		assert.EqualError(t, err, "ulidtime validation failed: timestamp 10889-08-02T05:31:50.655Z is in the future")
+	})
+
+	t.Run("Invalid ULID", func(t *testing.T) {
+		err := ULIDTime("8ZZZZZZZZZZZZZZZZZZZZZZZZZ", nil, map[string]args.Arg{"past": {Value: "past"}})
+		assert.EqualError(t, err, "invalid ULID value: 8ZZZZZZZZZZZZZZZZZZZZZZZZZ")
+	})
+
+	t.Run("Wrong type", func(t *testing.T) {
+		err := ULIDTime(42, nil, map[string]args.Arg{"past": {Value: "past"}})
+		assert.EqualError(t, err, "expected a string, got int")
+	})
+}
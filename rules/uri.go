@@ -0,0 +1,58 @@
+package rules
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// URI validates that the input is an absolute URI as defined by RFC 3986, which requires a scheme.
+// Unlike URL, non-hierarchical identifiers such as "mailto:user@example.com" or "urn:isbn:0451450523" are accepted.
+//
+// Parameters:
+// - input: The value being validated. It can be a string, a url.URL or a *url.URL.
+//
+// Returns nil if the input is a valid URI, or an error if:
+// - The input is not a string, url.URL or *url.URL
+// - The input cannot be parsed or has no scheme.
+//
+// Example:
+//
+//	err := URI("urn:isbn:0451450523") // err will be nil
+//	err := URI("/relative/path")      // err will be: "invalid uri: /relative/path"
+func URI(input any) error {
+	_, err := getURI(input)
+	return err
+}
+
+// getURI converts the input into a *url.URL with a scheme.
+func getURI(input any) (*url.URL, error) {
+	var u *url.URL
+	switch v := input.(type) {
+	case string:
+		// Whitespace is not allowed anywhere in a URI, while url.Parse tolerates some of it
+		if strings.ContainsAny(v, " \t\r\n") {
+			return nil, fmt.Errorf("invalid uri: %s", v)
+		}
+		parsed, err := url.Parse(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid uri: %s", v)
+		}
+		u = parsed
+	case url.URL:
+		u = &v
+	case *url.URL:
+		if v == nil {
+			return nil, fmt.Errorf("invalid uri: %v", v)
+		}
+		u = v
+	default:
+		return nil, fmt.Errorf("expected a string or url, got %T", input)
+	}
+
+	if u.Scheme == "" {
+		return nil, fmt.Errorf("invalid uri: %s", u)
+	}
+
+	return u, nil
+}
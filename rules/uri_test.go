@@ -0,0 +1,38 @@
+package rules
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestURI(t *testing.T) {
+	t.Run("Valid URL", func(t *testing.T) {
+		assert.NoError(t, URI("https://example.com"))
+	})
+
+	t.Run("Valid mailto URI", func(t *testing.T) {
+		assert.NoError(t, URI("mailto:user@example.com"))
+	})
+
+	t.Run("Valid URN", func(t *testing.T) {
+		assert.NoError(t, URI("urn:isbn:0451450523"))
+	})
+
+	t.Run("Valid url.URL value", func(t *testing.T) {
+		assert.NoError(t, URI(url.URL{Scheme: "urn", Opaque: "example"}))
+	})
+
+	t.Run("Relative path", func(t *testing.T) {
+		assert.EqualError(t, URI("/relative/path"), "invalid uri: /relative/path")
+	})
+
+	t.Run("Invalid escape", func(t *testing.T) {
+		assert.EqualError(t, URI("https://example.com/%zz"), "invalid uri: https://example.com/%zz")
+	})
+
+	t.Run("Invalid input type", func(t *testing.T) {
+		assert.EqualError(t, URI(true), "expected a string or url, got bool")
+	})
+}
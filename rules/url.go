@@ -0,0 +1,43 @@
+package rules
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// URL validates that the input is an absolute URL with a scheme and a host, such as "https://example.com/path".
+// The only scheme allowed without a host is "file", e.g. "file:///etc/hosts".
+// Use URI to also accept non-hierarchical identifiers like "mailto:" or "urn:" and HTTPURL to restrict the scheme.
+//
+// Parameters:
+// - input: The value being validated. It can be a string, a url.URL or a *url.URL.
+//
+// Returns nil if the input is a valid URL, or an error if:
+// - The input is not a string, url.URL or *url.URL
+// - The input cannot be parsed, has no scheme, or has no host.
+//
+// Example:
+//
+//	err := URL("https://example.com") // err will be nil
+//	err := URL("example.com")         // err will be: "invalid url: example.com"
+func URL(input any) error {
+	_, err := getURL(input)
+	return err
+}
+
+// getURL converts the input into a *url.URL with a scheme and a host.
+func getURL(input any) (*url.URL, error) {
+	u, err := getURI(input)
+	if err != nil {
+		if value, ok := input.(string); ok {
+			return nil, fmt.Errorf("invalid url: %s", value)
+		}
+		return nil, err
+	}
+
+	if u.Opaque != "" || (u.Host == "" && u.Scheme != "file") {
+		return nil, fmt.Errorf("invalid url: %s", u)
+	}
+
+	return u, nil
+}
@@ -0,0 +1,63 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/functions"
+	"sort"
+	"strings"
+)
+
+// URLScheme validates that the input is a URI whose scheme is one of the given schemes.
+// It is expected to be used in validation rules such as `urlscheme:https,wss`. Schemes are compared case-insensitively.
+//
+// Parameters:
+// - input: The value being validated. It can be a string, a url.URL or a *url.URL.
+// - obj: The object containing additional data (can be used for Field references within the args).
+// - args: A map of arguments, each of which evaluates to an allowed scheme.
+//
+// Returns nil if the input is valid, or an error if:
+// - No arguments are provided
+// - The input is not a valid URI
+// - The scheme of the input is not one of the allowed schemes.
+//
+// Example:
+//
+//	input := "wss://example.com/socket"
+//	obj := nil
+//	args := map[string]Arg{
+//	    "https": {Value: "https"},
+//	    "wss":   {Value: "wss"},
+//	}
+//	err := URLScheme(input, obj, args)  // err will be nil
+func URLScheme(input any, obj any, arguments map[string]args.Arg) error {
+	if len(arguments) == 0 {
+		return fmt.Errorf("urlscheme expects atleast 1 argument, got %d", len(arguments))
+	}
+
+	u, err := getURI(input)
+	if err != nil {
+		return err
+	}
+
+	schemes := make([]string, 0, len(arguments))
+	for _, v := range arguments {
+		eval, err := v.Evaluate(obj)
+		if err != nil {
+			return err
+		}
+
+		scheme, err := functions.GetString(eval)
+		if err != nil {
+			return fmt.Errorf("unsupported type for urlscheme argument: %w", err)
+		}
+
+		if strings.EqualFold(u.Scheme, scheme) {
+			return nil
+		}
+		schemes = append(schemes, scheme)
+	}
+
+	sort.Strings(schemes)
+	return fmt.Errorf("urlscheme validation failed: %s is not one of %s", u.Scheme, strings.Join(schemes, ", "))
+}
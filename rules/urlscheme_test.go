@@ -0,0 +1,36 @@
+package rules
+
+import (
+	"go-runtimevalidation/args"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestURLScheme(t *testing.T) {
+	secure := map[string]args.Arg{
+		"https": {Value: "https"},
+		"wss":   {Value: "wss"},
+	}
+
+	t.Run("Allowed scheme", func(t *testing.T) {
+		assert.NoError(t, URLScheme("wss://example.com/socket", nil, secure))
+	})
+
+	t.Run("Allowed scheme with different case", func(t *testing.T) {
+		assert.NoError(t, URLScheme("HTTPS://example.com", nil, secure))
+	})
+
+	t.Run("Disallowed scheme", func(t *testing.T) {
+		err := URLScheme("http://example.com", nil, secure)
+		assert.EqualError(t, err, "urlscheme validation failed: http is not one of https, wss")
+	})
+
+	t.Run("Invalid URI", func(t *testing.T) {
+		assert.EqualError(t, URLScheme("example.com", nil, secure), "invalid uri: example.com")
+	})
+
+	t.Run("No arguments", func(t *testing.T) {
+		assert.EqualError(t, URLScheme("https://example.com", nil, map[string]args.Arg{}), "urlscheme expects atleast 1 argument, got 0")
+	})
+}
@@ -1,33 +1,69 @@
 package rules
 
 import (
+	"errors"
 	"fmt"
-	"go-runtimevalidation/regex"
+	"go-runtimevalidation/uuid"
+	"reflect"
 )
 
-// UUID validates that the input is a valid UUID value.
-// The input must be a string matching the RFC 4122 standard for UUIDs.
+// UUID validates that the input is a valid UUID value of any version or variant, including the Nil and Max UUIDs.
+// The input must be a string in the canonical 8-4-4-4-12 hexadecimal form defined by RFC 9562,
+// or a [16]byte array such as uuid.UUID (which is always valid).
 //
 // Parameters:
-// - input: The value being validated, expected to be a string.
+// - input: The value being validated, expected to be a string or a [16]byte array.
 //
 // Returns nil if the input is a valid UUID, or an error if:
-// - The input is not a string
-// - The input does not match the UUID RFC 4122 format.
+// - The input is neither a string nor a [16]byte array
+// - The input does not match the canonical UUID format.
 //
 // Example:
 //
 //	err := UUID("123e4567-e89b-12d3-a456-426614174000") // err will be nil for a valid UUID
 func UUID(input any) error {
-	// Check if the input is a string
-	value, ok := input.(string)
-	if !ok {
-		return fmt.Errorf("expected a string, got %T", input)
+	_, value, err := getUUID(input)
+	if errors.Is(err, errInvalidUUID) {
+		return fmt.Errorf("invalid UUID value: %s", value)
 	}
 
-	// Check if the string is a valid UUID value
-	if !regex.UUIDRFC4122Regex().MatchString(value) {
-		return fmt.Errorf("invalid UUID value: %s", value)
+	return err
+}
+
+// errInvalidUUID is returned by getUUID for strings that are not in the canonical UUID form.
+var errInvalidUUID = errors.New("invalid UUID")
+
+// getUUID returns the input as a UUID, along with its text for error messages.
+// Strings must be in the canonical form. Arrays of 16 bytes, including named types such as uuid.UUID
+// or the UUID types of other packages, are used as they are.
+func getUUID(input any) (uuid.UUID, string, error) {
+	if value, ok := input.(string); ok {
+		u, err := uuid.Parse(value)
+		if err != nil {
+			return u, value, errInvalidUUID
+		}
+		return u, value, nil
+	}
+
+	v := reflect.ValueOf(input)
+	if v.Kind() == reflect.Array && v.Len() == 16 && v.Type().Elem().Kind() == reflect.Uint8 {
+		var u uuid.UUID
+		reflect.Copy(reflect.ValueOf(&u).Elem(), v)
+		return u, u.String(), nil
+	}
+
+	return uuid.UUID{}, "", fmt.Errorf("expected a string, got %T", input)
+}
+
+// uuidVersion validates that the input is a UUID of the RFC 9562 variant with the given version.
+func uuidVersion(input any, version int) error {
+	u, value, err := getUUID(input)
+	if err != nil && !errors.Is(err, errInvalidUUID) {
+		return err
+	}
+
+	if err != nil || u.Variant() != uuid.VariantRFC9562 || u.Version() != version {
+		return fmt.Errorf("invalid UUID%d value: %s", version, value)
 	}
 
 	return nil
@@ -0,0 +1,19 @@
+package rules
+
+// UUID1 validates whether the input is a valid UUID1 string.
+// A UUID1 is a time-based UUID with a Gregorian timestamp and a node ID, in the layout of the RFC 9562 (formerly RFC 4122) variant.
+//
+// Parameters:
+// - input: The value being validated, expected to be a string or a [16]byte array.
+//
+// Returns an error if:
+// - The input is neither a string nor a [16]byte array
+// - The input is not a canonical UUID, or its version or variant is wrong.
+//
+// Example:
+//
+//	input := "c232ab00-9414-11ec-b3c8-9f6bdeced846"
+//	err := UUID1(input)  // err will be nil
+func UUID1(input any) error {
+	return uuidVersion(input, 1)
+}
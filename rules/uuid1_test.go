@@ -0,0 +1,37 @@
+package rules
+
+import (
+	"go-runtimevalidation/uuid"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUUID1(t *testing.T) {
+	t.Run("Valid UUID1", func(t *testing.T) {
+		err := UUID1("c232ab00-9414-11ec-b3c8-9f6bdeced846")
+		assert.NoError(t, err)
+	})
+
+	t.Run("Valid UUID1 as an array", func(t *testing.T) {
+		u, err := uuid.Parse("c232ab00-9414-11ec-b3c8-9f6bdeced846")
+		assert.NoError(t, err)
+		assert.NoError(t, UUID1(u))
+		assert.NoError(t, UUID1([16]byte(u)))
+	})
+
+	t.Run("Invalid UUID1 - wrong version", func(t *testing.T) {
+		err := UUID1("c232ab00-9414-61ec-b3c8-9f6bdeced846")
+		assert.EqualError(t, err, "invalid UUID1 value: c232ab00-9414-61ec-b3c8-9f6bdeced846")
+	})
+
+	t.Run("Invalid UUID1 - wrong variant", func(t *testing.T) {
+		err := UUID1("c232ab00-9414-11ec-c3c8-9f6bdeced846")
+		assert.EqualError(t, err, "invalid UUID1 value: c232ab00-9414-11ec-c3c8-9f6bdeced846")
+	})
+
+	t.Run("Invalid UUID1 - wrong type", func(t *testing.T) {
+		err := UUID1([]byte("c232ab00-9414-11ec-b3c8-9f6bdeced846"))
+		assert.EqualError(t, err, "expected a string, got []uint8")
+	})
+}
@@ -0,0 +1,19 @@
+package rules
+
+// UUID6 validates whether the input is a valid UUID6 string.
+// A UUID6 is the field-compatible, time-ordered version of UUID1 defined by RFC 9562: its timestamp is stored most significant bits first.
+//
+// Parameters:
+// - input: The value being validated, expected to be a string or a [16]byte array.
+//
+// Returns an error if:
+// - The input is neither a string nor a [16]byte array
+// - The input is not a canonical UUID, or its version or variant is wrong.
+//
+// Example:
+//
+//	input := "1ec9414c-232a-6b00-b3c8-9f6bdeced846"
+//	err := UUID6(input)  // err will be nil
+func UUID6(input any) error {
+	return uuidVersion(input, 6)
+}
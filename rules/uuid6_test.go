@@ -0,0 +1,37 @@
+package rules
+
+import (
+	"go-runtimevalidation/uuid"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUUID6(t *testing.T) {
+	t.Run("Valid UUID6", func(t *testing.T) {
+		err := UUID6("1ec9414c-232a-6b00-b3c8-9f6bdeced846")
+		assert.NoError(t, err)
+	})
+
+	t.Run("Valid UUID6 as an array", func(t *testing.T) {
+		u, err := uuid.Parse("1ec9414c-232a-6b00-b3c8-9f6bdeced846")
+		assert.NoError(t, err)
+		assert.NoError(t, UUID6(u))
+		assert.NoError(t, UUID6([16]byte(u)))
+	})
+
+	t.Run("Invalid UUID6 - wrong version", func(t *testing.T) {
+		err := UUID6("1ec9414c-232a-1b00-b3c8-9f6bdeced846")
+		assert.EqualError(t, err, "invalid UUID6 value: 1ec9414c-232a-1b00-b3c8-9f6bdeced846")
+	})
+
+	t.Run("Invalid UUID6 - wrong variant", func(t *testing.T) {
+		err := UUID6("1ec9414c-232a-6b00-73c8-9f6bdeced846")
+		assert.EqualError(t, err, "invalid UUID6 value: 1ec9414c-232a-6b00-73c8-9f6bdeced846")
+	})
+
+	t.Run("Invalid UUID6 - wrong type", func(t *testing.T) {
+		err := UUID6([]byte("1ec9414c-232a-6b00-b3c8-9f6bdeced846"))
+		assert.EqualError(t, err, "expected a string, got []uint8")
+	})
+}
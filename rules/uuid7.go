@@ -0,0 +1,19 @@
+package rules
+
+// UUID7 validates whether the input is a valid UUID7 string.
+// A UUID7 is a time-ordered UUID defined by RFC 9562, starting with a Unix timestamp in milliseconds followed by random bits.
+//
+// Parameters:
+// - input: The value being validated, expected to be a string or a [16]byte array.
+//
+// Returns an error if:
+// - The input is neither a string nor a [16]byte array
+// - The input is not a canonical UUID, or its version or variant is wrong.
+//
+// Example:
+//
+//	input := "017f22e2-79b0-7cc3-98c4-dc0c0c07398f"
+//	err := UUID7(input)  // err will be nil
+func UUID7(input any) error {
+	return uuidVersion(input, 7)
+}
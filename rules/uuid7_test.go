@@ -0,0 +1,37 @@
+package rules
+
+import (
+	"go-runtimevalidation/uuid"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUUID7(t *testing.T) {
+	t.Run("Valid UUID7", func(t *testing.T) {
+		err := UUID7("017f22e2-79b0-7cc3-98c4-dc0c0c07398f")
+		assert.NoError(t, err)
+	})
+
+	t.Run("Valid UUID7 as an array", func(t *testing.T) {
+		u, err := uuid.Parse("017f22e2-79b0-7cc3-98c4-dc0c0c07398f")
+		assert.NoError(t, err)
+		assert.NoError(t, UUID7(u))
+		assert.NoError(t, UUID7([16]byte(u)))
+	})
+
+	t.Run("Invalid UUID7 - wrong version", func(t *testing.T) {
+		err := UUID7("017f22e2-79b0-4cc3-98c4-dc0c0c07398f")
+		assert.EqualError(t, err, "invalid UUID7 value: 017f22e2-79b0-4cc3-98c4-dc0c0c07398f")
+	})
+
+	t.Run("Invalid UUID7 - wrong variant", func(t *testing.T) {
+		err := UUID7("017f22e2-79b0-7cc3-e8c4-dc0c0c07398f")
+		assert.EqualError(t, err, "invalid UUID7 value: 017f22e2-79b0-7cc3-e8c4-dc0c0c07398f")
+	})
+
+	t.Run("Invalid UUID7 - wrong type", func(t *testing.T) {
+		err := UUID7([]byte("017f22e2-79b0-7cc3-98c4-dc0c0c07398f"))
+		assert.EqualError(t, err, "expected a string, got []uint8")
+	})
+}
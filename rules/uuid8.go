@@ -0,0 +1,19 @@
+package rules
+
+// UUID8 validates whether the input is a valid UUID8 string.
+// A UUID8 has a custom, vendor-specific layout defined by RFC 9562: only its version and variant bits are checked.
+//
+// Parameters:
+// - input: The value being validated, expected to be a string or a [16]byte array.
+//
+// Returns an error if:
+// - The input is neither a string nor a [16]byte array
+// - The input is not a canonical UUID, or its version or variant is wrong.
+//
+// Example:
+//
+//	input := "2489e9ad-2ee2-8e00-8ec9-32d5f69181c0"
+//	err := UUID8(input)  // err will be nil
+func UUID8(input any) error {
+	return uuidVersion(input, 8)
+}
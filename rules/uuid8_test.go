@@ -0,0 +1,37 @@
+package rules
+
+import (
+	"go-runtimevalidation/uuid"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUUID8(t *testing.T) {
+	t.Run("Valid UUID8", func(t *testing.T) {
+		err := UUID8("2489e9ad-2ee2-8e00-8ec9-32d5f69181c0")
+		assert.NoError(t, err)
+	})
+
+	t.Run("Valid UUID8 as an array", func(t *testing.T) {
+		u, err := uuid.Parse("2489e9ad-2ee2-8e00-8ec9-32d5f69181c0")
+		assert.NoError(t, err)
+		assert.NoError(t, UUID8(u))
+		assert.NoError(t, UUID8([16]byte(u)))
+	})
+
+	t.Run("Invalid UUID8 - wrong version", func(t *testing.T) {
+		err := UUID8("2489e9ad-2ee2-7e00-8ec9-32d5f69181c0")
+		assert.EqualError(t, err, "invalid UUID8 value: 2489e9ad-2ee2-7e00-8ec9-32d5f69181c0")
+	})
+
+	t.Run("Invalid UUID8 - wrong variant", func(t *testing.T) {
+		err := UUID8("2489e9ad-2ee2-8e00-0ec9-32d5f69181c0")
+		assert.EqualError(t, err, "invalid UUID8 value: 2489e9ad-2ee2-8e00-0ec9-32d5f69181c0")
+	})
+
+	t.Run("Invalid UUID8 - wrong type", func(t *testing.T) {
+		err := UUID8([]byte("2489e9ad-2ee2-8e00-8ec9-32d5f69181c0"))
+		assert.EqualError(t, err, "expected a string, got []uint8")
+	})
+}
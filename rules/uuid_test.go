@@ -27,6 +27,19 @@ func TestUUID(t *testing.T) {
 		assert.EqualError(t, err, "invalid UUID value: 123e4567-e89b-12d3-a456-42661417g000")
 	})
 
+	// Test UUID given as a byte array
+	t.Run("Valid UUID as an array", func(t *testing.T) {
+		type googleUUID [16]byte
+		err := UUID(googleUUID{0x12, 0x3e, 0x45, 0x67})
+		assert.NoError(t, err)
+	})
+
+	// Test Nil and Max UUIDs, which have no version
+	t.Run("Valid Nil and Max UUIDs", func(t *testing.T) {
+		assert.NoError(t, UUID("00000000-0000-0000-0000-000000000000"))
+		assert.NoError(t, UUID("ffffffff-ffff-ffff-ffff-ffffffffffff"))
+	})
+
 	// Test UUID input is not a string
 	t.Run("Input is not a string", func(t *testing.T) {
 		err := UUID(1234567890)
@@ -0,0 +1,33 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"go-runtimevalidation/uuid"
+)
+
+// UUIDMax validates that the input is the Max UUID, ffffffff-ffff-ffff-ffff-ffffffffffff, with all 128 bits set to one.
+// RFC 9562 reserves it as a sentinel, e.g. to mark the end of a list of UUIDs.
+//
+// Parameters:
+// - input: The value being validated, expected to be a string or a [16]byte array.
+//
+// Returns an error if:
+// - The input is neither a string nor a [16]byte array
+// - The input is not the Max UUID.
+//
+// Example:
+//
+//	err := UUIDMax("ffffffff-ffff-ffff-ffff-ffffffffffff")  // err will be nil
+func UUIDMax(input any) error {
+	u, value, err := getUUID(input)
+	if err != nil && !errors.Is(err, errInvalidUUID) {
+		return err
+	}
+
+	if err != nil || u != uuid.Max {
+		return fmt.Errorf("invalid max UUID value: %s", value)
+	}
+
+	return nil
+}
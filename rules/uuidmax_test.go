@@ -0,0 +1,24 @@
+package rules
+
+import (
+	"go-runtimevalidation/uuid"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUUIDMax(t *testing.T) {
+	t.Run("Max UUID", func(t *testing.T) {
+		assert.NoError(t, UUIDMax("FFFFFFFF-FFFF-FFFF-FFFF-FFFFFFFFFFFF"))
+		assert.NoError(t, UUIDMax(uuid.Max))
+	})
+
+	t.Run("Other UUID", func(t *testing.T) {
+		err := UUIDMax(uuid.Nil)
+		assert.EqualError(t, err, "invalid max UUID value: 00000000-0000-0000-0000-000000000000")
+	})
+
+	t.Run("Wrong type", func(t *testing.T) {
+		assert.EqualError(t, UUIDMax(1), "expected a string, got int")
+	})
+}
@@ -0,0 +1,33 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"go-runtimevalidation/uuid"
+)
+
+// UUIDNil validates that the input is the Nil UUID, 00000000-0000-0000-0000-000000000000, with all 128 bits set to zero.
+// It is mostly useful negated or in alternatives, e.g. `uuid7||uuidnil` for an optional reference.
+//
+// Parameters:
+// - input: The value being validated, expected to be a string or a [16]byte array.
+//
+// Returns an error if:
+// - The input is neither a string nor a [16]byte array
+// - The input is not the Nil UUID.
+//
+// Example:
+//
+//	err := UUIDNil("00000000-0000-0000-0000-000000000000")  // err will be nil
+func UUIDNil(input any) error {
+	u, value, err := getUUID(input)
+	if err != nil && !errors.Is(err, errInvalidUUID) {
+		return err
+	}
+
+	if err != nil || u != uuid.Nil {
+		return fmt.Errorf("invalid nil UUID value: %s", value)
+	}
+
+	return nil
+}
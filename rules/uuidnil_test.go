@@ -0,0 +1,28 @@
+package rules
+
+import (
+	"go-runtimevalidation/uuid"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUUIDNil(t *testing.T) {
+	t.Run("Nil UUID", func(t *testing.T) {
+		assert.NoError(t, UUIDNil("00000000-0000-0000-0000-000000000000"))
+		assert.NoError(t, UUIDNil(uuid.Nil))
+	})
+
+	t.Run("Other UUID", func(t *testing.T) {
+		err := UUIDNil("00000000-0000-0000-0000-000000000001")
+		assert.EqualError(t, err, "invalid nil UUID value: 00000000-0000-0000-0000-000000000001")
+
+		err = UUIDNil(uuid.Max)
+		assert.EqualError(t, err, "invalid nil UUID value: ffffffff-ffff-ffff-ffff-ffffffffffff")
+	})
+
+	t.Run("Invalid UUID", func(t *testing.T) {
+		err := UUIDNil("0")
+		assert.EqualError(t, err, "invalid nil UUID value: 0")
+	})
+}
@@ -0,0 +1,136 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/functions"
+	"time"
+)
+
+// UUIDTime validates that the input is a version 1, 6 or 7 UUID whose embedded timestamp lies within bounds.
+// It is expected to be used in validation rules such as `uuidtime:past,maxage=720h` or `uuidtime:after=$CreatedAt,leeway=5s`.
+//
+// Arguments (in any order):
+// - past: the timestamp must not be in the future
+// - maxage=D: the timestamp must not be older than D, e.g. 720h for 30 days
+// - after=T, before=T: the timestamp must be strictly after or before T, a date/time literal such as 2024-01-01 or a field reference such as $CreatedAt
+// - leeway=D: tolerance for clock skew applied to every bound, e.g. 5s
+//
+// The current time comes from the validation clock (see functions.Now). The timestamps of versions 1 and 6 have
+// a precision of 100 nanoseconds, those of version 7 of one millisecond.
+//
+// Parameters:
+// - input: The value being validated, expected to be a string or a [16]byte array.
+// - obj: The object containing additional data (can be used for Field references within the args).
+// - args: A map of bound arguments. At least one is required.
+//
+// Returns nil if the input is valid, or an error if:
+// - An argument is not a known option or has an invalid value
+// - The input is not a valid UUID or has no timestamp
+// - The timestamp is out of bounds.
+//
+// Example:
+//
+//	input := "017f22e2-79b0-7cc3-98c4-dc0c0c07398f"
+//	obj := nil
+//	args := map[string]Arg{
+//	    "after=2024-01-01": {Value: "after=2024-01-01"},
+//	}
+//	err := UUIDTime(input, obj, args)  // err will be: "uuidtime validation failed: timestamp 2022-02-22T19:22:22Z is not after 2024-01-01T00:00:00Z"
+func UUIDTime(input any, obj any, arguments map[string]args.Arg) error {
+	policy, err := parseIDTimePolicy("uuidtime", obj, arguments)
+	if err != nil {
+		return err
+	}
+
+	u, value, err := getUUID(input)
+	if errors.Is(err, errInvalidUUID) {
+		return fmt.Errorf("invalid UUID value: %s", value)
+	} else if err != nil {
+		return err
+	}
+
+	timestamp, ok := u.Time()
+	if !ok {
+		return fmt.Errorf("uuidtime validation failed: %s has no timestamp, expected a version 1, 6 or 7 UUID", value)
+	}
+
+	return policy.check("uuidtime", timestamp)
+}
+
+// idTimePolicy bounds the timestamp embedded in a UUID or ULID.
+type idTimePolicy struct {
+	past          bool
+	maxAge        time.Duration
+	after, before time.Time
+	leeway        time.Duration
+}
+
+// parseIDTimePolicy builds the bounds from the arguments of the uuidtime and ulidtime rules.
+func parseIDTimePolicy(rule string, obj any, arguments map[string]args.Arg) (idTimePolicy, error) {
+	policy := idTimePolicy{}
+	if len(arguments) == 0 {
+		return policy, fmt.Errorf("%s expects at least one argument", rule)
+	}
+
+	options := args.Options{Rule: rule, Values: []string{"maxage", "leeway", "after", "before"}, Flags: []string{"past"}}
+	for key, arg := range arguments {
+		option, err := options.Parse(key, arg)
+		if err != nil {
+			return policy, err
+		}
+
+		switch option.Name {
+		case "past":
+			policy.past = true
+		case "maxage", "leeway":
+			d, err := time.ParseDuration(option.Text)
+			if err != nil || d < 0 {
+				return policy, fmt.Errorf("%s: invalid value for %s: %s", rule, option.Name, option.Text)
+			}
+			if option.Name == "maxage" {
+				policy.maxAge = d
+			} else {
+				policy.leeway = d
+			}
+		case "after", "before":
+			eval, err := option.Evaluate(obj)
+			if err != nil {
+				return policy, err
+			}
+			bound, err := functions.GetTime(eval)
+			if err != nil {
+				return policy, fmt.Errorf("%s: invalid value for %s: %w", rule, option.Name, err)
+			}
+			if option.Name == "after" {
+				policy.after = bound
+			} else {
+				policy.before = bound
+			}
+		}
+	}
+
+	return policy, nil
+}
+
+// check returns an error naming the first bound the timestamp does not satisfy.
+func (p idTimePolicy) check(rule string, timestamp time.Time) error {
+	now := functions.Now()
+	stamp := timestamp.Format(time.RFC3339Nano)
+
+	if p.past && timestamp.After(now.Add(p.leeway)) {
+		return fmt.Errorf("%s validation failed: timestamp %s is in the future", rule, stamp)
+	}
+	if p.maxAge > 0 && timestamp.Before(now.Add(-p.maxAge-p.leeway)) {
+		return fmt.Errorf("%s validation failed: timestamp %s is older than %s", rule, stamp, p.maxAge)
+	}
+	if !p.after.IsZero() && !timestamp.After(p.after.Add(-p.leeway)) {
+		return fmt.Errorf("%s validation failed: timestamp %s is not after %s", rule, stamp, p.after.Format(time.RFC3339Nano))
+	}
+	if !p.before.IsZero() && !timestamp.Before(p.before.Add(p.leeway)) {
+		return fmt.Errorf("%s validation failed: timestamp %s is not before %s", rule, stamp, p.before.Format(time.RFC3339Nano))
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package rules
+
+import (
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/functions"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUUIDTime(t *testing.T) {
+	// The example UUIDs of RFC 9562 were generated at 2022-02-22T19:22:22Z
+	restore := functions.SetClock(func() time.Time { return time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC) })
+	defer restore()
+
+	options := func(values ...string) map[string]args.Arg {
+		arguments := map[string]args.Arg{}
+		for _, v := range values {
+			arguments[v] = args.Arg{Value: v}
+		}
+		return arguments
+	}
+
+	v1 := "c232ab00-9414-11ec-b3c8-9f6bdeced846"
+	v6 := "1ec9414c-232a-6b00-b3c8-9f6bdeced846"
+	v7 := "017f22e2-79b0-7cc3-98c4-dc0c0c07398f"
+
+	t.Run("Within bounds", func(t *testing.T) {
+		for _, u := range []string{v1, v6, v7} {
+			assert.NoError(t, UUIDTime(u, nil, options("past", "maxage=720h")), u)
+			assert.NoError(t, UUIDTime(u, nil, options("after=2022-01-01", "before=2022-03-01")), u)
+		}
+	})
+
+	t.Run("In the future", func(t *testing.T) {
+		restore := functions.SetClock(func() time.Time { return time.Date(2022, 2, 22, 19, 22, 21, 0, time.UTC) })
+		defer restore()
+
+		err := UUIDTime(v7, nil, options("past"))
+		assert.EqualError(t, err, "uuidtime validation failed: timestamp 2022-02-22T19:22:22Z is in the future")
+
+		assert.NoError(t, UUIDTime(v7, nil, options("past", "leeway=1s")))
+	})
+
+	t.Run("Too old", func(t *testing.T) {
+		err := UUIDTime(v1, nil, options("maxage=120h"))
+		assert.EqualError(t, err, "uuidtime validation failed: timestamp 2022-02-22T19:22:22Z is older than 120h0m0s")
+	})
+
+	t.Run("Bounds from fields", func(t *testing.T) {
+		obj := struct{ CreatedAt time.Time }{CreatedAt: time.Date(2022, 2, 23, 0, 0, 0, 0, time.UTC)}
+
+		err := UUIDTime(v6, obj, options("after=$CreatedAt"))
+		assert.EqualError(t, err, "uuidtime validation failed: timestamp 2022-02-22T19:22:22Z is not after 2022-02-23T00:00:00Z")
+
+		assert.NoError(t, UUIDTime(v6, obj, options("before=$CreatedAt")))
+	})
+
+	t.Run("No timestamp", func(t *testing.T) {
+		err := UUIDTime("919108f7-52d1-4320-9bac-f847db4148a8", nil, options("past"))
+		assert.EqualError(t, err, "uuidtime validation failed: 919108f7-52d1-4320-9bac-f847db4148a8 has no timestamp, expected a version 1, 6 or 7 UUID")
+	})
+
+	t.Run("Invalid UUID", func(t *testing.T) {
+		err := UUIDTime("017f22e2", nil, options("past"))
+		assert.EqualError(t, err, "invalid UUID value: 017f22e2")
+	})
+
+	t.Run("Invalid arguments", func(t *testing.T) {
+		assert.EqualError(t, UUIDTime(v7, nil, nil), "uuidtime expects at least one argument")
+		assert.EqualError(t, UUIDTime(v7, nil, options("maxage=30d")), "uuidtime: invalid value for maxage: 30d")
+		assert.EqualError(t, UUIDTime(v7, nil, options("recent")), "uuidtime: unknown option recent")
+
+		err := UUIDTime(v7, nil, options("after=yesterday"))
+		assert.EqualError(t, err, `uuidtime: invalid value for after: failed to parse "yesterday" of type string as time`)
+	})
+}
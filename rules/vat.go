@@ -0,0 +1,303 @@
+package rules
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// vatPrefixes lists the VAT prefixes that differ from the ISO 3166-1 country code.
+var vatPrefixes = map[string]string{
+	"GR": "EL",
+}
+
+// vatChecks validates EU VAT numbers without their country prefix, by ISO 3166-1 alpha-2 country code.
+var vatChecks = map[string]nationalIDCheck{
+	"AT": checkVATAT,
+	"BE": checkVATBE,
+	"DE": checkVATDE,
+	"DK": checkVATDK,
+	"EE": checkVATEE,
+	"ES": checkNIF,
+	"FI": checkVATFI,
+	"FR": checkVATFR,
+	"GR": checkVATGR,
+	"HR": checkVATHR,
+	"IE": checkVATIE,
+	"IT": checkVATIT,
+	"LU": checkVATLU,
+	"NL": checkVATNL,
+	"PL": checkVATPL,
+	"PT": checkVATPT,
+	"SE": checkVATSE,
+	"SI": checkVATSI,
+}
+
+// vatPrefix returns the VAT prefix of a country.
+func vatPrefix(country string) string {
+	if p, ok := vatPrefixes[country]; ok {
+		return p
+	}
+	return country
+}
+
+// vatCheck wraps the check of a VAT number so that the country prefix is optional.
+// A VAT number with the prefix of another supported country is rejected, since French
+// numbers may otherwise start with any two letters.
+func vatCheck(country string, check nationalIDCheck) nationalIDCheck {
+	prefix := vatPrefix(country)
+	return func(id string) error {
+		if strings.HasPrefix(id, prefix) {
+			return check(id[len(prefix):])
+		}
+		for other := range vatChecks {
+			if other != country && strings.HasPrefix(id, vatPrefix(other)) {
+				return fmt.Errorf("country prefix %s does not match %s", vatPrefix(other), prefix)
+			}
+		}
+		return check(id)
+	}
+}
+
+// mod1110 reports whether a digit string ends with a valid ISO 7064 MOD 11,10 check digit.
+func mod1110(digits string) bool {
+	p := 10
+	for i := 0; i < len(digits)-1; i++ {
+		s := (int(digits[i]-'0') + p) % 10
+		if s == 0 {
+			s = 10
+		}
+		p = 2 * s % 11
+	}
+	return (11-p)%10 == int(digits[len(digits)-1]-'0')
+}
+
+// checkVATAT validates an Austrian UID: U and 8 digits.
+func checkVATAT(id string) error {
+	if len(id) != 9 || id[0] != 'U' || !isDigits(id[1:]) {
+		return errIDFormat
+	}
+	sum := 0
+	for i := 1; i <= 7; i++ {
+		d := int(id[i] - '0')
+		if i%2 == 0 {
+			d *= 2
+			d = d/10 + d%10
+		}
+		sum += d
+	}
+	if (10-(sum+4)%10)%10 != int(id[8]-'0') {
+		return errIDCheck
+	}
+	return nil
+}
+
+// checkVATBE validates a Belgian enterprise number: 10 digits starting with 0 or 1,
+// where the last two are 97 minus the first eight modulo 97. Old 9 digit numbers are prefixed with 0.
+func checkVATBE(id string) error {
+	if len(id) == 9 {
+		id = "0" + id
+	}
+	if len(id) != 10 || !isDigits(id) || id[0] > '1' {
+		return errIDFormat
+	}
+	n, _ := strconv.Atoi(id[:8])
+	check, _ := strconv.Atoi(id[8:])
+	if 97-n%97 != check {
+		return errIDCheck
+	}
+	return nil
+}
+
+// checkVATDE validates a German USt-IdNr: 9 digits with an ISO 7064 MOD 11,10 check digit.
+func checkVATDE(id string) error {
+	if len(id) != 9 || !isDigits(id) || id[0] == '0' {
+		return errIDFormat
+	}
+	if !mod1110(id) {
+		return errIDCheck
+	}
+	return nil
+}
+
+// checkVATDK validates a Danish CVR number: 8 digits whose weighted sum is divisible by 11.
+func checkVATDK(id string) error {
+	if len(id) != 8 || !isDigits(id) || id[0] == '0' {
+		return errIDFormat
+	}
+	if weightedSum(id, 2, 7, 6, 5, 4, 3, 2, 1)%11 != 0 {
+		return errIDCheck
+	}
+	return nil
+}
+
+// checkVATEE validates an Estonian KMKR number: 9 digits starting with 10.
+func checkVATEE(id string) error {
+	if len(id) != 9 || !isDigits(id) || !strings.HasPrefix(id, "10") {
+		return errIDFormat
+	}
+	if (10-weightedSum(id, 3, 7, 1, 3, 7, 1, 3, 7)%10)%10 != int(id[8]-'0') {
+		return errIDCheck
+	}
+	return nil
+}
+
+// checkVATFI validates a Finnish ALV number: 8 digits with a mod-11 check digit.
+func checkVATFI(id string) error {
+	if len(id) != 8 || !isDigits(id) {
+		return errIDFormat
+	}
+	r := weightedSum(id, 7, 9, 10, 5, 8, 4, 2) % 11
+	if r == 1 || (11-r)%11 != int(id[7]-'0') {
+		return errIDCheck
+	}
+	return nil
+}
+
+// checkVATFR validates a French TVA number: a two character key and the 9 digit SIREN.
+// Numeric keys are checked against the SIREN; alphanumeric keys are only checked for format.
+func checkVATFR(id string) error {
+	if len(id) != 11 || !isDigits(id[2:]) || strings.Trim(id[:2], "0123456789ABCDEFGHJKLMNPQRSTUVWXYZ") != "" {
+		return errIDFormat
+	}
+	if !isDigits(id[:2]) {
+		return nil
+	}
+	key, _ := strconv.Atoi(id[:2])
+	siren, _ := strconv.Atoi(id[2:])
+	if (12+3*(siren%97))%97 != key {
+		return errIDCheck
+	}
+	return nil
+}
+
+// checkVATGR validates a Greek AFM: 9 digits with a powers-of-two mod-11 check digit.
+func checkVATGR(id string) error {
+	if len(id) != 9 || !isDigits(id) {
+		return errIDFormat
+	}
+	if weightedSum(id, 256, 128, 64, 32, 16, 8, 4, 2)%11%10 != int(id[8]-'0') {
+		return errIDCheck
+	}
+	return nil
+}
+
+// checkVATHR validates a Croatian OIB: 11 digits with an ISO 7064 MOD 11,10 check digit.
+func checkVATHR(id string) error {
+	if len(id) != 11 || !isDigits(id) {
+		return errIDFormat
+	}
+	if !mod1110(id) {
+		return errIDCheck
+	}
+	return nil
+}
+
+// checkVATIE validates an Irish VAT number in the current format: 7 digits, a check letter and an optional second letter.
+func checkVATIE(id string) error {
+	if len(id) < 8 || len(id) > 9 || !isDigits(id[:7]) {
+		return errIDFormat
+	}
+	sum := weightedSum(id, 8, 7, 6, 5, 4, 3, 2)
+	if len(id) == 9 {
+		if id[8] != 'W' && (id[8] < 'A' || id[8] > 'I') {
+			return errIDFormat
+		}
+		if id[8] != 'W' {
+			sum += 9 * int(id[8]-'A'+1)
+		}
+	}
+	if "WABCDEFGHIJKLMNOPQRSTUV"[sum%23] != id[7] {
+		return errIDCheck
+	}
+	return nil
+}
+
+// checkVATIT validates an Italian partita IVA: 11 digits with a Luhn check digit.
+func checkVATIT(id string) error {
+	if len(id) != 11 || !isDigits(id) || id[:7] == "0000000" {
+		return errIDFormat
+	}
+	if !luhn(id) {
+		return errIDCheck
+	}
+	return nil
+}
+
+// checkVATLU validates a Luxembourg TVA number: 8 digits where the last two are the first six modulo 89.
+func checkVATLU(id string) error {
+	if len(id) != 8 || !isDigits(id) {
+		return errIDFormat
+	}
+	n, _ := strconv.Atoi(id[:6])
+	check, _ := strconv.Atoi(id[6:])
+	if n%89 != check {
+		return errIDCheck
+	}
+	return nil
+}
+
+// checkVATNL validates a Dutch btw-id: 9 digits, B and 2 digits. Numbers issued before 2020 carry
+// an RSIN mod-11 check digit; newer ones are checked with mod-97 over the whole number including "NL".
+func checkVATNL(id string) error {
+	if len(id) != 12 || !isDigits(id[:9]) || id[9] != 'B' || !isDigits(id[10:]) {
+		return errIDFormat
+	}
+	if weightedSum(id, 9, 8, 7, 6, 5, 4, 3, 2, -1)%11 == 0 || mod97("NL"+id) == 1 {
+		return nil
+	}
+	return errIDCheck
+}
+
+// checkVATPL validates a Polish NIP: 10 digits with a mod-11 check digit.
+func checkVATPL(id string) error {
+	if len(id) != 10 || !isDigits(id) {
+		return errIDFormat
+	}
+	check := weightedSum(id, 6, 5, 7, 2, 3, 4, 5, 6, 7) % 11
+	if check == 10 || check != int(id[9]-'0') {
+		return errIDCheck
+	}
+	return nil
+}
+
+// checkVATPT validates a Portuguese NIF: 9 digits with a mod-11 check digit.
+func checkVATPT(id string) error {
+	if len(id) != 9 || !isDigits(id) || id[0] == '0' {
+		return errIDFormat
+	}
+	check := 11 - weightedSum(id, 9, 8, 7, 6, 5, 4, 3, 2)%11
+	if check >= 10 {
+		check = 0
+	}
+	if check != int(id[8]-'0') {
+		return errIDCheck
+	}
+	return nil
+}
+
+// checkVATSE validates a Swedish momsregistreringsnummer: a 10 digit organisation number with a Luhn check digit, followed by 01.
+func checkVATSE(id string) error {
+	if len(id) != 12 || !isDigits(id) || !strings.HasSuffix(id, "01") {
+		return errIDFormat
+	}
+	if !luhn(id[:10]) {
+		return errIDCheck
+	}
+	return nil
+}
+
+// checkVATSI validates a Slovenian ID za DDV: 8 digits with a mod-11 check digit.
+func checkVATSI(id string) error {
+	if len(id) != 8 || !isDigits(id) || id[0] == '0' {
+		return errIDFormat
+	}
+	check := 11 - weightedSum(id, 8, 7, 6, 5, 4, 3, 2)%11
+	if check == 11 {
+		return errIDCheck
+	}
+	if check%10 != int(id[7]-'0') {
+		return errIDCheck
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/functions"
+	"strings"
+	"time"
+)
+
+// Weekday validates that the input falls on one of the given days of the week.
+// It is expected to be used in validation rules such as `weekday` (Monday to Friday) or `weekday:sat,sun`.
+//
+// Days are given as English names or their three letter abbreviations (case-insensitive),
+// or as numbers from 0 (Sunday) to 6 (Saturday). Without arguments, Monday to Friday are allowed.
+// The day is taken in the input's own location.
+//
+// Parameters:
+// - input: The value being validated. It can be a time.Time, *time.Time, Unix seconds, or a date/time string.
+// - obj: The object containing additional data (can be used for Field references within the args).
+// - args: A map of arguments, each naming an allowed day. May be empty.
+//
+// Returns nil if the input is valid, or an error if:
+// - The input cannot be converted to a time
+// - Any argument is not a valid day
+// - The input does not fall on an allowed day.
+//
+// Example:
+//
+//	input := "2024-06-01" // a Saturday
+//	obj := nil
+//	err := Weekday(input, obj, nil)  // err will be: "weekday validation failed: Saturday is not one of Monday, Tuesday, Wednesday, Thursday, Friday"
+func Weekday(input any, obj any, arguments map[string]args.Arg) error {
+	value, err := functions.GetTime(input)
+	if err != nil {
+		return fmt.Errorf("unsupported type for input field: %w", err)
+	}
+
+	var allowed [7]bool
+	if len(arguments) == 0 {
+		for day := time.Monday; day <= time.Friday; day++ {
+			allowed[day] = true
+		}
+	}
+
+	for _, v := range arguments {
+		eval, err := v.Evaluate(obj)
+		if err != nil {
+			return err
+		}
+
+		day, err := parseWeekday(eval)
+		if err != nil {
+			return err
+		}
+		allowed[day] = true
+	}
+
+	if allowed[value.Weekday()] {
+		return nil
+	}
+
+	names := make([]string, 0, 7)
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if allowed[day] {
+			names = append(names, day.String())
+		}
+	}
+
+	return fmt.Errorf("weekday validation failed: %s is not one of %s", value.Weekday(), strings.Join(names, ", "))
+}
+
+// parseWeekday converts a day name, abbreviation or number into a time.Weekday.
+func parseWeekday(value any) (time.Weekday, error) {
+	if n, err := functions.GetInt(value); err == nil {
+		if n < 0 || n > 6 {
+			return 0, fmt.Errorf("invalid weekday argument: %d", n)
+		}
+		return time.Weekday(n), nil
+	}
+
+	name, err := functions.GetString(value)
+	if err != nil {
+		return 0, fmt.Errorf("unsupported type for weekday argument: %w", err)
+	}
+
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if strings.EqualFold(name, day.String()) || strings.EqualFold(name, day.String()[:3]) {
+			return day, nil
+		}
+	}
+
+	return 0, fmt.Errorf("invalid weekday argument: %s", name)
+}
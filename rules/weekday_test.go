@@ -0,0 +1,50 @@
+package rules
+
+import (
+	"go-runtimevalidation/args"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWeekday(t *testing.T) {
+	t.Run("Business day without arguments", func(t *testing.T) {
+		assert.NoError(t, Weekday("2024-06-03", nil, nil)) // Monday
+	})
+
+	t.Run("Weekend without arguments", func(t *testing.T) {
+		err := Weekday("2024-06-01", nil, nil) // Saturday
+		assert.EqualError(t, err, "weekday validation failed: Saturday is not one of Monday, Tuesday, Wednesday, Thursday, Friday")
+	})
+
+	t.Run("Allowed days by name", func(t *testing.T) {
+		weekend := map[string]args.Arg{
+			"sat":    {Value: "sat"},
+			"Sunday": {Value: "Sunday"},
+		}
+		assert.NoError(t, Weekday(time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC), nil, weekend))
+		assert.EqualError(t, Weekday("2024-06-03", nil, weekend), "weekday validation failed: Monday is not one of Sunday, Saturday")
+	})
+
+	t.Run("Allowed days by number", func(t *testing.T) {
+		err := Weekday("2024-06-05", nil, map[string]args.Arg{
+			"3": {Value: 3},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Invalid day argument", func(t *testing.T) {
+		err := Weekday("2024-06-05", nil, map[string]args.Arg{
+			"someday": {Value: "someday"},
+		})
+		assert.EqualError(t, err, "invalid weekday argument: someday")
+	})
+
+	t.Run("Day number out of range", func(t *testing.T) {
+		err := Weekday("2024-06-05", nil, map[string]args.Arg{
+			"7": {Value: 7},
+		})
+		assert.EqualError(t, err, "invalid weekday argument: 7")
+	})
+}
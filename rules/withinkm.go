@@ -0,0 +1,83 @@
+package rules
+
+import (
+	"fmt"
+	"go-runtimevalidation/args"
+	"go-runtimevalidation/functions"
+	"go-runtimevalidation/geo"
+	"math"
+	"strconv"
+)
+
+// WithinKm validates that coordinates are within a distance in kilometres of an origin, such as a delivery radius.
+// It is expected to be used in validation rules such as `withinkm:$OriginLat,$OriginLng,50`, `withinkm:52.52,13.405,$Radius`
+// or `withinkm:$Store,10`.
+//
+// The distance is the great-circle distance computed with the haversine formula, see geo.Distance.
+// Unlike most rules, the arguments are positional: either the latitude, the longitude and the radius of the origin,
+// or the origin as coordinates (see LatLng for the supported types) and the radius.
+//
+// Parameters:
+// - input: The coordinates being validated, see LatLng for the supported types.
+// - obj: The object containing additional data (can be used for Field references within the args).
+// - args: The origin and the radius in kilometres, in order, as values, Field or function references.
+//
+// Returns nil if the input is valid, or an error if:
+// - The number of arguments is not 2 or 3
+// - The origin is not valid coordinates, or the radius is not a non-negative number
+// - The input is not valid coordinates
+// - The input is farther than the radius from the origin.
+//
+// Example:
+//
+//	input := "48.8566,2.3522"
+//	obj := struct{ OriginLat, OriginLng float64 }{OriginLat: 52.52, OriginLng: 13.405}
+//	args := []Arg{
+//	    {Type: FieldArg, Field: "OriginLat"},
+//	    {Type: FieldArg, Field: "OriginLng"},
+//	    {Value: 50},
+//	}
+//	err := WithinKm(input, obj, args)  // err will be: "withinkm validation failed: 48.8566,2.3522 is 877.46 km from 52.52,13.405, more than 50 km"
+func WithinKm(input any, obj any, arguments []args.Arg) error {
+	if len(arguments) != 2 && len(arguments) != 3 {
+		return fmt.Errorf("withinkm expects 2 or 3 arguments, got %d", len(arguments))
+	}
+
+	// Evaluate the arguments in order
+	evals := make([]any, len(arguments))
+	for i, arg := range arguments {
+		eval, err := arg.Evaluate(obj)
+		if err != nil {
+			return err
+		}
+		evals[i] = eval
+	}
+
+	var origin geo.Point
+	var err error
+	if len(evals) == 3 {
+		origin, err = getPoint([]any{evals[0], evals[1]})
+	} else {
+		origin, err = getPoint(evals[0])
+	}
+	if err != nil {
+		return fmt.Errorf("withinkm: invalid origin: %w", err)
+	}
+
+	radius, err := functions.GetFloat(evals[len(evals)-1])
+	if err != nil || radius < 0 || math.IsNaN(radius) {
+		return fmt.Errorf("withinkm: invalid radius: %v", evals[len(evals)-1])
+	}
+
+	point, err := getPoint(input)
+	if err != nil {
+		return err
+	}
+
+	if distance := geo.Distance(origin, point); distance > radius {
+		return fmt.Errorf("withinkm validation failed: %s is %s km from %s, more than %s km",
+			point, strconv.FormatFloat(distance, 'f', 2, 64), origin, strconv.FormatFloat(radius, 'f', -1, 64))
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package rules
+
+import (
+	"go-runtimevalidation/args"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithinKm(t *testing.T) {
+	type Order struct {
+		OriginLat float64
+		OriginLng float64
+		Radius    int
+		Store     string
+	}
+	order := Order{OriginLat: 52.52, OriginLng: 13.405, Radius: 50, Store: "52.52,13.405"}
+	origin := []args.Arg{
+		{Type: args.FieldArg, Field: "OriginLat"},
+		{Type: args.FieldArg, Field: "OriginLng"},
+		{Value: 50},
+	}
+
+	t.Run("Within the radius", func(t *testing.T) {
+		// Potsdam is about 27 km from Berlin
+		assert.NoError(t, WithinKm("52.3906,13.0645", order, origin))
+		assert.NoError(t, WithinKm("52.52,13.405", order, origin))
+	})
+
+	t.Run("Beyond the radius", func(t *testing.T) {
+		err := WithinKm("48.8566,2.3522", order, origin)
+		assert.EqualError(t, err, "withinkm validation failed: 48.8566,2.3522 is 877.46 km from 52.52,13.405, more than 50 km")
+	})
+
+	t.Run("Origin as coordinates", func(t *testing.T) {
+		arguments := []args.Arg{{Type: args.FieldArg, Field: "Store"}, {Type: args.FieldArg, Field: "Radius"}}
+		assert.NoError(t, WithinKm([2]float64{52.3906, 13.0645}, order, arguments))
+
+		arguments = []args.Arg{{Type: args.FieldArg, Field: "Store"}, {Value: 10}}
+		assert.Error(t, WithinKm([2]float64{52.3906, 13.0645}, order, arguments))
+	})
+
+	t.Run("Invalid arguments", func(t *testing.T) {
+		err := WithinKm("0,0", nil, []args.Arg{{Value: 50}})
+		assert.EqualError(t, err, "withinkm expects 2 or 3 arguments, got 1")
+
+		err = WithinKm("0,0", nil, []args.Arg{{Value: 91}, {Value: 0}, {Value: 50}})
+		assert.EqualError(t, err, "withinkm: invalid origin: invalid coordinates: latitude 91 is out of range [-90, 90]")
+
+		err = WithinKm("0,0", nil, []args.Arg{{Value: 0}, {Value: 0}, {Value: -1}})
+		assert.EqualError(t, err, "withinkm: invalid radius: -1")
+
+		err = WithinKm("0,0", nil, []args.Arg{{Value: "0,0"}, {Value: "far"}})
+		assert.EqualError(t, err, "withinkm: invalid radius: far")
+	})
+
+	t.Run("Invalid input", func(t *testing.T) {
+		assert.Error(t, WithinKm("here", order, origin))
+	})
+}
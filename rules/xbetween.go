@@ -5,11 +5,12 @@ import (
 
 	"go-runtimevalidation/args"
 	"go-runtimevalidation/functions"
+	"sort"
 )
 
 // XBetween validates that the input is exclusively between two specified bounds.
 // The input must be an integer or a type that can be converted into an integer.
-// It checks against exactly two arguments provided in the args map.
+// It checks against exactly two arguments provided in the args map, taken in the order of their keys.
 //
 // Parameters:
 // - input: The value being validated, expected to be convertible to an integer.
@@ -32,21 +33,34 @@ import (
 //	}
 //	err := XBetween(input, obj, args)  // err will be nil
 func XBetween(input any, obj any, arguments map[string]args.Arg) error {
+	// Extract the two arguments, ordered by key so that the bounds are read the same way on every call
+	keys := make([]string, 0, len(arguments))
+	for k := range arguments {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	bounds := make([]args.Arg, 0, len(arguments))
+	for _, k := range keys {
+		bounds = append(bounds, arguments[k])
+	}
+
+	return XBetweenList(input, obj, bounds)
+}
+
+// XBetweenList is XBetween with the bounds given in order, as read by args.ParseArgList.
+// The validation rule uses it, so that equal bounds such as `xbetween:5,5` are kept as two arguments.
+//
+// Example:
+//
+//	err := XBetweenList(input, obj, []Arg{{Value: 10}, {Value: 20}})
+func XBetweenList(input any, obj any, arguments []args.Arg) error {
 	if len(arguments) != 2 {
 		return fmt.Errorf("xbewteen expects exactly 2 arguments, got %d", len(arguments))
 	}
 
-	// Extract the two arguments
-	var lhsArg, rhsArg args.Arg
-	i := 0
-	for _, v := range arguments {
-		if i == 0 {
-			lhsArg = v
-		} else {
-			rhsArg = v
-		}
-		i++
-	}
+	// Extract the two arguments, in order
+	lhsArg, rhsArg := arguments[0], arguments[1]
 
 	// Evaluate the arguments
 	lhsEval, err := lhsArg.Evaluate(obj)
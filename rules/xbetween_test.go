@@ -86,3 +86,31 @@ func TestXBetween(t *testing.T) {
 		assert.ErrorContains(t, err, "unsupported type for")
 	})
 }
+
+func TestXBetweenList(t *testing.T) {
+	// Test case 1: Bounds are read in order
+	t.Run("Ordered bounds", func(t *testing.T) {
+		obj := struct{ Upper int }{Upper: 20}
+		assert.NoError(t, XBetweenList(15, obj, []args.Arg{
+			{Value: 10},
+			{Type: args.FieldArg, Field: "Upper"},
+		}))
+	})
+
+	// Test case 2: Equal exclusive bounds accept no value
+	t.Run("Equal bounds", func(t *testing.T) {
+		err := XBetweenList(5, nil, []args.Arg{
+			{Value: 5},
+			{Value: 5},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "is not exclusively between")
+	})
+
+	// Test case 3: Wrong number of arguments
+	t.Run("Wrong number of arguments", func(t *testing.T) {
+		err := XBetweenList(15, nil, []args.Arg{{Value: 10}})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "expects exactly 2 arguments, got 1")
+	})
+}
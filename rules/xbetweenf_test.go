@@ -2,6 +2,7 @@ package rules
 
 import (
 	"go-runtimevalidation/args"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -160,4 +161,41 @@ func TestXBetweenF(t *testing.T) {
 		assert.Error(t, err)
 		assert.EqualError(t, err, "unsupported type for lower bound argument: failed to parse \"test\" of type string as float64")
 	})
+
+	// Test that NaN is rejected
+	t.Run("NaN input", func(t *testing.T) {
+		err := XBetweenF(math.NaN(), nil, map[string]args.Arg{
+			"lowerBound": {Value: 0},
+			"upperBound": {Value: 1},
+		})
+		assert.EqualError(t, err, "xbetweenf validation failed: NaN is not a number")
+	})
+}
+
+func TestXBetweenFList(t *testing.T) {
+	// Test case 1: Bounds are read in order
+	t.Run("Ordered bounds", func(t *testing.T) {
+		obj := struct{ Upper int }{Upper: 20}
+		assert.NoError(t, XBetweenFList(15, obj, []args.Arg{
+			{Value: 10},
+			{Type: args.FieldArg, Field: "Upper"},
+		}))
+	})
+
+	// Test case 2: Equal exclusive bounds accept no value
+	t.Run("Equal bounds", func(t *testing.T) {
+		err := XBetweenFList(0.5, nil, []args.Arg{
+			{Value: 0.5},
+			{Value: 0.5},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "is not exclusively between")
+	})
+
+	// Test case 3: Wrong number of arguments
+	t.Run("Wrong number of arguments", func(t *testing.T) {
+		err := XBetweenFList(15, nil, []args.Arg{{Value: 10}})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "expects exactly 2 arguments, got 1")
+	})
 }
@@ -0,0 +1,67 @@
+package rules
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"go-runtimevalidation/functions"
+	"io"
+)
+
+// XML validates that the input is a well-formed XML document with a single root element.
+// An XML declaration, comments, processing instructions and a DOCTYPE may surround the root element,
+// but no other text. The document is only checked for well-formedness; it is not validated against a schema.
+//
+// Example
+//   - `<?xml version="1.0"?><config><retries>3</retries></config>`
+//
+// Parameters:
+//   - input (any): The value to validate, a string or a byte slice.
+//
+// Returns:
+//   - error: If the input is empty, is not a string or byte slice, or is not a well-formed XML document.
+func XML(input any) error {
+	data, err := functions.GetBytes(input)
+	if err != nil {
+		return fmt.Errorf("unsupported type for input field: %w", err)
+	}
+
+	if len(bytes.TrimSpace(data)) == 0 {
+		return fmt.Errorf("invalid xml: empty document")
+	}
+
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	depth, roots := 0, 0
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("invalid xml: %w", err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			if depth == 0 {
+				roots++
+				if roots > 1 {
+					return fmt.Errorf("invalid xml: more than one root element")
+				}
+			}
+			depth++
+		case xml.EndElement:
+			depth--
+		case xml.CharData:
+			if depth == 0 && len(bytes.TrimSpace(t)) > 0 {
+				return fmt.Errorf("invalid xml: text outside the root element")
+			}
+		}
+	}
+
+	if roots == 0 {
+		return fmt.Errorf("invalid xml: no root element")
+	}
+
+	return nil
+}
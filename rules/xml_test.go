@@ -0,0 +1,58 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestXML(t *testing.T) {
+	t.Run("Valid documents", func(t *testing.T) {
+		assert.NoError(t, XML(`<config/>`))
+		assert.NoError(t, XML(`<?xml version="1.0" encoding="UTF-8"?>
+<!-- settings -->
+<config><retries>3</retries><url>https://example.com?a=1&amp;b=2</url></config>
+`))
+		assert.NoError(t, XML([]byte(`<a><b/></a>`)))
+	})
+
+	t.Run("Mismatched tags", func(t *testing.T) {
+		err := XML(`<config><retries>3</config>`)
+		assert.Error(t, err)
+		assert.Equal(t, "invalid xml: XML syntax error on line 1: element <retries> closed by </config>", err.Error())
+	})
+
+	t.Run("Unclosed element", func(t *testing.T) {
+		err := XML(`<config>`)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid xml")
+	})
+
+	t.Run("Multiple roots", func(t *testing.T) {
+		err := XML(`<a/><b/>`)
+		assert.Error(t, err)
+		assert.Equal(t, "invalid xml: more than one root element", err.Error())
+	})
+
+	t.Run("Text outside the root", func(t *testing.T) {
+		err := XML(`just text`)
+		assert.Error(t, err)
+		assert.Equal(t, "invalid xml: text outside the root element", err.Error())
+
+		err = XML(`<a/> trailing`)
+		assert.Error(t, err)
+		assert.Equal(t, "invalid xml: text outside the root element", err.Error())
+	})
+
+	t.Run("No root element", func(t *testing.T) {
+		err := XML(`<?xml version="1.0"?>`)
+		assert.Error(t, err)
+		assert.Equal(t, "invalid xml: no root element", err.Error())
+	})
+
+	t.Run("Empty document", func(t *testing.T) {
+		err := XML("")
+		assert.Error(t, err)
+		assert.Equal(t, "invalid xml: empty document", err.Error())
+	})
+}
@@ -0,0 +1,79 @@
+package rules
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go-runtimevalidation/functions"
+	"io"
+
+	"gopkg.in/yaml.v3"
+)
+
+// YAML validates that the input is a well-formed YAML stream of one or more documents.
+// Documents separated by `---` are each checked. A mapping that defines the same key twice is rejected,
+// as most YAML parsers would silently keep only one of the values.
+//
+// Example
+//   - "name: webhook\nretries: 3\n"
+//
+// Parameters:
+//   - input (any): The value to validate, a string or a byte slice.
+//
+// Returns:
+//   - error: If the input is empty, is not a string or byte slice, is not valid YAML, or has a duplicate mapping key.
+func YAML(input any) error {
+	data, err := functions.GetBytes(input)
+	if err != nil {
+		return fmt.Errorf("unsupported type for input field: %w", err)
+	}
+
+	if len(bytes.TrimSpace(data)) == 0 {
+		return fmt.Errorf("invalid yaml: empty document")
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	for {
+		var node yaml.Node
+		err := decoder.Decode(&node)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("invalid yaml: %w", err)
+		}
+		if err := checkYAMLKeys(&node); err != nil {
+			return fmt.Errorf("invalid yaml: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// checkYAMLKeys returns an error for the first mapping in the node tree that defines a scalar key twice.
+// Keys are compared by their resolved tag and value, so that `1` and `"1"` are different keys.
+// Aliases are not followed, as the anchored node is checked where it is defined.
+func checkYAMLKeys(node *yaml.Node) error {
+	if node.Kind == yaml.MappingNode {
+		seen := map[[2]string]int{}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i]
+			if key.Kind != yaml.ScalarNode || key.ShortTag() == "!!merge" {
+				continue
+			}
+			id := [2]string{key.ShortTag(), key.Value}
+			if line, ok := seen[id]; ok {
+				return fmt.Errorf("line %d: mapping key %q already defined at line %d", key.Line, key.Value, line)
+			}
+			seen[id] = key.Line
+		}
+	}
+
+	for _, child := range node.Content {
+		if err := checkYAMLKeys(child); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
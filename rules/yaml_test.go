@@ -0,0 +1,56 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestYAML(t *testing.T) {
+	t.Run("Valid documents", func(t *testing.T) {
+		assert.NoError(t, YAML("name: webhook\nretries: 3\nheaders:\n  - X-Token\n"))
+		assert.NoError(t, YAML("a: 1\n---\nb: 2\n"))
+		assert.NoError(t, YAML(`{"json": "is yaml too"}`))
+		assert.NoError(t, YAML([]byte("- 1\n- 2\n")))
+	})
+
+	t.Run("Invalid indentation", func(t *testing.T) {
+		err := YAML("name: webhook\n  retries: 3\n")
+		assert.Error(t, err)
+		assert.Equal(t, "invalid yaml: yaml: line 2: mapping values are not allowed in this context", err.Error())
+	})
+
+	t.Run("Invalid second document", func(t *testing.T) {
+		err := YAML("a: 1\n---\nb: [1, 2\n")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid yaml")
+	})
+
+	t.Run("Duplicate mapping key", func(t *testing.T) {
+		err := YAML("a: 1\na: 2\n")
+		assert.Error(t, err)
+		assert.Equal(t, "invalid yaml: line 2: mapping key \"a\" already defined at line 1", err.Error())
+
+		err = YAML("a: 1\n---\nb:\n  c: 1\n  c: 2\n")
+		assert.Error(t, err)
+		assert.Equal(t, "invalid yaml: line 5: mapping key \"c\" already defined at line 4", err.Error())
+	})
+
+	t.Run("Keys with different types or in different mappings", func(t *testing.T) {
+		assert.NoError(t, YAML("1: int\n\"1\": string\n"))
+		assert.NoError(t, YAML("a:\n  b: 1\nc:\n  b: 2\n"))
+		assert.NoError(t, YAML("base: &base\n  a: 1\nchild:\n  <<: *base\n  a: 2\n"))
+	})
+
+	t.Run("Empty document", func(t *testing.T) {
+		err := YAML("\n")
+		assert.Error(t, err)
+		assert.Equal(t, "invalid yaml: empty document", err.Error())
+	})
+
+	t.Run("Unsupported type", func(t *testing.T) {
+		err := YAML(3.5)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported type for input field")
+	})
+}
@@ -0,0 +1,319 @@
+package semver
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Range is a version range such as ">=1.2.0 <2.0.0 || ^3.1".
+//
+// A range is a list of comparator sets separated by "||", and a version satisfies the range if it satisfies
+// every comparator of at least one set. Comparators within a set are separated by spaces and can be:
+//   - primitives: <1.2.3, <=1.2.3, >1.2.3, >=1.2.3 and =1.2.3 (or just 1.2.3)
+//   - wildcards: *, 1.x, 1.2.x, or partial versions such as 1 or 1.2, meaning any version with that prefix
+//   - tilde ranges: ~1.2.3 allows patch updates (>=1.2.3 <1.3.0), ~1 allows minor updates (>=1.0.0 <2.0.0)
+//   - caret ranges: ^1.2.3 allows updates that do not change the leftmost non-zero component (>=1.2.3 <2.0.0, ^0.2.3 is >=0.2.3 <0.3.0)
+//   - hyphen ranges: 1.2.3 - 2.3.4 (>=1.2.3 <=2.3.4), where a partial upper bound includes its whole prefix
+//
+// Upper bounds derived from partial versions, tilde and caret ranges exclude the prereleases of the bound itself,
+// so ^1.2.3 does not match 2.0.0-rc.1.
+type Range struct {
+	text string
+	sets [][]comparator
+}
+
+// comparator is a single primitive comparison. An empty operator matches every version.
+type comparator struct {
+	op      string
+	version Version
+}
+
+var (
+	hyphenRange     = regexp.MustCompile(`^(\S+)\s+-\s+(\S+)$`)
+	operatorSpacing = regexp.MustCompile(`(<=|>=|<|>|=|\^|~>|~)\s+`)
+	operators       = []string{">=", "<=", ">", "<", "=", "^", "~>", "~"}
+)
+
+var (
+	anyVersion = comparator{}
+	noVersion  = comparator{op: "<", version: Version{Prerelease: []string{"0"}}}
+)
+
+// ParseRange parses a version range. An empty range matches every version.
+//
+// Example:
+//
+//	r, err := ParseRange(">=1.2.0 <2.0.0 || ^3.1")
+//	r.Contains(MustParse("3.4.0")) // true
+func ParseRange(text string) (Range, error) {
+	r := Range{text: strings.TrimSpace(text)}
+	for _, set := range strings.Split(r.text, "||") {
+		comparators, err := parseComparatorSet(strings.TrimSpace(set))
+		if err != nil {
+			return Range{}, err
+		}
+		r.sets = append(r.sets, comparators)
+	}
+	return r, nil
+}
+
+// String returns the range as it was written, without surrounding spaces.
+func (r Range) String() string {
+	return r.text
+}
+
+// Contains reports whether the version satisfies the range.
+//
+// A prerelease version only satisfies a comparator set that contains a prerelease of the same major, minor and patch version,
+// so ">=1.2.3-beta.1" matches 1.2.3-beta.2 but not 1.2.4-beta.1: opting into the prereleases of one version does not opt into all of them.
+func (r Range) Contains(v Version) bool {
+	return r.contains(v, false)
+}
+
+// ContainsPrerelease is like Contains but lets prerelease versions satisfy any comparator set by precedence alone.
+func (r Range) ContainsPrerelease(v Version) bool {
+	return r.contains(v, true)
+}
+
+func (r Range) contains(v Version, includePrerelease bool) bool {
+	for _, set := range r.sets {
+		if setContains(set, v, includePrerelease) {
+			return true
+		}
+	}
+	return false
+}
+
+func setContains(set []comparator, v Version, includePrerelease bool) bool {
+	for _, c := range set {
+		if !c.matches(v) {
+			return false
+		}
+	}
+
+	if !v.IsPrerelease() || includePrerelease {
+		return true
+	}
+
+	for _, c := range set {
+		if c.version.IsPrerelease() && c.version.Major == v.Major && c.version.Minor == v.Minor && c.version.Patch == v.Patch {
+			return true
+		}
+	}
+	return false
+}
+
+func (c comparator) matches(v Version) bool {
+	cmp := v.Compare(c.version)
+	switch c.op {
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	case "=":
+		return cmp == 0
+	}
+	return true
+}
+
+// parseComparatorSet parses the comparators of one "||"-separated part of a range.
+func parseComparatorSet(text string) ([]comparator, error) {
+	if text == "" {
+		return []comparator{anyVersion}, nil
+	}
+
+	if m := hyphenRange.FindStringSubmatch(text); m != nil {
+		lower, err := parsePartial(m[1])
+		if err != nil {
+			return nil, fmt.Errorf("comparator %q: %w", m[1], err)
+		}
+		upper, err := parsePartial(m[2])
+		if err != nil {
+			return nil, fmt.Errorf("comparator %q: %w", m[2], err)
+		}
+		return []comparator{lower.atLeast(), upper.atMost()}, nil
+	}
+
+	var set []comparator
+	for _, token := range strings.Fields(operatorSpacing.ReplaceAllString(text, "$1")) {
+		op := ""
+		for _, o := range operators {
+			if strings.HasPrefix(token, o) {
+				op = o
+				break
+			}
+		}
+
+		p, err := parsePartial(strings.TrimPrefix(token, op))
+		if err != nil {
+			return nil, fmt.Errorf("comparator %q: %w", token, err)
+		}
+
+		switch op {
+		case "", "=":
+			set = append(set, p.equal()...)
+		case ">":
+			set = append(set, p.greater())
+		case ">=":
+			set = append(set, p.atLeast())
+		case "<":
+			set = append(set, p.less())
+		case "<=":
+			set = append(set, p.atMost())
+		case "~", "~>":
+			set = append(set, p.tilde()...)
+		case "^":
+			set = append(set, p.caret()...)
+		}
+	}
+	return set, nil
+}
+
+// partial is a possibly incomplete version such as 1, 1.2, 1.2.x or 1.2.3-rc.1.
+type partial struct {
+	numbers [3]uint64
+	n       int // number of components given before the first wildcard or the end
+	version Version
+}
+
+func parsePartial(text string) (partial, error) {
+	text = strings.TrimPrefix(text, "v")
+	if text == "" {
+		return partial{}, fmt.Errorf("version is empty")
+	}
+
+	var p partial
+	rest, build, hasBuild := strings.Cut(text, "+")
+	core, pre, hasPre := strings.Cut(rest, "-")
+
+	parts := strings.Split(core, ".")
+	if len(parts) > 3 {
+		return partial{}, fmt.Errorf("version %q has more than three components", core)
+	}
+
+	wildcard := false
+	for i, part := range parts {
+		if part == "x" || part == "X" || part == "*" {
+			wildcard = true
+			continue
+		}
+		if wildcard {
+			return partial{}, fmt.Errorf("%s version %q follows a wildcard", componentNames[i], part)
+		}
+		n, err := parseNumber(componentNames[i], part)
+		if err != nil {
+			return partial{}, err
+		}
+		p.numbers[i] = n
+		p.n++
+	}
+
+	if (hasPre || hasBuild) && p.n < 3 {
+		return partial{}, fmt.Errorf("version %q has a prerelease or build but is not complete", text)
+	}
+
+	p.version = Version{Major: p.numbers[0], Minor: p.numbers[1], Patch: p.numbers[2]}
+	if hasPre {
+		ids, err := parseIdentifiers("prerelease", pre, true)
+		if err != nil {
+			return partial{}, err
+		}
+		p.version.Prerelease = ids
+	}
+	if hasBuild {
+		if _, err := parseIdentifiers("build metadata", build, false); err != nil {
+			return partial{}, err
+		}
+	}
+
+	return p, nil
+}
+
+// next returns the lowest version, prereleases included, above every version with the first i components of p.
+func (p partial) next(i int) Version {
+	v := Version{Prerelease: []string{"0"}}
+	numbers := [3]*uint64{&v.Major, &v.Minor, &v.Patch}
+	for j := 0; j < i; j++ {
+		*numbers[j] = p.numbers[j]
+	}
+	*numbers[i-1]++
+	return v
+}
+
+func (p partial) equal() []comparator {
+	switch p.n {
+	case 0:
+		return []comparator{anyVersion}
+	case 3:
+		return []comparator{{op: "=", version: p.version}}
+	}
+	return []comparator{p.atLeast(), {op: "<", version: p.next(p.n)}}
+}
+
+func (p partial) greater() comparator {
+	switch p.n {
+	case 0:
+		return noVersion
+	case 3:
+		return comparator{op: ">", version: p.version}
+	}
+	next := p.next(p.n)
+	next.Prerelease = nil
+	return comparator{op: ">=", version: next}
+}
+
+func (p partial) atLeast() comparator {
+	if p.n == 0 {
+		return anyVersion
+	}
+	return comparator{op: ">=", version: p.version}
+}
+
+func (p partial) less() comparator {
+	switch p.n {
+	case 0:
+		return noVersion
+	case 3:
+		return comparator{op: "<", version: p.version}
+	}
+	bound := p.version
+	bound.Prerelease = []string{"0"}
+	return comparator{op: "<", version: bound}
+}
+
+func (p partial) atMost() comparator {
+	switch p.n {
+	case 0:
+		return anyVersion
+	case 3:
+		return comparator{op: "<=", version: p.version}
+	}
+	return comparator{op: "<", version: p.next(p.n)}
+}
+
+func (p partial) tilde() []comparator {
+	switch p.n {
+	case 0:
+		return []comparator{anyVersion}
+	case 1:
+		return []comparator{p.atLeast(), {op: "<", version: p.next(1)}}
+	}
+	return []comparator{p.atLeast(), {op: "<", version: p.next(2)}}
+}
+
+func (p partial) caret() []comparator {
+	switch {
+	case p.n == 0:
+		return []comparator{anyVersion}
+	case p.n == 1 || p.numbers[0] > 0:
+		return []comparator{p.atLeast(), {op: "<", version: p.next(1)}}
+	case p.n == 2 || p.numbers[1] > 0:
+		return []comparator{p.atLeast(), {op: "<", version: p.next(2)}}
+	}
+	return []comparator{p.atLeast(), {op: "<", version: p.next(3)}}
+}
@@ -0,0 +1,85 @@
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRange(t *testing.T) {
+	cases := []struct {
+		rng      string
+		included []string
+		excluded []string
+	}{
+		{">=1.2.0 <2.0.0", []string{"1.2.0", "1.9.9"}, []string{"1.1.9", "2.0.0", "1.5.0-rc.1"}},
+		{">= 1.2.0  < 2.0.0", []string{"1.2.0"}, []string{"2.0.0"}},
+		{"1.2.3", []string{"1.2.3", "1.2.3+build"}, []string{"1.2.4"}},
+		{"=v1.2.3", []string{"1.2.3"}, []string{"1.2.4"}},
+		{"", []string{"0.0.0", "9.9.9"}, []string{"1.0.0-rc.1"}},
+		{"*", []string{"0.0.0", "9.9.9"}, nil},
+		{"1.x", []string{"1.0.0", "1.9.9"}, []string{"0.9.9", "2.0.0"}},
+		{"1.2", []string{"1.2.0", "1.2.9"}, []string{"1.3.0"}},
+		{"1.2.*", []string{"1.2.0", "1.2.9"}, []string{"1.3.0"}},
+		{"~1.2.3", []string{"1.2.3", "1.2.9"}, []string{"1.2.2", "1.3.0"}},
+		{"~1.2", []string{"1.2.0", "1.2.9"}, []string{"1.3.0"}},
+		{"~1", []string{"1.0.0", "1.9.0"}, []string{"2.0.0"}},
+		{"^1.2.3", []string{"1.2.3", "1.9.0"}, []string{"1.2.2", "2.0.0", "2.0.0-rc.1"}},
+		{"^0.2.3", []string{"0.2.3", "0.2.9"}, []string{"0.3.0"}},
+		{"^0.0.3", []string{"0.0.3"}, []string{"0.0.4"}},
+		{"^0.0", []string{"0.0.0", "0.0.9"}, []string{"0.1.0"}},
+		{"^0.x", []string{"0.0.0", "0.9.0"}, []string{"1.0.0"}},
+		{"^1.x", []string{"1.0.0", "1.9.0"}, []string{"2.0.0"}},
+		{">1.2", []string{"1.3.0"}, []string{"1.2.9"}},
+		{"<1.2", []string{"1.1.9"}, []string{"1.2.0", "1.2.0-rc.1"}},
+		{"<=1.2", []string{"1.2.9"}, []string{"1.3.0"}},
+		{">*", nil, []string{"0.0.0", "1.0.0"}},
+		{"1.2.3 - 2.3.4", []string{"1.2.3", "2.3.4"}, []string{"1.2.2", "2.3.5"}},
+		{"1.2 - 2.3", []string{"1.2.0", "2.3.9"}, []string{"1.1.9", "2.4.0"}},
+		{"1.x || >=2.5.0 || 5.0.0 - 7.2.3", []string{"1.2.3", "2.5.0", "4.0.0", "7.2.3"}, []string{"0.9.0", "2.4.9"}},
+		{">=1.2.3-beta.1 <2.0.0", []string{"1.2.3-beta.1", "1.2.3-beta.2", "1.2.3", "1.5.0"}, []string{"1.2.3-alpha", "1.2.4-beta.1"}},
+	}
+
+	for _, c := range cases {
+		r, err := ParseRange(c.rng)
+		if !assert.NoError(t, err, c.rng) {
+			continue
+		}
+		for _, v := range c.included {
+			assert.True(t, r.Contains(MustParse(v)), "%s should contain %s", c.rng, v)
+		}
+		for _, v := range c.excluded {
+			assert.False(t, r.Contains(MustParse(v)), "%s should not contain %s", c.rng, v)
+		}
+	}
+
+	t.Run("Including prereleases", func(t *testing.T) {
+		r, err := ParseRange("^1.2.3")
+		assert.NoError(t, err)
+		assert.False(t, r.Contains(MustParse("1.5.0-rc.1")))
+		assert.True(t, r.ContainsPrerelease(MustParse("1.5.0-rc.1")))
+		assert.False(t, r.ContainsPrerelease(MustParse("2.0.0-rc.1")))
+	})
+
+	t.Run("String", func(t *testing.T) {
+		r, err := ParseRange(" ^1.2 || ~2.0 ")
+		assert.NoError(t, err)
+		assert.Equal(t, "^1.2 || ~2.0", r.String())
+	})
+
+	t.Run("Invalid ranges", func(t *testing.T) {
+		for text, reason := range map[string]string{
+			">=1.2.z":        `comparator ">=1.2.z": patch version "z" is not a number`,
+			"1.x.3":          `comparator "1.x.3": patch version "3" follows a wildcard`,
+			"^1.2-rc.1":      `comparator "^1.2-rc.1": version "1.2-rc.1" has a prerelease or build but is not complete`,
+			"1.2.3.4":        `comparator "1.2.3.4": version "1.2.3.4" has more than three components`,
+			">=1.0.0 || >=":  `comparator ">=": version is empty`,
+			"1.0.0 - 2.0.0a": `comparator "2.0.0a": patch version "0a" is not a number`,
+		} {
+			_, err := ParseRange(text)
+			if assert.Error(t, err, text) {
+				assert.Equal(t, reason, err.Error(), text)
+			}
+		}
+	})
+}
@@ -0,0 +1,197 @@
+// Package semver parses and compares versions following Semantic Versioning 2.0.0 (https://semver.org)
+// and matches them against ranges in the syntax used by npm, such as ">=1.2.0 <2.0.0", "^1.2", "~1.2.3" or "1.x || 2.x".
+package semver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Version is a parsed semantic version.
+type Version struct {
+	Major, Minor, Patch uint64
+	Prerelease          []string // dot-separated prerelease identifiers, e.g. ["rc", "1"] for 1.0.0-rc.1
+	Build               []string // dot-separated build metadata identifiers, ignored in comparisons
+}
+
+// Parse parses a version in the strict MAJOR.MINOR.PATCH[-PRERELEASE][+BUILD] form.
+//
+// Example:
+//
+//	v, err := Parse("1.2.3-rc.1+build.5") // v.Minor == 2, v.Prerelease == ["rc", "1"]
+//	_, err = Parse("1.02.3")             // err: `minor version "02" has a leading zero`
+func Parse(text string) (Version, error) {
+	if text == "" {
+		return Version{}, fmt.Errorf("version is empty")
+	}
+
+	var v Version
+	rest, build, hasBuild := strings.Cut(text, "+")
+	if hasBuild {
+		ids, err := parseIdentifiers("build metadata", build, false)
+		if err != nil {
+			return Version{}, err
+		}
+		v.Build = ids
+	}
+
+	core, pre, hasPre := strings.Cut(rest, "-")
+	if hasPre {
+		ids, err := parseIdentifiers("prerelease", pre, true)
+		if err != nil {
+			return Version{}, err
+		}
+		v.Prerelease = ids
+	}
+
+	parts := strings.Split(core, ".")
+	if len(parts) != 3 {
+		return Version{}, fmt.Errorf("version %q is not of the form MAJOR.MINOR.PATCH", core)
+	}
+	numbers := [3]*uint64{&v.Major, &v.Minor, &v.Patch}
+	for i, part := range parts {
+		n, err := parseNumber(componentNames[i], part)
+		if err != nil {
+			return Version{}, err
+		}
+		*numbers[i] = n
+	}
+
+	return v, nil
+}
+
+// MustParse is like Parse but panics if the version cannot be parsed. It simplifies initializing known versions.
+func MustParse(text string) Version {
+	v, err := Parse(text)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+var componentNames = [3]string{"major", "minor", "patch"}
+
+// parseNumber parses a numeric version component, which must not have leading zeros.
+func parseNumber(name, text string) (uint64, error) {
+	if text == "" {
+		return 0, fmt.Errorf("%s version is empty", name)
+	}
+	if !isNumeric(text) {
+		return 0, fmt.Errorf("%s version %q is not a number", name, text)
+	}
+	if len(text) > 1 && text[0] == '0' {
+		return 0, fmt.Errorf("%s version %q has a leading zero", name, text)
+	}
+	n, err := strconv.ParseUint(text, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s version %q is too large", name, text)
+	}
+	return n, nil
+}
+
+// parseIdentifiers splits dot-separated prerelease or build identifiers.
+// Numeric prerelease identifiers must not have leading zeros.
+func parseIdentifiers(kind, text string, prerelease bool) ([]string, error) {
+	ids := strings.Split(text, ".")
+	for _, id := range ids {
+		if id == "" {
+			return nil, fmt.Errorf("%s has an empty identifier", kind)
+		}
+		for _, r := range id {
+			if !(r == '-' || r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z') {
+				return nil, fmt.Errorf("%s identifier %q contains invalid character %q", kind, id, r)
+			}
+		}
+		if prerelease && len(id) > 1 && id[0] == '0' && isNumeric(id) {
+			return nil, fmt.Errorf("%s identifier %q has a leading zero", kind, id)
+		}
+	}
+	return ids, nil
+}
+
+func isNumeric(text string) bool {
+	for i := 0; i < len(text); i++ {
+		if text[i] < '0' || text[i] > '9' {
+			return false
+		}
+	}
+	return text != ""
+}
+
+// String returns the version in its canonical form.
+func (v Version) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if len(v.Prerelease) > 0 {
+		b.WriteString("-" + strings.Join(v.Prerelease, "."))
+	}
+	if len(v.Build) > 0 {
+		b.WriteString("+" + strings.Join(v.Build, "."))
+	}
+	return b.String()
+}
+
+// IsPrerelease reports whether the version has prerelease identifiers.
+func (v Version) IsPrerelease() bool {
+	return len(v.Prerelease) > 0
+}
+
+// Compare returns -1, 0 or +1 depending on whether v has lower, equal or higher precedence than w.
+//
+// Precedence follows section 11 of the specification: major, minor and patch are compared numerically,
+// a prerelease version has lower precedence than the release, prerelease identifiers are compared one by one
+// (numeric ones numerically and below alphanumeric ones, alphanumeric ones in ASCII order), and build metadata is ignored.
+func (v Version) Compare(w Version) int {
+	for _, c := range [3][2]uint64{{v.Major, w.Major}, {v.Minor, w.Minor}, {v.Patch, w.Patch}} {
+		if c[0] != c[1] {
+			if c[0] < c[1] {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	switch {
+	case len(v.Prerelease) == 0 && len(w.Prerelease) == 0:
+		return 0
+	case len(v.Prerelease) == 0:
+		return 1
+	case len(w.Prerelease) == 0:
+		return -1
+	}
+
+	for i := 0; i < len(v.Prerelease) && i < len(w.Prerelease); i++ {
+		if c := compareIdentifiers(v.Prerelease[i], w.Prerelease[i]); c != 0 {
+			return c
+		}
+	}
+
+	switch {
+	case len(v.Prerelease) < len(w.Prerelease):
+		return -1
+	case len(v.Prerelease) > len(w.Prerelease):
+		return 1
+	}
+	return 0
+}
+
+func compareIdentifiers(a, b string) int {
+	aNumeric, bNumeric := isNumeric(a), isNumeric(b)
+	switch {
+	case aNumeric && bNumeric:
+		// Without leading zeros, a longer number is a larger one
+		if len(a) != len(b) {
+			if len(a) < len(b) {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a, b)
+	case aNumeric:
+		return -1
+	case bNumeric:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
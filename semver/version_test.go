@@ -0,0 +1,70 @@
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("Valid versions", func(t *testing.T) {
+		v, err := Parse("1.2.3-rc.1+build.05")
+		assert.NoError(t, err)
+		assert.Equal(t, Version{Major: 1, Minor: 2, Patch: 3, Prerelease: []string{"rc", "1"}, Build: []string{"build", "05"}}, v)
+		assert.Equal(t, "1.2.3-rc.1+build.05", v.String())
+		assert.True(t, v.IsPrerelease())
+
+		v, err = Parse("0.0.0")
+		assert.NoError(t, err)
+		assert.False(t, v.IsPrerelease())
+
+		_, err = Parse("1.0.0-x-y-z.--")
+		assert.NoError(t, err)
+	})
+
+	t.Run("Invalid versions", func(t *testing.T) {
+		for text, reason := range map[string]string{
+			"":                         "version is empty",
+			"1.2":                      `version "1.2" is not of the form MAJOR.MINOR.PATCH`,
+			"1.2.3.4":                  `version "1.2.3.4" is not of the form MAJOR.MINOR.PATCH`,
+			"v1.2.3":                   `major version "v1" is not a number`,
+			"1.02.3":                   `minor version "02" has a leading zero`,
+			"1..3":                     "minor version is empty",
+			"1.2.99999999999999999999": `patch version "99999999999999999999" is too large`,
+			"1.2.3-":                   "prerelease has an empty identifier",
+			"1.2.3-rc..1":              "prerelease has an empty identifier",
+			"1.2.3-rc.01":              `prerelease identifier "01" has a leading zero`,
+			"1.2.3-rc_1":               `prerelease identifier "rc_1" contains invalid character '_'`,
+			"1.2.3+":                   "build metadata has an empty identifier",
+			"1.2.3+build!":             `build metadata identifier "build!" contains invalid character '!'`,
+		} {
+			_, err := Parse(text)
+			if assert.Error(t, err, text) {
+				assert.Equal(t, reason, err.Error(), text)
+			}
+		}
+	})
+}
+
+func TestCompare(t *testing.T) {
+	// Ordered by precedence, from the example in section 11 of the specification
+	ordered := []string{
+		"1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta", "1.0.0-beta.2",
+		"1.0.0-beta.11", "1.0.0-rc.1", "1.0.0", "1.0.1", "1.1.0", "2.0.0", "10.0.0",
+	}
+	for i := range ordered {
+		for j := range ordered {
+			want := 0
+			if i < j {
+				want = -1
+			} else if i > j {
+				want = 1
+			}
+			assert.Equal(t, want, MustParse(ordered[i]).Compare(MustParse(ordered[j])), "%s <=> %s", ordered[i], ordered[j])
+		}
+	}
+
+	t.Run("Build metadata is ignored", func(t *testing.T) {
+		assert.Equal(t, 0, MustParse("1.0.0+build.1").Compare(MustParse("1.0.0+build.2")))
+	})
+}
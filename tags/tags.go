@@ -64,6 +64,7 @@ const (
 	CVE                 Tag = "cve"
 	Cron                Tag = "cron"
 	Regex               Tag = "regex"
+	NotRegex            Tag = "notregex"
 	RequiredIf          Tag = "requiredif"
 	Between             Tag = "between"
 	XBetween            Tag = "xbetween"
@@ -72,6 +73,9 @@ const (
 	Min                 Tag = "min"
 	Max                 Tag = "max"
 	Length              Tag = "length"
+	MinLen              Tag = "minlen"
+	MaxLen              Tag = "maxlen"
+	LenBetween          Tag = "lenbetween"
 	OneOf               Tag = "oneof"
 	StartsWith          Tag = "startswith"
 	StartsNotWith       Tag = "startsnotwith"
@@ -81,4 +85,90 @@ const (
 	Uppercase           Tag = "upper"
 	Lowercase           Tag = "lower"
 	EndsNotWith         Tag = "endsnotwith"
+	IP                  Tag = "ip"
+	IPv4                Tag = "ipv4"
+	IPv6                Tag = "ipv6"
+	CIDR                Tag = "cidr"
+	CIDRv4              Tag = "cidrv4"
+	CIDRv6              Tag = "cidrv6"
+	MAC                 Tag = "mac"
+	Port                Tag = "port"
+	HostPort            Tag = "hostport"
+	URL                 Tag = "url"
+	URI                 Tag = "uri"
+	HTTPURL             Tag = "httpurl"
+	IPIn                Tag = "ipin"
+	URLScheme           Tag = "urlscheme"
+	DateTime            Tag = "datetime"
+	Before              Tag = "before"
+	After               Tag = "after"
+	Future              Tag = "future"
+	Past                Tag = "past"
+	Weekday             Tag = "weekday"
+	Timezone            Tag = "timezone"
+	Duration            Tag = "duration"
+	MinDuration         Tag = "minduration"
+	MaxDuration         Tag = "maxduration"
+	DurationBetween     Tag = "durationbetween"
+	CreditCard          Tag = "creditcard"
+	CardBrand           Tag = "cardbrand"
+	CardExpiry          Tag = "cardexpiry"
+	CardExpYear         Tag = "cardexpyear"
+	CardExpMonth        Tag = "cardexpmonth"
+	IBAN                Tag = "iban"
+	IBANCountry         Tag = "ibancountry"
+	BICIBAN             Tag = "biciban"
+	Country2            Tag = "country2"
+	Country3            Tag = "country3"
+	CountryNum          Tag = "countrynum"
+	Currency            Tag = "currency"
+	Language            Tag = "language"
+	BCP47               Tag = "bcp47"
+	Subdivision         Tag = "subdivision"
+	Decimals            Tag = "decimals"
+	Postcode            Tag = "postcode"
+	Phone               Tag = "phone"
+	NationalID          Tag = "nationalid"
+	Password            Tag = "password"
+	JSON                Tag = "json"
+	JSONObject          Tag = "jsonobject"
+	JSONArray           Tag = "jsonarray"
+	XML                 Tag = "xml"
+	YAML                Tag = "yaml"
+	JSONField           Tag = "jsonfield"
+	HTMLPolicy          Tag = "htmlpolicy"
+	EmailDomain         Tag = "emaildomain"
+	RegistrableDomain   Tag = "registrabledomain"
+	SemVerRange         Tag = "semverrange"
+	SemVerGT            Tag = "semvergt"
+	SemVerGTE           Tag = "semvergte"
+	SemVerLT            Tag = "semverlt"
+	SemVerLTE           Tag = "semverlte"
+	UUID1               Tag = "uuid1"
+	UUID6               Tag = "uuid6"
+	UUID7               Tag = "uuid7"
+	UUID8               Tag = "uuid8"
+	UUIDNil             Tag = "uuidnil"
+	UUIDMax             Tag = "uuidmax"
+	UUIDTime            Tag = "uuidtime"
+	ULIDTime            Tag = "ulidtime"
+	Base64Decoded       Tag = "base64decoded"
+	Base32Decoded       Tag = "base32decoded"
+	DataURIDecoded      Tag = "datauridecoded"
+	MimeType            Tag = "mimetype"
+	FileSize            Tag = "filesize"
+	ImageDims           Tag = "imagedims"
+	AspectRatio         Tag = "aspectratio"
+	MultipleOf          Tag = "multipleof"
+	Precision           Tag = "precision"
+	Positive            Tag = "positive"
+	Negative            Tag = "negative"
+	NonZero             Tag = "nonzero"
+	Finite              Tag = "finite"
+	Integer             Tag = "integer"
+	Step                Tag = "step"
+	LatLng              Tag = "latlng"
+	InBBox              Tag = "inbbox"
+	InPolygon           Tag = "inpolygon"
+	WithinKm            Tag = "withinkm"
 )
@@ -0,0 +1,121 @@
+// Package uuid parses UUIDs and inspects their version, variant and embedded timestamp as defined by RFC 9562,
+// which obsoletes RFC 4122 and adds versions 6, 7 and 8 as well as the Max UUID.
+package uuid
+
+import (
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// UUID is a 128-bit universally unique identifier.
+type UUID [16]byte
+
+// Variant is the layout of a UUID, encoded in the most significant bits of octet 8.
+type Variant int
+
+const (
+	VariantNCS       Variant = iota // 0xx: reserved, Network Computing System backward compatibility
+	VariantRFC9562                  // 10x: the layout defined by RFC 9562 (and RFC 4122)
+	VariantMicrosoft                // 110: reserved, Microsoft Corporation backward compatibility
+	VariantFuture                   // 111: reserved for future definition
+)
+
+var (
+	// Nil is the UUID with all bits set to zero.
+	Nil = UUID{}
+	// Max is the UUID with all bits set to one.
+	Max = UUID{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+)
+
+// gregorianOffset is the number of 100-nanosecond intervals between the start of the Gregorian calendar
+// (1582-10-15, the epoch of version 1 and 6 timestamps) and the Unix epoch.
+const gregorianOffset = 122192928000000000
+
+// Parse parses a UUID in its canonical textual form of 32 hexadecimal digits grouped 8-4-4-4-12,
+// such as "f81d4fae-7dec-11d0-a765-00a0c91e6bf6". Upper and lower case digits are accepted.
+func Parse(text string) (UUID, error) {
+	var u UUID
+	if len(text) != 36 {
+		return u, fmt.Errorf("UUID must be 36 characters long, got %d", len(text))
+	}
+
+	j := 0
+	for i := 0; i < 36; i += 2 {
+		if i == 8 || i == 13 || i == 18 || i == 23 {
+			if text[i] != '-' {
+				return u, fmt.Errorf("expected a hyphen at position %d, got %q", i+1, text[i])
+			}
+			i++
+		}
+		if _, err := hex.Decode(u[j:j+1], []byte(text[i:i+2])); err != nil {
+			return u, fmt.Errorf("invalid hexadecimal digits %q at position %d", text[i:i+2], i+1)
+		}
+		j++
+	}
+
+	return u, nil
+}
+
+// String returns the UUID in its canonical lower case form.
+func (u UUID) String() string {
+	buf := make([]byte, 36)
+	hex.Encode(buf[0:8], u[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], u[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], u[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], u[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], u[10:])
+	return string(buf)
+}
+
+// Version returns the version number in the most significant bits of octet 6.
+// It is only meaningful for UUIDs of the RFC 9562 variant.
+func (u UUID) Version() int {
+	return int(u[6] >> 4)
+}
+
+// Variant returns the variant of the UUID.
+func (u UUID) Variant() Variant {
+	switch {
+	case u[8]&0x80 == 0:
+		return VariantNCS
+	case u[8]&0xc0 == 0x80:
+		return VariantRFC9562
+	case u[8]&0xe0 == 0xc0:
+		return VariantMicrosoft
+	}
+	return VariantFuture
+}
+
+// Time returns the timestamp embedded in a version 1, 6 or 7 UUID, in UTC.
+// Versions 1 and 6 have a precision of 100 nanoseconds, version 7 of one millisecond.
+// It reports false for other versions and variants, which carry no timestamp.
+func (u UUID) Time() (time.Time, bool) {
+	if u.Variant() != VariantRFC9562 {
+		return time.Time{}, false
+	}
+
+	var ticks uint64
+	switch u.Version() {
+	case 1:
+		// time_low, time_mid and time_hi, from least to most significant
+		ticks = uint64(u[6]&0x0f)<<56 | uint64(u[7])<<48 | uint64(u[4])<<40 | uint64(u[5])<<32 |
+			uint64(u[0])<<24 | uint64(u[1])<<16 | uint64(u[2])<<8 | uint64(u[3])
+	case 6:
+		// time_high, time_mid and time_low, from most to least significant
+		ticks = uint64(u[0])<<52 | uint64(u[1])<<44 | uint64(u[2])<<36 | uint64(u[3])<<28 |
+			uint64(u[4])<<20 | uint64(u[5])<<12 | uint64(u[6]&0x0f)<<8 | uint64(u[7])
+	case 7:
+		ms := int64(u[0])<<40 | int64(u[1])<<32 | int64(u[2])<<24 | int64(u[3])<<16 | int64(u[4])<<8 | int64(u[5])
+		return time.UnixMilli(ms).UTC(), true
+	default:
+		return time.Time{}, false
+	}
+
+	unix := int64(ticks) - gregorianOffset
+	return time.Unix(unix/1e7, unix%1e7*100).UTC(), true
+}
@@ -0,0 +1,86 @@
+package uuid
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("Canonical form", func(t *testing.T) {
+		u, err := Parse("F81D4FAE-7DEC-11D0-A765-00A0C91E6BF6")
+		assert.NoError(t, err)
+		assert.Equal(t, "f81d4fae-7dec-11d0-a765-00a0c91e6bf6", u.String())
+		assert.Equal(t, 1, u.Version())
+		assert.Equal(t, VariantRFC9562, u.Variant())
+	})
+
+	t.Run("Nil and Max", func(t *testing.T) {
+		u, err := Parse("00000000-0000-0000-0000-000000000000")
+		assert.NoError(t, err)
+		assert.Equal(t, Nil, u)
+
+		u, err = Parse("FFFFFFFF-FFFF-FFFF-FFFF-FFFFFFFFFFFF")
+		assert.NoError(t, err)
+		assert.Equal(t, Max, u)
+	})
+
+	t.Run("Invalid forms", func(t *testing.T) {
+		for text, reason := range map[string]string{
+			"":                                       "UUID must be 36 characters long, got 0",
+			"f81d4fae7dec11d0a76500a0c91e6bf6":       "UUID must be 36 characters long, got 32",
+			"f81d4fae-7dec-11d0-a765_00a0c91e6bf6":   `expected a hyphen at position 24, got '_'`,
+			"f81d4fae-7dec-11d0-a765-00a0c91e6bfg":   `invalid hexadecimal digits "fg" at position 35`,
+			"{f81d4fae-7dec-11d0-a765-00a0c91e6bf6}": "UUID must be 36 characters long, got 38",
+		} {
+			_, err := Parse(text)
+			if assert.Error(t, err, text) {
+				assert.Equal(t, reason, err.Error(), text)
+			}
+		}
+	})
+}
+
+func TestVariant(t *testing.T) {
+	for text, variant := range map[string]Variant{
+		"f81d4fae-7dec-11d0-0765-00a0c91e6bf6": VariantNCS,
+		"f81d4fae-7dec-11d0-a765-00a0c91e6bf6": VariantRFC9562,
+		"f81d4fae-7dec-11d0-c765-00a0c91e6bf6": VariantMicrosoft,
+		"f81d4fae-7dec-11d0-e765-00a0c91e6bf6": VariantFuture,
+	} {
+		u, err := Parse(text)
+		assert.NoError(t, err)
+		assert.Equal(t, variant, u.Variant(), text)
+	}
+}
+
+func TestTime(t *testing.T) {
+	// Test vectors from RFC 9562 appendix A: Tuesday, February 22, 2022 2:22:22 PM GMT-05:00
+	want := time.Date(2022, 2, 22, 19, 22, 22, 0, time.UTC)
+
+	for _, text := range []string{
+		"C232AB00-9414-11EC-B3C8-9F6BDECED846", // version 1
+		"1EC9414C-232A-6B00-B3C8-9F6BDECED846", // version 6
+		"017F22E2-79B0-7CC3-98C4-DC0C0C07398F", // version 7
+	} {
+		u, err := Parse(text)
+		assert.NoError(t, err)
+		got, ok := u.Time()
+		assert.True(t, ok, text)
+		assert.Equal(t, want, got, text)
+	}
+
+	t.Run("No timestamp", func(t *testing.T) {
+		for _, text := range []string{
+			"919108f7-52d1-4320-9bac-f847db4148a8", // version 4
+			"00000000-0000-0000-0000-000000000000", // Nil
+			"017f22e2-79b0-7cc3-18c4-dc0c0c07398f", // version 7 digit with the NCS variant
+		} {
+			u, err := Parse(text)
+			assert.NoError(t, err)
+			_, ok := u.Time()
+			assert.False(t, ok, text)
+		}
+	})
+}
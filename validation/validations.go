@@ -4,9 +4,11 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"go-runtimevalidation/args"
+	"go-runtimevalidation/regex"
 	"go-runtimevalidation/rules"
 	"go-runtimevalidation/tags"
 )
@@ -117,12 +119,12 @@ func (rules ValidationRules) Validate(input any, parent any) ValidationErrors {
 func Parse(rulestext string) (ValidationRules, error) {
 	groupedRules := make(ValidationRules)
 
-	groups := strings.Split(rulestext, "&&") // Split the rules by AND operator
-	for i, group := range groups {           // Parse each group of rules
+	groups := splitOutsideQuotes(rulestext, "&&") // Split the rules by AND operator
+	for i, group := range groups {                // Parse each group of rules
 
 		parsed := make([]ValidationRule, 0)
 
-		rules := strings.Split(group, "||")
+		rules := splitOutsideQuotes(group, "||")
 		for _, rule := range rules {
 			parsedrule := parseRule(rule, i)
 			parsed = append(parsed, *parsedrule)
@@ -152,11 +154,36 @@ func Parse(rulestext string) (ValidationRules, error) {
 	return groupedRules, nil
 }
 
+// splitOutsideQuotes splits text around each separator that is not inside a double-quoted string,
+// so that quoted arguments such as semverrange:"^1.0.0 || ^2.0.0" are kept in one piece.
+func splitOutsideQuotes(text string, sep string) []string {
+	var parts []string
+	start, inQuotes := 0, false
+	for i := 0; i < len(text); i++ {
+		switch {
+		case text[i] == '"':
+			inQuotes = !inQuotes
+		case !inQuotes && strings.HasPrefix(text[i:], sep):
+			parts = append(parts, text[start:i])
+			start = i + len(sep)
+			i += len(sep) - 1
+		}
+	}
+	return append(parts, text[start:])
+}
+
 func parseRule(text string, group int) *ValidationRule {
 	if text == "" {
 		return BadValidationRule(string(tags.Unknown), text, group, fmt.Errorf("empty rule"))
 	}
 
+	// Decoded content rules may carry a nested rule in parentheses, which can contain colons itself
+	name, _, _ := strings.Cut(text, ":")
+	name, _, _ = strings.Cut(name, "(")
+	if tag := tags.Tag(strings.ToLower(strings.TrimSpace(name))); decodedRules[tag] != nil {
+		return parseDecodedRule(tag, text, group)
+	}
+
 	parts := strings.Split(text, ":")
 	rulename := strings.ToLower(strings.TrimSpace(parts[0]))
 	// args string is the rest of the rule text after the rule name and first colon
@@ -406,7 +433,199 @@ func parseRule(text string, group int) *ValidationRule {
 			return NewValidationRule(string(tags.Cron), text, group, func(field any, object any) error {
 				return rules.Cron(field)
 			})
-		case tags.Regex, tags.RequiredIf, tags.Between, tags.XBetween, tags.BetweenF, tags.XBetweenF, tags.OneOf, tags.Min, tags.Max, tags.Length, tags.StartsWith, tags.StartsNotWith, tags.EndsWith, tags.EndsNotWith, tags.Contains, tags.ContainsNot:
+		case tags.IP:
+			return NewValidationRule(string(tags.IP), text, group, func(field any, object any) error {
+				return rules.IP(field)
+			})
+		case tags.IPv4:
+			return NewValidationRule(string(tags.IPv4), text, group, func(field any, object any) error {
+				return rules.IPv4(field)
+			})
+		case tags.IPv6:
+			return NewValidationRule(string(tags.IPv6), text, group, func(field any, object any) error {
+				return rules.IPv6(field)
+			})
+		case tags.CIDR:
+			return NewValidationRule(string(tags.CIDR), text, group, func(field any, object any) error {
+				return rules.CIDR(field)
+			})
+		case tags.CIDRv4:
+			return NewValidationRule(string(tags.CIDRv4), text, group, func(field any, object any) error {
+				return rules.CIDRv4(field)
+			})
+		case tags.CIDRv6:
+			return NewValidationRule(string(tags.CIDRv6), text, group, func(field any, object any) error {
+				return rules.CIDRv6(field)
+			})
+		case tags.MAC:
+			return NewValidationRule(string(tags.MAC), text, group, func(field any, object any) error {
+				return rules.MAC(field)
+			})
+		case tags.Port:
+			return NewValidationRule(string(tags.Port), text, group, func(field any, object any) error {
+				return rules.Port(field)
+			})
+		case tags.HostPort:
+			return NewValidationRule(string(tags.HostPort), text, group, func(field any, object any) error {
+				return rules.HostPort(field)
+			})
+		case tags.URL:
+			return NewValidationRule(string(tags.URL), text, group, func(field any, object any) error {
+				return rules.URL(field)
+			})
+		case tags.URI:
+			return NewValidationRule(string(tags.URI), text, group, func(field any, object any) error {
+				return rules.URI(field)
+			})
+		case tags.HTTPURL:
+			return NewValidationRule(string(tags.HTTPURL), text, group, func(field any, object any) error {
+				return rules.HTTPURL(field)
+			})
+		case tags.Future:
+			return NewValidationRule(string(tags.Future), text, group, func(field any, object any) error {
+				return rules.Future(field)
+			})
+		case tags.Past:
+			return NewValidationRule(string(tags.Past), text, group, func(field any, object any) error {
+				return rules.Past(field)
+			})
+		case tags.Timezone:
+			return NewValidationRule(string(tags.Timezone), text, group, func(field any, object any) error {
+				return rules.Timezone(field)
+			})
+		case tags.Duration:
+			return NewValidationRule(string(tags.Duration), text, group, func(field any, object any) error {
+				return rules.Duration(field)
+			})
+		case tags.Weekday: // Without arguments, weekday allows Monday to Friday
+			return NewValidationRule(string(tags.Weekday), text, group, func(field any, object any) error {
+				return rules.Weekday(field, object, nil)
+			})
+		case tags.CreditCard:
+			return NewValidationRule(string(tags.CreditCard), text, group, func(field any, object any) error {
+				return rules.CreditCard(field)
+			})
+		case tags.CardExpiry:
+			return NewValidationRule(string(tags.CardExpiry), text, group, func(field any, object any) error {
+				return rules.CardExpiry(field)
+			})
+		case tags.CardExpYear:
+			return NewValidationRule(string(tags.CardExpYear), text, group, func(field any, object any) error {
+				return rules.CardExpYear(field)
+			})
+		case tags.IBAN:
+			return NewValidationRule(string(tags.IBAN), text, group, func(field any, object any) error {
+				return rules.IBAN(field)
+			})
+		case tags.Country2:
+			return NewValidationRule(string(tags.Country2), text, group, func(field any, object any) error {
+				return rules.Country2(field)
+			})
+		case tags.Country3:
+			return NewValidationRule(string(tags.Country3), text, group, func(field any, object any) error {
+				return rules.Country3(field)
+			})
+		case tags.CountryNum:
+			return NewValidationRule(string(tags.CountryNum), text, group, func(field any, object any) error {
+				return rules.CountryNum(field)
+			})
+		case tags.Currency:
+			return NewValidationRule(string(tags.Currency), text, group, func(field any, object any) error {
+				return rules.Currency(field)
+			})
+		case tags.Language:
+			return NewValidationRule(string(tags.Language), text, group, func(field any, object any) error {
+				return rules.Language(field)
+			})
+		case tags.BCP47:
+			return NewValidationRule(string(tags.BCP47), text, group, func(field any, object any) error {
+				return rules.BCP47(field)
+			})
+		case tags.Phone: // Without arguments, phone accepts numbers in international form only
+			return NewValidationRule(string(tags.Phone), text, group, func(field any, object any) error {
+				return rules.Phone(field, object, nil)
+			})
+		case tags.Subdivision: // Without arguments, subdivision accepts any country
+			return NewValidationRule(string(tags.Subdivision), text, group, func(field any, object any) error {
+				return rules.Subdivision(field, object, nil)
+			})
+		case tags.Password: // Without arguments, password requires at least 8 characters and rejects common passwords
+			return NewValidationRule(string(tags.Password), text, group, func(field any, object any) error {
+				return rules.Password(field, object, nil)
+			})
+		case tags.JSON:
+			return NewValidationRule(string(tags.JSON), text, group, func(field any, object any) error {
+				return rules.JSON(field)
+			})
+		case tags.JSONObject:
+			return NewValidationRule(string(tags.JSONObject), text, group, func(field any, object any) error {
+				return rules.JSONObject(field)
+			})
+		case tags.JSONArray:
+			return NewValidationRule(string(tags.JSONArray), text, group, func(field any, object any) error {
+				return rules.JSONArray(field)
+			})
+		case tags.XML:
+			return NewValidationRule(string(tags.XML), text, group, func(field any, object any) error {
+				return rules.XML(field)
+			})
+		case tags.YAML:
+			return NewValidationRule(string(tags.YAML), text, group, func(field any, object any) error {
+				return rules.YAML(field)
+			})
+		case tags.RegistrableDomain:
+			return NewValidationRule(string(tags.RegistrableDomain), text, group, func(field any, object any) error {
+				return rules.RegistrableDomain(field)
+			})
+		case tags.UUID1:
+			return NewValidationRule(string(tags.UUID1), text, group, func(field any, object any) error {
+				return rules.UUID1(field)
+			})
+		case tags.UUID6:
+			return NewValidationRule(string(tags.UUID6), text, group, func(field any, object any) error {
+				return rules.UUID6(field)
+			})
+		case tags.UUID7:
+			return NewValidationRule(string(tags.UUID7), text, group, func(field any, object any) error {
+				return rules.UUID7(field)
+			})
+		case tags.UUID8:
+			return NewValidationRule(string(tags.UUID8), text, group, func(field any, object any) error {
+				return rules.UUID8(field)
+			})
+		case tags.UUIDNil:
+			return NewValidationRule(string(tags.UUIDNil), text, group, func(field any, object any) error {
+				return rules.UUIDNil(field)
+			})
+		case tags.UUIDMax:
+			return NewValidationRule(string(tags.UUIDMax), text, group, func(field any, object any) error {
+				return rules.UUIDMax(field)
+			})
+		case tags.Positive:
+			return NewValidationRule(string(tags.Positive), text, group, func(field any, object any) error {
+				return rules.Positive(field)
+			})
+		case tags.Negative:
+			return NewValidationRule(string(tags.Negative), text, group, func(field any, object any) error {
+				return rules.Negative(field)
+			})
+		case tags.NonZero:
+			return NewValidationRule(string(tags.NonZero), text, group, func(field any, object any) error {
+				return rules.NonZero(field)
+			})
+		case tags.Finite:
+			return NewValidationRule(string(tags.Finite), text, group, func(field any, object any) error {
+				return rules.Finite(field)
+			})
+		case tags.Integer:
+			return NewValidationRule(string(tags.Integer), text, group, func(field any, object any) error {
+				return rules.Integer(field)
+			})
+		case tags.LatLng:
+			return NewValidationRule(string(tags.LatLng), text, group, func(field any, object any) error {
+				return rules.LatLng(field)
+			})
+		case tags.Regex, tags.NotRegex, tags.RequiredIf, tags.Between, tags.XBetween, tags.BetweenF, tags.XBetweenF, tags.OneOf, tags.Min, tags.Max, tags.Length, tags.MinLen, tags.MaxLen, tags.LenBetween, tags.StartsWith, tags.StartsNotWith, tags.EndsWith, tags.EndsNotWith, tags.Contains, tags.ContainsNot, tags.IPIn, tags.URLScheme, tags.DateTime, tags.Before, tags.After, tags.MinDuration, tags.MaxDuration, tags.DurationBetween, tags.CardBrand, tags.CardExpMonth, tags.IBANCountry, tags.BICIBAN, tags.Decimals, tags.Postcode, tags.NationalID, tags.JSONField, tags.HTMLPolicy, tags.EmailDomain, tags.SemVerRange, tags.SemVerGT, tags.SemVerGTE, tags.SemVerLT, tags.SemVerLTE, tags.UUIDTime, tags.ULIDTime, tags.MimeType, tags.FileSize, tags.ImageDims, tags.AspectRatio, tags.MultipleOf, tags.Precision, tags.Step, tags.InBBox, tags.InPolygon, tags.WithinKm:
 			return BadValidationRule(rulename, text, group, fmt.Errorf("missing arguments for rule: %s", text))
 		default:
 			return BadValidationRule(string(tags.Unknown), text, group, fmt.Errorf("unknown rule: %s", text))
@@ -414,13 +633,10 @@ func parseRule(text string, group int) *ValidationRule {
 	} else { // Rule has arguments, we do this for faster lookup of the correct rule, eliminating the need to switch on rules which we know have no arguments
 		ruleargs, err := args.ParseArgs(argsStr)
 		switch tags.Tag(rulename) {
-		case tags.Regex:
-			if err != nil {
-				return BadValidationRule(string(tags.Regex), text, group, err)
-			}
-			return NewValidationRule(string(tags.Regex), text, group, func(field any, object any) error {
-				return rules.Regex(field, object, ruleargs)
-			})
+		case tags.Regex, tags.NotRegex:
+			return parseRegexRule(tags.Tag(rulename), text, argsStr, group)
+		case tags.JSONField:
+			return parseJSONFieldRule(text, argsStr, group)
 		case tags.RequiredIf:
 			if err != nil {
 				return BadValidationRule(string(tags.RequiredIf), text, group, err)
@@ -429,32 +645,40 @@ func parseRule(text string, group int) *ValidationRule {
 				return rules.RequiredIf(field, object, ruleargs)
 			})
 		case tags.Between:
+			// The bounds are positional, so equal bounds must not collapse into one argument
+			orderedargs, err := args.ParseArgList(argsStr)
 			if err != nil {
 				return BadValidationRule(string(tags.Between), text, group, err)
 			}
 			return NewValidationRule(string(tags.Between), text, group, func(field any, object any) error {
-				return rules.Between(field, object, ruleargs)
+				return rules.BetweenList(field, object, orderedargs)
 			})
 		case tags.XBetween:
+			// The bounds are positional, so equal bounds must not collapse into one argument
+			orderedargs, err := args.ParseArgList(argsStr)
 			if err != nil {
 				return BadValidationRule(string(tags.XBetween), text, group, err)
 			}
 			return NewValidationRule(string(tags.XBetween), text, group, func(field any, object any) error {
-				return rules.XBetween(field, object, ruleargs)
+				return rules.XBetweenList(field, object, orderedargs)
 			})
 		case tags.BetweenF:
+			// The bounds are positional, so equal bounds must not collapse into one argument
+			orderedargs, err := args.ParseArgList(argsStr)
 			if err != nil {
 				return BadValidationRule(string(tags.BetweenF), text, group, err)
 			}
 			return NewValidationRule(string(tags.BetweenF), text, group, func(field any, object any) error {
-				return rules.BetweenF(field, object, ruleargs)
+				return rules.BetweenFList(field, object, orderedargs)
 			})
 		case tags.XBetweenF:
+			// The bounds are positional, so equal bounds must not collapse into one argument
+			orderedargs, err := args.ParseArgList(argsStr)
 			if err != nil {
 				return BadValidationRule(string(tags.XBetweenF), text, group, err)
 			}
 			return NewValidationRule(string(tags.XBetweenF), text, group, func(field any, object any) error {
-				return rules.XBetweenF(field, object, ruleargs)
+				return rules.XBetweenFList(field, object, orderedargs)
 			})
 		case tags.OneOf:
 			if err != nil {
@@ -526,10 +750,465 @@ func parseRule(text string, group int) *ValidationRule {
 			return NewValidationRule(string(tags.Length), text, group, func(field any, object any) error {
 				return rules.Length(field, object, ruleargs)
 			})
-		case tags.Required, tags.Alpha, tags.AlphaNumeric, tags.AlphaUnicode, tags.AlphaNumericUnicode, tags.Numeric, tags.NumericUnsigned, tags.Hexadecimal, tags.HexColor, tags.RGB, tags.RGBA, tags.HSL, tags.HSLA, tags.Email, tags.ISSN, tags.E164, tags.Base32, tags.Base32Hex, tags.Base64, tags.Base64Raw, tags.Base64URL, tags.Base64RawURL, tags.Isbn10, tags.Isbn13, tags.SSN, tags.UUID, tags.UUID3, tags.UUID4, tags.UUID5, tags.ULID, tags.MD4, tags.MD5, tags.SHA, tags.SHA0, tags.SHA1, tags.SHA2, tags.SHA3, tags.SHA224, tags.SHA256, tags.SHA384, tags.SHA512, tags.ASCII, tags.PrintableASCII, tags.MultiByte, tags.Uppercase, tags.Lowercase, tags.DataURI, tags.Latitude, tags.Longitude, tags.Hostname, tags.Fqdn, tags.UrlEncoded, tags.HTML, tags.HTMLEncoded, tags.JWT, tags.BIC, tags.SemVer, tags.DNS, tags.CVE, tags.Cron:
+		case tags.MinLen:
+			if err != nil {
+				return BadValidationRule(string(tags.MinLen), text, group, err)
+			}
+			return NewValidationRule(string(tags.MinLen), text, group, func(field any, object any) error {
+				return rules.MinLen(field, object, ruleargs)
+			})
+		case tags.MaxLen:
+			if err != nil {
+				return BadValidationRule(string(tags.MaxLen), text, group, err)
+			}
+			return NewValidationRule(string(tags.MaxLen), text, group, func(field any, object any) error {
+				return rules.MaxLen(field, object, ruleargs)
+			})
+		case tags.LenBetween:
+			// The bounds are positional, so equal bounds must not collapse into one argument
+			orderedargs, err := args.ParseArgList(argsStr)
+			if err != nil {
+				return BadValidationRule(string(tags.LenBetween), text, group, err)
+			}
+			return NewValidationRule(string(tags.LenBetween), text, group, func(field any, object any) error {
+				return rules.LenBetween(field, object, orderedargs)
+			})
+		case tags.IPIn:
+			if err != nil {
+				return BadValidationRule(string(tags.IPIn), text, group, err)
+			}
+			return NewValidationRule(string(tags.IPIn), text, group, func(field any, object any) error {
+				return rules.IPIn(field, object, ruleargs)
+			})
+		case tags.URLScheme:
+			if err != nil {
+				return BadValidationRule(string(tags.URLScheme), text, group, err)
+			}
+			return NewValidationRule(string(tags.URLScheme), text, group, func(field any, object any) error {
+				return rules.URLScheme(field, object, ruleargs)
+			})
+		case tags.DateTime:
+			if err != nil {
+				return BadValidationRule(string(tags.DateTime), text, group, err)
+			}
+			return NewValidationRule(string(tags.DateTime), text, group, func(field any, object any) error {
+				return rules.DateTime(field, object, ruleargs)
+			})
+		case tags.Before:
+			if err != nil {
+				return BadValidationRule(string(tags.Before), text, group, err)
+			}
+			return NewValidationRule(string(tags.Before), text, group, func(field any, object any) error {
+				return rules.Before(field, object, ruleargs)
+			})
+		case tags.After:
+			if err != nil {
+				return BadValidationRule(string(tags.After), text, group, err)
+			}
+			return NewValidationRule(string(tags.After), text, group, func(field any, object any) error {
+				return rules.After(field, object, ruleargs)
+			})
+		case tags.Weekday:
+			if err != nil {
+				return BadValidationRule(string(tags.Weekday), text, group, err)
+			}
+			return NewValidationRule(string(tags.Weekday), text, group, func(field any, object any) error {
+				return rules.Weekday(field, object, ruleargs)
+			})
+		case tags.MinDuration:
+			if err != nil {
+				return BadValidationRule(string(tags.MinDuration), text, group, err)
+			}
+			return NewValidationRule(string(tags.MinDuration), text, group, func(field any, object any) error {
+				return rules.MinDuration(field, object, ruleargs)
+			})
+		case tags.MaxDuration:
+			if err != nil {
+				return BadValidationRule(string(tags.MaxDuration), text, group, err)
+			}
+			return NewValidationRule(string(tags.MaxDuration), text, group, func(field any, object any) error {
+				return rules.MaxDuration(field, object, ruleargs)
+			})
+		case tags.DurationBetween:
+			// The bounds are positional, so equal bounds must not collapse into one argument
+			orderedargs, err := args.ParseArgList(argsStr)
+			if err != nil {
+				return BadValidationRule(string(tags.DurationBetween), text, group, err)
+			}
+			return NewValidationRule(string(tags.DurationBetween), text, group, func(field any, object any) error {
+				return rules.DurationBetween(field, object, orderedargs)
+			})
+		case tags.CardBrand:
+			if err != nil {
+				return BadValidationRule(string(tags.CardBrand), text, group, err)
+			}
+			return NewValidationRule(string(tags.CardBrand), text, group, func(field any, object any) error {
+				return rules.CardBrand(field, object, ruleargs)
+			})
+		case tags.CardExpMonth:
+			if err != nil {
+				return BadValidationRule(string(tags.CardExpMonth), text, group, err)
+			}
+			return NewValidationRule(string(tags.CardExpMonth), text, group, func(field any, object any) error {
+				return rules.CardExpMonth(field, object, ruleargs)
+			})
+		case tags.IBANCountry:
+			if err != nil {
+				return BadValidationRule(string(tags.IBANCountry), text, group, err)
+			}
+			return NewValidationRule(string(tags.IBANCountry), text, group, func(field any, object any) error {
+				return rules.IBANCountry(field, object, ruleargs)
+			})
+		case tags.BICIBAN:
+			if err != nil {
+				return BadValidationRule(string(tags.BICIBAN), text, group, err)
+			}
+			return NewValidationRule(string(tags.BICIBAN), text, group, func(field any, object any) error {
+				return rules.BICIBAN(field, object, ruleargs)
+			})
+		case tags.Subdivision:
+			if err != nil {
+				return BadValidationRule(string(tags.Subdivision), text, group, err)
+			}
+			return NewValidationRule(string(tags.Subdivision), text, group, func(field any, object any) error {
+				return rules.Subdivision(field, object, ruleargs)
+			})
+		case tags.Decimals:
+			if err != nil {
+				return BadValidationRule(string(tags.Decimals), text, group, err)
+			}
+			return NewValidationRule(string(tags.Decimals), text, group, func(field any, object any) error {
+				return rules.Decimals(field, object, ruleargs)
+			})
+		case tags.Postcode:
+			if err != nil {
+				return BadValidationRule(string(tags.Postcode), text, group, err)
+			}
+			return NewValidationRule(string(tags.Postcode), text, group, func(field any, object any) error {
+				return rules.Postcode(field, object, ruleargs)
+			})
+		case tags.Phone:
+			if err != nil {
+				return BadValidationRule(string(tags.Phone), text, group, err)
+			}
+			return NewValidationRule(string(tags.Phone), text, group, func(field any, object any) error {
+				return rules.Phone(field, object, ruleargs)
+			})
+		case tags.NationalID:
+			if err != nil {
+				return BadValidationRule(string(tags.NationalID), text, group, err)
+			}
+			return NewValidationRule(string(tags.NationalID), text, group, func(field any, object any) error {
+				return rules.NationalID(field, object, ruleargs)
+			})
+		case tags.Password:
+			if err != nil {
+				return BadValidationRule(string(tags.Password), text, group, err)
+			}
+			return NewValidationRule(string(tags.Password), text, group, func(field any, object any) error {
+				return rules.Password(field, object, ruleargs)
+			})
+		case tags.JWT:
+			if err != nil {
+				return BadValidationRule(string(tags.JWT), text, group, err)
+			}
+			return NewValidationRule(string(tags.JWT), text, group, func(field any, object any) error {
+				return rules.JWTClaims(field, object, ruleargs)
+			})
+		case tags.HTMLPolicy:
+			if err != nil {
+				return BadValidationRule(string(tags.HTMLPolicy), text, group, err)
+			}
+			return NewValidationRule(string(tags.HTMLPolicy), text, group, func(field any, object any) error {
+				return rules.HTMLPolicy(field, object, ruleargs)
+			})
+		case tags.EmailDomain:
+			if err != nil {
+				return BadValidationRule(string(tags.EmailDomain), text, group, err)
+			}
+			return NewValidationRule(string(tags.EmailDomain), text, group, func(field any, object any) error {
+				return rules.EmailDomain(field, object, ruleargs)
+			})
+		case tags.Cron:
+			if err != nil {
+				return BadValidationRule(string(tags.Cron), text, group, err)
+			}
+			return NewValidationRule(string(tags.Cron), text, group, func(field any, object any) error {
+				return rules.CronSchedule(field, object, ruleargs)
+			})
+		case tags.Email:
+			if err != nil {
+				return BadValidationRule(string(tags.Email), text, group, err)
+			}
+			return NewValidationRule(string(tags.Email), text, group, func(field any, object any) error {
+				return rules.EmailAddress(field, object, ruleargs)
+			})
+		case tags.RegistrableDomain:
+			if err != nil {
+				return BadValidationRule(string(tags.RegistrableDomain), text, group, err)
+			}
+			return NewValidationRule(string(tags.RegistrableDomain), text, group, func(field any, object any) error {
+				return rules.SameRegistrableDomain(field, object, ruleargs)
+			})
+		case tags.SemVerRange:
+			if err != nil {
+				return BadValidationRule(string(tags.SemVerRange), text, group, err)
+			}
+			return NewValidationRule(string(tags.SemVerRange), text, group, func(field any, object any) error {
+				return rules.SemVerRange(field, object, ruleargs)
+			})
+		case tags.SemVerGT:
+			if err != nil {
+				return BadValidationRule(string(tags.SemVerGT), text, group, err)
+			}
+			return NewValidationRule(string(tags.SemVerGT), text, group, func(field any, object any) error {
+				return rules.SemVerGT(field, object, ruleargs)
+			})
+		case tags.SemVerGTE:
+			if err != nil {
+				return BadValidationRule(string(tags.SemVerGTE), text, group, err)
+			}
+			return NewValidationRule(string(tags.SemVerGTE), text, group, func(field any, object any) error {
+				return rules.SemVerGTE(field, object, ruleargs)
+			})
+		case tags.SemVerLT:
+			if err != nil {
+				return BadValidationRule(string(tags.SemVerLT), text, group, err)
+			}
+			return NewValidationRule(string(tags.SemVerLT), text, group, func(field any, object any) error {
+				return rules.SemVerLT(field, object, ruleargs)
+			})
+		case tags.SemVerLTE:
+			if err != nil {
+				return BadValidationRule(string(tags.SemVerLTE), text, group, err)
+			}
+			return NewValidationRule(string(tags.SemVerLTE), text, group, func(field any, object any) error {
+				return rules.SemVerLTE(field, object, ruleargs)
+			})
+		case tags.UUIDTime:
+			if err != nil {
+				return BadValidationRule(string(tags.UUIDTime), text, group, err)
+			}
+			return NewValidationRule(string(tags.UUIDTime), text, group, func(field any, object any) error {
+				return rules.UUIDTime(field, object, ruleargs)
+			})
+		case tags.ULIDTime:
+			if err != nil {
+				return BadValidationRule(string(tags.ULIDTime), text, group, err)
+			}
+			return NewValidationRule(string(tags.ULIDTime), text, group, func(field any, object any) error {
+				return rules.ULIDTime(field, object, ruleargs)
+			})
+		case tags.MimeType:
+			if err != nil {
+				return BadValidationRule(string(tags.MimeType), text, group, err)
+			}
+			return NewValidationRule(string(tags.MimeType), text, group, func(field any, object any) error {
+				return rules.MimeType(field, object, ruleargs)
+			})
+		case tags.FileSize:
+			if err != nil {
+				return BadValidationRule(string(tags.FileSize), text, group, err)
+			}
+			return NewValidationRule(string(tags.FileSize), text, group, func(field any, object any) error {
+				return rules.FileSize(field, object, ruleargs)
+			})
+		case tags.ImageDims:
+			if err != nil {
+				return BadValidationRule(string(tags.ImageDims), text, group, err)
+			}
+			return NewValidationRule(string(tags.ImageDims), text, group, func(field any, object any) error {
+				return rules.ImageDims(field, object, ruleargs)
+			})
+		case tags.AspectRatio:
+			if err != nil {
+				return BadValidationRule(string(tags.AspectRatio), text, group, err)
+			}
+			return NewValidationRule(string(tags.AspectRatio), text, group, func(field any, object any) error {
+				return rules.AspectRatio(field, object, ruleargs)
+			})
+		case tags.MultipleOf:
+			if err != nil {
+				return BadValidationRule(string(tags.MultipleOf), text, group, err)
+			}
+			return NewValidationRule(string(tags.MultipleOf), text, group, func(field any, object any) error {
+				return rules.MultipleOf(field, object, ruleargs)
+			})
+		case tags.Precision:
+			if err != nil {
+				return BadValidationRule(string(tags.Precision), text, group, err)
+			}
+			return NewValidationRule(string(tags.Precision), text, group, func(field any, object any) error {
+				return rules.Precision(field, object, ruleargs)
+			})
+		case tags.Step:
+			if err != nil {
+				return BadValidationRule(string(tags.Step), text, group, err)
+			}
+			return NewValidationRule(string(tags.Step), text, group, func(field any, object any) error {
+				return rules.Step(field, object, ruleargs)
+			})
+		case tags.InBBox:
+			if err != nil {
+				return BadValidationRule(string(tags.InBBox), text, group, err)
+			}
+			return NewValidationRule(string(tags.InBBox), text, group, func(field any, object any) error {
+				return rules.InBBox(field, object, ruleargs)
+			})
+		case tags.InPolygon:
+			if err != nil {
+				return BadValidationRule(string(tags.InPolygon), text, group, err)
+			}
+			return NewValidationRule(string(tags.InPolygon), text, group, func(field any, object any) error {
+				return rules.InPolygon(field, object, ruleargs)
+			})
+		case tags.WithinKm:
+			// The arguments of withinkm are positional
+			orderedargs, err := args.ParseArgList(argsStr)
+			if err != nil {
+				return BadValidationRule(string(tags.WithinKm), text, group, err)
+			}
+			return NewValidationRule(string(tags.WithinKm), text, group, func(field any, object any) error {
+				return rules.WithinKm(field, object, orderedargs)
+			})
+		case tags.Required, tags.Alpha, tags.AlphaNumeric, tags.AlphaUnicode, tags.AlphaNumericUnicode, tags.Numeric, tags.NumericUnsigned, tags.Hexadecimal, tags.HexColor, tags.RGB, tags.RGBA, tags.HSL, tags.HSLA, tags.ISSN, tags.E164, tags.Base32, tags.Base32Hex, tags.Base64, tags.Base64Raw, tags.Base64URL, tags.Base64RawURL, tags.Isbn10, tags.Isbn13, tags.SSN, tags.UUID, tags.UUID3, tags.UUID4, tags.UUID5, tags.ULID, tags.MD4, tags.MD5, tags.SHA, tags.SHA0, tags.SHA1, tags.SHA2, tags.SHA3, tags.SHA224, tags.SHA256, tags.SHA384, tags.SHA512, tags.ASCII, tags.PrintableASCII, tags.MultiByte, tags.Uppercase, tags.Lowercase, tags.DataURI, tags.Latitude, tags.Longitude, tags.Hostname, tags.Fqdn, tags.UrlEncoded, tags.HTML, tags.HTMLEncoded, tags.BIC, tags.SemVer, tags.DNS, tags.CVE, tags.IP, tags.IPv4, tags.IPv6, tags.CIDR, tags.CIDRv4, tags.CIDRv6, tags.MAC, tags.Port, tags.HostPort, tags.URL, tags.URI, tags.HTTPURL, tags.Future, tags.Past, tags.Timezone, tags.Duration, tags.CreditCard, tags.CardExpiry, tags.CardExpYear, tags.IBAN, tags.Country2, tags.Country3, tags.CountryNum, tags.Currency, tags.Language, tags.BCP47, tags.JSON, tags.JSONObject, tags.JSONArray, tags.XML, tags.YAML, tags.UUID1, tags.UUID6, tags.UUID7, tags.UUID8, tags.UUIDNil, tags.UUIDMax, tags.Positive, tags.Negative, tags.NonZero, tags.Finite, tags.Integer, tags.LatLng:
 			return BadValidationRule(rulename, text, group, fmt.Errorf("rule: %s accepts no arguments", text))
 		default:
 			return BadValidationRule(string(tags.Unknown), text, group, fmt.Errorf("unknown rule: %s", text))
 		}
 	}
 }
+
+// parseRegexRule builds a regex or notregex rule.
+// Literal patterns (e.g. `regex:/^[a-z]+$/i`) and named patterns (e.g. `regex:@slug`) are compiled here, once,
+// so an invalid pattern is reported as a parsing error. Any other argument, such as `regex:$Pattern`,
+// is evaluated and compiled at validation time.
+func parseRegexRule(tag tags.Tag, text, argsStr string, group int) *ValidationRule {
+	var exp *regexp.Regexp
+	switch {
+	case regex.IsLiteral(argsStr):
+		compiled, err := regex.Literal(argsStr)
+		if err != nil {
+			return BadValidationRule(string(tag), text, group, err)
+		}
+		exp = compiled
+	case strings.HasPrefix(argsStr, "@"):
+		compiled, ok := regex.Lookup(strings.TrimPrefix(argsStr, "@"))
+		if !ok {
+			return BadValidationRule(string(tag), text, group, fmt.Errorf("unknown regex name: %s", argsStr))
+		}
+		exp = compiled
+	default:
+		ruleargs, err := args.ParseArgs(argsStr)
+		if err != nil {
+			return BadValidationRule(string(tag), text, group, err)
+		}
+		if tag == tags.NotRegex {
+			return NewValidationRule(string(tag), text, group, func(field any, object any) error {
+				return rules.NotRegex(field, object, ruleargs)
+			})
+		}
+		return NewValidationRule(string(tag), text, group, func(field any, object any) error {
+			return rules.Regex(field, object, ruleargs)
+		})
+	}
+
+	if tag == tags.NotRegex {
+		return NewValidationRule(string(tag), text, group, func(field any, object any) error {
+			return rules.NotMatchRegex(field, exp)
+		})
+	}
+	return NewValidationRule(string(tag), text, group, func(field any, object any) error {
+		return rules.MatchRegex(field, exp)
+	})
+}
+
+// parseJSONFieldRule builds a jsonfield rule such as `jsonfield:version,semver` or `jsonfield:targets[0].port,between:1,65535`.
+// Everything after the first comma is the nested rule, which is parsed here so that errors in it are reported as parsing errors.
+// The nested rule is validated against the value at the path, with the same parent object as the document itself.
+func parseJSONFieldRule(text, argsStr string, group int) *ValidationRule {
+	path, nestedText, found := strings.Cut(argsStr, ",")
+	path, nestedText = strings.TrimSpace(path), strings.TrimSpace(nestedText)
+	if !found || path == "" || nestedText == "" {
+		return BadValidationRule(string(tags.JSONField), text, group, fmt.Errorf("jsonfield expects a path and a rule, e.g. jsonfield:version,semver"))
+	}
+
+	nested := parseRule(nestedText, group)
+	if nested.Error != nil {
+		return BadValidationRule(string(tags.JSONField), text, group, nested.Error.Error)
+	}
+
+	return NewValidationRule(string(tags.JSONField), text, group, func(field any, object any) error {
+		return rules.JSONField(field, path, func(value any) error {
+			return nested.Validate(value, object)
+		})
+	})
+}
+
+// decodedRules maps the tags of the rules that decode their input to their validation functions.
+var decodedRules = map[tags.Tag]func(input any, obj any, arguments map[string]args.Arg, validate func(value any) error) error{
+	tags.Base64Decoded:  rules.Base64Decoded,
+	tags.Base32Decoded:  rules.Base32Decoded,
+	tags.DataURIDecoded: rules.DataURIDecoded,
+}
+
+// parseDecodedRule builds a decoded content rule such as `base64decoded:max=64`, `base64decoded(json)` or `datauridecoded(json):mime=application/json`.
+// The rule in parentheses is parsed here so that errors in it are reported as parsing errors, and is validated against the decoded content
+// with the same parent object as the encoded value. The options after the colon are passed to the rule as arguments.
+func parseDecodedRule(tag tags.Tag, text string, group int) *ValidationRule {
+	rest := strings.TrimSpace(text)[len(tag):]
+
+	var nested *ValidationRule
+	if strings.HasPrefix(rest, "(") {
+		end := closingParenthesis(rest)
+		if end < 0 {
+			return BadValidationRule(string(tag), text, group, fmt.Errorf("unbalanced parentheses in rule: %s", text))
+		}
+		nested = parseRule(strings.TrimSpace(rest[1:end]), group)
+		if nested.Error != nil {
+			return BadValidationRule(string(tag), text, group, nested.Error.Error)
+		}
+		rest = rest[end+1:]
+	}
+
+	var ruleargs map[string]args.Arg
+	if options, found := strings.CutPrefix(strings.TrimSpace(rest), ":"); found && strings.TrimSpace(options) != "" {
+		var err error
+		ruleargs, err = args.ParseArgs(strings.TrimSpace(options))
+		if err != nil {
+			return BadValidationRule(string(tag), text, group, err)
+		}
+	} else if !found && strings.TrimSpace(rest) != "" {
+		return BadValidationRule(string(tag), text, group, fmt.Errorf("unexpected %q after rule: %s", strings.TrimSpace(rest), text))
+	}
+
+	validate := decodedRules[tag]
+	return NewValidationRule(string(tag), text, group, func(field any, object any) error {
+		if nested == nil {
+			return validate(field, object, ruleargs, nil)
+		}
+		return validate(field, object, ruleargs, func(value any) error {
+			return nested.Validate(value, object)
+		})
+	})
+}
+
+// closingParenthesis returns the index of the parenthesis closing the one text starts with, ignoring parentheses in double quotes, or -1.
+func closingParenthesis(text string) int {
+	depth, inQuotes := 0, false
+	for i := 0; i < len(text); i++ {
+		switch {
+		case text[i] == '"':
+			inQuotes = !inQuotes
+		case inQuotes:
+		case text[i] == '(':
+			depth++
+		case text[i] == ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}